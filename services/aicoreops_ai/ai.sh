@@ -1,2 +1,9 @@
 #!/bin/bash
-protoc aicoreops_ai.proto --go_out=. --go-grpc_out=.
\ No newline at end of file
+protoc aicoreops_ai.proto --go_out=. --go-grpc_out=.
+# 生成 AI 助手 Agent 调用其他服务所需的客户端代码
+protoc -I ../aicoreops_prometheus prometheus_rpc.proto \
+  --go_out=. --go_opt=Mprometheus_rpc.proto=./types/prometheus \
+  --go-grpc_out=. --go-grpc_opt=Mprometheus_rpc.proto=./types/prometheus
+protoc -I ../aicoreops_tree aicoreops_tree.proto aicoreops_ecs.proto \
+  --go_out=. --go_opt=Maicoreops_tree.proto=./types/tree,Maicoreops_ecs.proto=./types/tree \
+  --go-grpc_out=. --go-grpc_opt=Maicoreops_tree.proto=./types/tree,Maicoreops_ecs.proto=./types/tree
@@ -0,0 +1,128 @@
+// eval 离线评测检索参数：回放问答集，输出 recall@k、MRR 和答案相似度
+//
+//	go run ./cmd/eval -f etc/config.yaml -dataset golden.jsonl -top-k 5 -score-threshold 0.3
+//
+// 指定 -corpus 时将目录下的文件写入内存向量库评测，不读取线上 Qdrant。
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/zeromicro/go-zero/core/conf"
+)
+
+var (
+	configFile     = flag.String("f", "etc/config.yaml", "the config file")
+	datasetFile    = flag.String("dataset", "", "问答集文件，JSON 数组或 JSONL")
+	corpusDir      = flag.String("corpus", "", "评测文档目录，指定时使用内存向量库")
+	topK           = flag.Int("top-k", 5, "召回数量")
+	scoreThreshold = flag.Float64("score-threshold", 0.3, "相似度阈值")
+	mode           = flag.String("mode", dao.SearchModeVector, "检索模式: vector|keyword|hybrid")
+	rerank         = flag.Bool("rerank", false, "是否使用大模型重排序")
+	retrievalOnly  = flag.Bool("retrieval-only", false, "只评测检索，不生成回答")
+	jsonOutput     = flag.Bool("json", false, "以 JSON 输出评测结果")
+)
+
+func main() {
+	flag.Parse()
+	if *datasetFile == "" {
+		fmt.Fprintln(os.Stderr, "缺少 -dataset 参数")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+
+	f, err := os.Open(*datasetFile)
+	if err != nil {
+		return fmt.Errorf("打开问答集失败: %w", err)
+	}
+	defer f.Close()
+
+	cases, err := domain.LoadEvalCases(f)
+	if err != nil {
+		return err
+	}
+
+	llm := pkg.InitLLM(c.LLM)
+	embedder, err := embeddings.NewEmbedder(llm)
+	if err != nil {
+		return fmt.Errorf("创建嵌入器失败: %w", err)
+	}
+
+	// 只需要检索能力，不连接 MySQL
+	retriever := &domain.AIHelperDomain{Reranker: dao.NewLLMReranker(llm)}
+	if *corpusDir != "" {
+		store := dao.NewMemoryVectorStore(embedder)
+		retriever.Qdrant = dao.NewQdrantDAO(store, store, dao.WithKeywordCandidateLimit(c.Qdrant.KeywordCandidateLimit))
+	} else {
+		retriever.Qdrant = dao.NewQdrantDAO(pkg.InitQdrantStore(c.Qdrant, llm), pkg.InitQdrantClient(c.Qdrant),
+			dao.WithKeywordCandidateLimit(c.Qdrant.KeywordCandidateLimit))
+	}
+
+	eval := domain.NewEvalDomain(retriever, llm, embedder, nil)
+	if *corpusDir != "" {
+		if err := loadCorpus(ctx, eval, *corpusDir); err != nil {
+			return err
+		}
+	}
+
+	report, err := eval.Run(ctx, cases, domain.EvalOptions{
+		ScoreThreshold: float32(*scoreThreshold),
+		TopK:           *topK,
+		RetrievalMode:  *mode,
+		Rerank:         *rerank,
+		RetrievalOnly:  *retrievalOnly,
+	})
+	if err != nil {
+		return err
+	}
+
+	if *jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	return report.WriteText(os.Stdout)
+}
+
+func loadCorpus(ctx context.Context, eval *domain.EvalDomain, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("读取文档目录失败: %w", err)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return fmt.Errorf("读取文档失败: %w", err)
+		}
+		if err := eval.LoadCorpus(ctx, e.Name(), content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
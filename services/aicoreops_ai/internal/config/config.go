@@ -1,21 +1,104 @@
 package config
 
-import "github.com/zeromicro/go-zero/zrpc"
+import (
+	"time"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
 
 type Config struct {
 	zrpc.RpcServerConf
-	LLM    LLMConfig
-	Qdrant QdrantConfig
-	MySQL  string
+	LLM       LLMConfig
+	Qdrant    QdrantConfig
+	MySQL     string
+	Redis     string `json:",optional"` // 配置后会话记忆存储在 Redis，多副本共享
+	Memory    MemoryConfig
+	K8s       K8sConfig       `json:",optional"`
+	AutoFix   AutoFixConfig   `json:",optional"`
+	Agent     AgentConfig     `json:",optional"`
+	Quota     QuotaConfig     `json:",optional"`
+	AlertRule AlertRuleConfig `json:",optional"`
+	// SemanticCache 相似问题直接返回缓存的回答，跳过检索和生成
+	SemanticCache SemanticCacheConfig `json:",optional"`
+	// Redaction 提问、日志和文档分块发送给大模型或写入存储前屏蔽密钥和个人信息
+	Redaction RedactionConfig `json:",optional"`
+	// Reindex 向量模型变更后将文档重新向量化写入新集合，完成后切换
+	Reindex ReindexConfig `json:",optional"`
+	Admins  []int64       `json:",optional"` // 管理员用户ID
+	// Agent 工具调用和告警规则生成依赖的下游服务，未配置时不提供对应功能
+	PrometheusRpc zrpc.RpcClientConf `json:",optional"`
+	TreeRpc       zrpc.RpcClientConf `json:",optional"`
 }
 
 type LLMConfig struct {
-	Url   string
-	Model string
+	Provider       string `json:",default=ollama,options=ollama|openai"` // 提供方类型
+	Url            string // 服务地址
+	ApiKey         string `json:",optional"` // OpenAI 兼容服务的密钥
+	Model          string // 对话模型
+	EmbeddingModel string `json:",optional"` // 向量模型，为空时使用对话模型
+	// MemoryTokenLimit 单个会话记忆的 token 上限，接近上限时较早的问答由大模型压缩为摘要
+	MemoryTokenLimit int `json:",default=10000"`
+}
+
+type MemoryConfig struct {
+	MaxSessions int           `json:",default=1024"` // 进程内最多缓存的会话数
+	TTL         time.Duration `json:",default=30m"`  // 会话空闲过期时间
+	MaxTurns    int           `json:",default=50"`   // Redis 中每个会话保留的最大轮数
+}
+
+type K8sConfig struct {
+	Kubeconfig string `json:",optional"` // kubeconfig 路径，为空时使用集群内配置
+}
+
+type AutoFixConfig struct {
+	Approvers      []int64       `json:",optional"`      // 可审批修复任务的用户ID，管理员也可以审批
+	SelfApprove    bool          `json:",default=false"` // 是否允许审批人审批自己发起的修复任务
+	ExecuteTimeout time.Duration `json:",default=5m"`    // 单次修复执行超时时间
+}
+
+type AgentConfig struct {
+	MaxSteps int                `json:",default=5"` // 单次提问最多的工具调用轮数
+	ToolACL  map[string][]int64 `json:",optional"`  // 工具名 -> 允许调用的用户ID，未配置的工具对所有用户开放
+}
+
+type QuotaConfig struct {
+	DailyTokens   int64 `json:",default=0"` // 每个用户每天可消耗的 token 数，0 表示不限制
+	MonthlyTokens int64 `json:",default=0"` // 每个用户每月可消耗的 token 数，0 表示不限制
+}
+
+type AlertRuleConfig struct {
+	MaxAttempts int `json:",default=3"` // 生成告警规则时最多调用大模型的次数，包含 PromQL 校验失败后的重试
+}
+
+type SemanticCacheConfig struct {
+	Enable     bool          `json:",default=false"`
+	Threshold  float32       `json:",default=0.95"` // 问题向量余弦相似度达到该值视为相同问题
+	MaxEntries int           `json:",default=1000"` // 最多缓存的回答数，超出时淘汰最久未命中的
+	TTL        time.Duration `json:",default=24h"`  // 缓存有效期，多副本部署时也是其他副本上传文档后的最长失效时间
+}
+
+type RedactionConfig struct {
+	Enable           bool            `json:",default=true"`
+	DisabledRules    []string        `json:",optional"`    // 关闭的内置规则，如 ipv4、email
+	Rules            []RedactionRule `json:",optional"`    // 自定义规则，在内置规则之后执行
+	EntropyThreshold float64         `json:",default=4.0"` // 同时包含大小写字母和数字的片段达到该熵值（bit/字符）视为密钥，0 表示关闭熵检测
+	EntropyMinLength int             `json:",default=20"`  // 参与熵检测的最短长度
+}
+
+// RedactionRule 脱敏规则，Pattern 中包含名为 secret 的分组时只替换该分组
+type RedactionRule struct {
+	Name    string
+	Pattern string
+}
+
+type ReindexConfig struct {
+	AutoStart       bool          `json:",default=true"` // 启动时发现集合的向量模型与配置不一致，自动创建重建任务
+	BatchSize       int           `json:",default=20"`   // 每批读取的文档数
+	RefreshInterval time.Duration `json:",default=30s"`  // 检查其他副本切换集合、接管中断任务的间隔
 }
 
 type QdrantConfig struct {
-	Url            string
-	CollectionName string
-	Model          string
+	Url                   string
+	CollectionName        string
+	KeywordCandidateLimit int `json:",default=1000"` // 关键词检索参与 BM25 排序的候选分块上限，超出时截断并记录日志
 }
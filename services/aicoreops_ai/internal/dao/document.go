@@ -0,0 +1,130 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+)
+
+type DocumentDAO struct {
+	db *gorm.DB
+}
+
+func NewDocumentDAO(db *gorm.DB) *DocumentDAO {
+	return &DocumentDAO{db: db}
+}
+
+// CreateDocument 创建文档，知识库中已有相同内容的文档时返回 gorm.ErrDuplicatedKey
+func (d *DocumentDAO) CreateDocument(ctx context.Context, doc *model.Document) error {
+	return d.translateError(d.db.WithContext(ctx).Create(doc).Error)
+}
+
+// GetDocumentByDocID 根据文档ID获取文档
+func (d *DocumentDAO) GetDocumentByDocID(ctx context.Context, docID string) (*model.Document, error) {
+	var doc model.Document
+	if err := d.db.WithContext(ctx).Where("doc_id = ? AND deleted_at = 0", docID).First(&doc).Error; err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// GetDocumentByHash 根据内容哈希获取知识库中的文档，不存在时返回 nil
+func (d *DocumentDAO) GetDocumentByHash(ctx context.Context, kbID int64, hash string) (*model.Document, error) {
+	var doc model.Document
+	err := d.db.WithContext(ctx).Where("knowledge_base_id = ? AND content_hash = ? AND deleted_at = 0", kbID, hash).First(&doc).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// GetDocumentList 获取指定知识库的文档列表，kbIDs 为 nil 时不过滤，不返回文档原文
+func (d *DocumentDAO) GetDocumentList(ctx context.Context, kbIDs []int64, offset, limit int) ([]*model.Document, int64, error) {
+	if limit < 0 {
+		return nil, 0, fmt.Errorf("limit 不能小于0")
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("offset 不能小于0")
+	}
+
+	var (
+		docs  []*model.Document
+		total int64
+	)
+	query := d.db.WithContext(ctx).Model(&model.Document{}).Where("deleted_at = 0")
+	if kbIDs != nil {
+		query = query.Where("knowledge_base_id IN ?", kbIDs)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Omit("content").Order("id DESC").Offset(offset).Limit(limit).Find(&docs).Error; err != nil {
+		return nil, 0, err
+	}
+	return docs, total, nil
+}
+
+// CountDocuments 统计知识库中的文档数量
+func (d *DocumentDAO) CountDocuments(ctx context.Context, kbID int64) (int64, error) {
+	var total int64
+	if err := d.db.WithContext(ctx).Model(&model.Document{}).
+		Where("knowledge_base_id = ? AND deleted_at = 0", kbID).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// UpdateDocument 更新文档，内容与知识库中其他文档相同时返回 gorm.ErrDuplicatedKey
+func (d *DocumentDAO) UpdateDocument(ctx context.Context, doc *model.Document) error {
+	return d.translateError(d.db.WithContext(ctx).Save(doc).Error)
+}
+
+// DeleteDocument 删除文档（软删除），删除时间精确到毫秒，避免同一内容反复上传删除时与唯一索引冲突
+func (d *DocumentDAO) DeleteDocument(ctx context.Context, docID string) error {
+	return d.db.WithContext(ctx).Model(&model.Document{}).Where("doc_id = ? AND deleted_at = 0", docID).
+		Update("deleted_at", time.Now().UnixMilli()).Error
+}
+
+// translateError 将数据库驱动的错误转换为 gorm 错误，如唯一索引冲突转换为 gorm.ErrDuplicatedKey
+func (d *DocumentDAO) translateError(err error) error {
+	if translator, ok := d.db.Dialector.(gorm.ErrorTranslator); ok && err != nil {
+		return translator.Translate(err)
+	}
+	return err
+}
+
+// ListDocumentsAfter 按主键顺序分批获取未删除的文档
+func (d *DocumentDAO) ListDocumentsAfter(ctx context.Context, afterID int64, limit int) ([]*model.Document, error) {
+	var docs []*model.Document
+	if err := d.db.WithContext(ctx).Where("id > ? AND deleted_at = 0", afterID).
+		Order("id ASC").Limit(limit).Find(&docs).Error; err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// ListDocumentsUpdatedSince 获取 since 之后变更的文档，包含已删除的文档
+func (d *DocumentDAO) ListDocumentsUpdatedSince(ctx context.Context, since int64) ([]*model.Document, error) {
+	var docs []*model.Document
+	if err := d.db.WithContext(ctx).Where("updated_at >= ?", since).
+		Order("id ASC").Find(&docs).Error; err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// CountAllDocuments 统计所有知识库中未删除的文档数量
+func (d *DocumentDAO) CountAllDocuments(ctx context.Context) (int64, error) {
+	var total int64
+	if err := d.db.WithContext(ctx).Model(&model.Document{}).Where("deleted_at = 0").Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FeedbackDAO struct {
+	db *gorm.DB
+}
+
+func NewFeedbackDAO(db *gorm.DB) *FeedbackDAO {
+	return &FeedbackDAO{db: db}
+}
+
+// UpsertFeedback 保存反馈，重复提交时覆盖评分和说明
+func (d *FeedbackDAO) UpsertFeedback(ctx context.Context, feedback *model.Feedback) error {
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "history_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rating", "comment", "updated_at"}),
+	}).Create(feedback).Error
+}
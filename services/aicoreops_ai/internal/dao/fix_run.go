@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+)
+
+type FixRunDAO struct {
+	db *gorm.DB
+}
+
+func NewFixRunDAO(db *gorm.DB) *FixRunDAO {
+	return &FixRunDAO{db: db}
+}
+
+// CreateFixRun 创建修复任务执行记录
+func (d *FixRunDAO) CreateFixRun(ctx context.Context, run *model.FixRun) error {
+	return d.db.WithContext(ctx).Create(run).Error
+}
+
+// GetFixRunByRunID 根据执行记录ID获取修复任务
+func (d *FixRunDAO) GetFixRunByRunID(ctx context.Context, runID string) (*model.FixRun, error) {
+	var run model.FixRun
+	if err := d.db.WithContext(ctx).Where("run_id = ?", runID).First(&run).Error; err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// TransitFixRun 以当前状态为条件更新，避免重复审批或并发执行
+func (d *FixRunDAO) TransitFixRun(ctx context.Context, runID, from, to string, fields map[string]any) (bool, error) {
+	updates := make(map[string]any, len(fields)+1)
+	for k, v := range fields {
+		updates[k] = v
+	}
+	updates["status"] = to
+
+	res := d.db.WithContext(ctx).Model(&model.FixRun{}).Where("run_id = ? AND status = ?", runID, from).Updates(updates)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
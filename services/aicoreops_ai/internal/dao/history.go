@@ -2,6 +2,9 @@ package dao
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
 	"gorm.io/gorm"
@@ -20,15 +23,94 @@ func (d *HistoryDAO) CreateHistory(ctx context.Context, history *model.History)
 	return d.db.WithContext(ctx).Create(history).Error
 }
 
+// GetHistoryByID 获取单条历史记录
+func (d *HistoryDAO) GetHistoryByID(ctx context.Context, id int64) (*model.History, error) {
+	var history model.History
+	if err := d.db.WithContext(ctx).Where("id = ? AND deleted_at = 0", id).First(&history).Error; err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
 // GetHistoryBySessionID 获取历史记录
 func (d *HistoryDAO) GetHistoryBySessionID(ctx context.Context, sessionID string) ([]*model.History, error) {
 	var history []*model.History
-	if err := d.db.WithContext(ctx).Where("session_id = ?", sessionID).Find(&history).Error; err != nil {
+	if err := d.db.WithContext(ctx).Where("session_id = ? AND deleted_at = 0", sessionID).Order("id").Find(&history).Error; err != nil {
 		return nil, err
 	}
 	return history, nil
 }
 
+// GetLastQuestion 获取会话中最后一个问题，备选回答不算作新的问题
+func (d *HistoryDAO) GetLastQuestion(ctx context.Context, sessionID string) (*model.History, error) {
+	var history model.History
+	err := d.db.WithContext(ctx).Where("session_id = ? AND parent_id = 0 AND deleted_at = 0", sessionID).
+		Order("id DESC").First(&history).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// GetHistoryPage 分页获取会话的历史记录，按时间正序
+func (d *HistoryDAO) GetHistoryPage(ctx context.Context, sessionID string, offset, limit int) ([]*model.History, int64, error) {
+	if limit < 0 {
+		return nil, 0, fmt.Errorf("limit 不能小于0")
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("offset 不能小于0")
+	}
+
+	var (
+		histories []*model.History
+		total     int64
+	)
+	query := d.db.WithContext(ctx).Model(&model.History{}).Where("session_id = ? AND deleted_at = 0", sessionID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Order("id").Offset(offset).Limit(limit).Find(&histories).Error; err != nil {
+		return nil, 0, err
+	}
+	return histories, total, nil
+}
+
+// SearchHistory 在用户未删除的会话中按关键字搜索问题和回答，按时间倒序
+func (d *HistoryDAO) SearchHistory(ctx context.Context, userId int64, keyword string, offset, limit int) ([]*model.HistoryHit, int64, error) {
+	if limit < 0 {
+		return nil, 0, fmt.Errorf("limit 不能小于0")
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("offset 不能小于0")
+	}
+
+	pattern := "%" + escapeLike(keyword) + "%"
+	var (
+		hits  []*model.HistoryHit
+		total int64
+	)
+	query := d.db.WithContext(ctx).Table("history").
+		Joins("JOIN history_session ON history_session.session_id = history.session_id AND history_session.deleted_at = 0").
+		Where("history_session.user_id = ? AND history.deleted_at = 0", userId).
+		Where("history.question LIKE ? OR history.answer LIKE ?", pattern, pattern)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Select("history.*, history_session.title").Order("history.id DESC").
+		Offset(offset).Limit(limit).Scan(&hits).Error; err != nil {
+		return nil, 0, err
+	}
+	return hits, total, nil
+}
+
+// escapeLike 转义 LIKE 通配符，关键字按字面匹配
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // GetHistoryList 获取历史记录列表
 func (d *HistoryDAO) GetHistoryList(ctx context.Context, userId int64, offset, limit int) ([]*model.History, error) {
 	var histories []*model.History
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
 	"gorm.io/gorm"
@@ -30,6 +31,15 @@ func (d *HistorySessionDAO) GetHistorySessionByID(ctx context.Context, id int64)
 	return &session, nil
 }
 
+// GetHistorySessionBySessionID 根据会话ID获取历史会话
+func (d *HistorySessionDAO) GetHistorySessionBySessionID(ctx context.Context, sessionID string) (*model.HistorySession, error) {
+	var session model.HistorySession
+	if err := d.db.WithContext(ctx).Where("session_id = ? AND deleted_at = 0", sessionID).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
 // GetHistorySessionList 获取历史会话列表
 func (d *HistorySessionDAO) GetHistorySessionList(ctx context.Context, userId int64, offset, limit int) ([]*model.HistorySession, error) {
 	if limit < 0 {
@@ -39,7 +49,8 @@ func (d *HistorySessionDAO) GetHistorySessionList(ctx context.Context, userId in
 		return nil, fmt.Errorf("offset 不能小于0")
 	}
 	var sessions []*model.HistorySession
-	if err := d.db.WithContext(ctx).Where("user_id = ?", userId).Offset(offset).Limit(limit).Find(&sessions).Error; err != nil {
+	if err := d.db.WithContext(ctx).Where("user_id = ? AND deleted_at = 0", userId).Order("id DESC").
+		Offset(offset).Limit(limit).Find(&sessions).Error; err != nil {
 		return nil, err
 	}
 	return sessions, nil
@@ -50,6 +61,31 @@ func (d *HistorySessionDAO) UpdateHistorySession(ctx context.Context, session *m
 	return d.db.WithContext(ctx).Save(session).Error
 }
 
+// RenameHistorySession 修改会话标题
+func (d *HistorySessionDAO) RenameHistorySession(ctx context.Context, sessionID, title string) error {
+	return d.db.WithContext(ctx).Model(&model.HistorySession{}).Where("session_id = ? AND deleted_at = 0", sessionID).
+		Update("title", title).Error
+}
+
+// UpdateHistorySessionSummary 更新会话摘要
+func (d *HistorySessionDAO) UpdateHistorySessionSummary(ctx context.Context, sessionID, summary string, summarizedTurns int) error {
+	return d.db.WithContext(ctx).Model(&model.HistorySession{}).Where("session_id = ? AND deleted_at = 0", sessionID).
+		Updates(map[string]any{"summary": summary, "summarized_turns": summarizedTurns}).Error
+}
+
+// DeleteHistorySessionBySessionID 软删除会话及其历史记录
+func (d *HistorySessionDAO) DeleteHistorySessionBySessionID(ctx context.Context, sessionID string) error {
+	now := time.Now().Unix()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.HistorySession{}).Where("session_id = ? AND deleted_at = 0", sessionID).
+			Update("deleted_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.History{}).Where("session_id = ? AND deleted_at = 0", sessionID).
+			Update("deleted_at", now).Error
+	})
+}
+
 // DeleteHistorySession 删除历史会话
 func (d *HistorySessionDAO) DeleteHistorySession(ctx context.Context, id int64) error {
 	return d.db.WithContext(ctx).Where("id = ?", id).Delete(&model.HistorySession{}).Error
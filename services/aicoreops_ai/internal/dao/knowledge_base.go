@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+)
+
+type KnowledgeBaseDAO struct {
+	db *gorm.DB
+}
+
+func NewKnowledgeBaseDAO(db *gorm.DB) *KnowledgeBaseDAO {
+	return &KnowledgeBaseDAO{db: db}
+}
+
+// CreateKnowledgeBase 创建知识库
+func (d *KnowledgeBaseDAO) CreateKnowledgeBase(ctx context.Context, kb *model.KnowledgeBase) error {
+	return d.db.WithContext(ctx).Create(kb).Error
+}
+
+// GetKnowledgeBaseByName 根据名称获取知识库
+func (d *KnowledgeBaseDAO) GetKnowledgeBaseByName(ctx context.Context, name string) (*model.KnowledgeBase, error) {
+	var kb model.KnowledgeBase
+	if err := d.db.WithContext(ctx).Where("name = ? AND deleted_at = 0", name).First(&kb).Error; err != nil {
+		return nil, err
+	}
+	return &kb, nil
+}
+
+// GetKnowledgeBaseByID 根据ID获取知识库
+func (d *KnowledgeBaseDAO) GetKnowledgeBaseByID(ctx context.Context, id int64) (*model.KnowledgeBase, error) {
+	var kb model.KnowledgeBase
+	if err := d.db.WithContext(ctx).Where("id = ? AND deleted_at = 0", id).First(&kb).Error; err != nil {
+		return nil, err
+	}
+	return &kb, nil
+}
+
+// ListKnowledgeBases 获取知识库列表，treeNodeID 为 0 时返回全部
+func (d *KnowledgeBaseDAO) ListKnowledgeBases(ctx context.Context, treeNodeID int64) ([]*model.KnowledgeBase, error) {
+	var kbs []*model.KnowledgeBase
+	query := d.db.WithContext(ctx).Where("deleted_at = 0")
+	if treeNodeID > 0 {
+		query = query.Where("tree_node_id = ?", treeNodeID)
+	}
+	if err := query.Order("id ASC").Find(&kbs).Error; err != nil {
+		return nil, err
+	}
+	return kbs, nil
+}
+
+// UpdateKnowledgeBase 更新知识库
+func (d *KnowledgeBaseDAO) UpdateKnowledgeBase(ctx context.Context, kb *model.KnowledgeBase) error {
+	return d.db.WithContext(ctx).Save(kb).Error
+}
+
+// DeleteKnowledgeBase 删除知识库（软删除）
+func (d *KnowledgeBaseDAO) DeleteKnowledgeBase(ctx context.Context, id int64) error {
+	return d.db.WithContext(ctx).Model(&model.KnowledgeBase{}).Where("id = ? AND deleted_at = 0", id).
+		Update("deleted_at", time.Now().Unix()).Error
+}
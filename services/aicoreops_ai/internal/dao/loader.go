@@ -0,0 +1,279 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/tmc/langchaingo/documentloaders"
+	"github.com/tmc/langchaingo/schema"
+	"golang.org/x/net/html"
+)
+
+// 支持的文档格式
+const (
+	FormatText     = "text"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatCSV      = "csv"
+	FormatPDF      = "pdf"
+)
+
+// sectionSeparator 多级标题拼接成章节路径时使用的分隔符
+const sectionSeparator = " > "
+
+var markdownHeadingRegexp = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+
+// DetectFormat 识别文档格式，优先使用显式指定的格式，其次根据文件扩展名和内容判断
+func DetectFormat(format, name string, content []byte) (string, error) {
+	if format != "" {
+		switch format {
+		case FormatText, FormatMarkdown, FormatHTML, FormatCSV, FormatPDF:
+			return format, nil
+		default:
+			return "", fmt.Errorf("不支持的文档格式: %s", format)
+		}
+	}
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".md", ".markdown":
+		return FormatMarkdown, nil
+	case ".html", ".htm":
+		return FormatHTML, nil
+	case ".csv":
+		return FormatCSV, nil
+	case ".pdf":
+		return FormatPDF, nil
+	case ".txt", ".log":
+		return FormatText, nil
+	}
+
+	if bytes.HasPrefix(content, []byte("%PDF-")) {
+		return FormatPDF, nil
+	}
+	if strings.HasPrefix(http.DetectContentType(content), "text/html") {
+		return FormatHTML, nil
+	}
+	if looksLikeMarkdown(content) {
+		return FormatMarkdown, nil
+	}
+
+	return FormatText, nil
+}
+
+// LoadDocuments 按格式将内容解析为带章节元数据的文档，尚未分块
+func LoadDocuments(ctx context.Context, format string, content []byte) ([]schema.Document, error) {
+	var (
+		docs []schema.Document
+		err  error
+	)
+
+	switch format {
+	case FormatMarkdown:
+		docs = loadMarkdown(content)
+	case FormatHTML:
+		docs, err = loadHTML(content)
+	case FormatCSV:
+		docs, err = loadCSV(ctx, content)
+	case FormatPDF:
+		docs, err = loadPDF(ctx, content)
+	default:
+		docs, err = documentloaders.NewText(bytes.NewReader(content)).Load(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("解析 %s 文档失败: %w", format, err)
+	}
+
+	for i := range docs {
+		if docs[i].Metadata == nil {
+			docs[i].Metadata = make(map[string]any)
+		}
+		if _, ok := docs[i].Metadata["section"]; !ok {
+			docs[i].Metadata["section"] = ""
+		}
+		docs[i].Metadata["format"] = format
+	}
+
+	return docs, nil
+}
+
+func looksLikeMarkdown(content []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for i := 0; scanner.Scan() && i < 50; i++ {
+		if markdownHeadingRegexp.MatchString(scanner.Text()) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadMarkdown 按标题切分章节，代码块中的 # 不视为标题
+func loadMarkdown(content []byte) []schema.Document {
+	var (
+		docs     []schema.Document
+		headings []string
+		buf      strings.Builder
+		inFence  bool
+	)
+
+	flush := func() {
+		text := strings.TrimSpace(buf.String())
+		buf.Reset()
+		if text == "" {
+			return
+		}
+		docs = append(docs, schema.Document{
+			PageContent: text,
+			Metadata:    map[string]any{"section": sectionPath(headings)},
+		})
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+		}
+
+		if m := markdownHeadingRegexp.FindStringSubmatch(line); m != nil && !inFence {
+			flush()
+			level := len(m[1])
+			if level <= len(headings) {
+				headings = headings[:level-1]
+			}
+			for len(headings) < level-1 {
+				headings = append(headings, "")
+			}
+			headings = append(headings, m[2])
+		}
+
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+	flush()
+
+	return docs
+}
+
+// loadHTML 提取正文文本，并按 h1-h6 标题切分章节
+func loadHTML(content []byte) ([]schema.Document, error) {
+	root, err := html.Parse(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		docs     []schema.Document
+		headings []string
+		buf      strings.Builder
+	)
+
+	flush := func() {
+		text := strings.Join(strings.Fields(buf.String()), " ")
+		buf.Reset()
+		if text == "" {
+			return
+		}
+		docs = append(docs, schema.Document{
+			PageContent: text,
+			Metadata:    map[string]any{"section": sectionPath(headings)},
+		})
+	}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "script", "style", "noscript", "head", "nav", "footer":
+				return
+			case "h1", "h2", "h3", "h4", "h5", "h6":
+				flush()
+				level := int(n.Data[1] - '0')
+				if level <= len(headings) {
+					headings = headings[:level-1]
+				}
+				for len(headings) < level-1 {
+					headings = append(headings, "")
+				}
+				title := strings.Join(strings.Fields(nodeText(n)), " ")
+				headings = append(headings, title)
+				buf.WriteString(title)
+				buf.WriteString("\n")
+				return
+			}
+		}
+
+		if n.Type == html.TextNode {
+			buf.WriteString(n.Data)
+			buf.WriteString(" ")
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(root)
+	flush()
+
+	return docs, nil
+}
+
+// sectionPath 拼接多级标题，跳过缺失的层级
+func sectionPath(headings []string) string {
+	parts := make([]string, 0, len(headings))
+	for _, h := range headings {
+		if h != "" {
+			parts = append(parts, h)
+		}
+	}
+	return strings.Join(parts, sectionSeparator)
+}
+
+func nodeText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(nodeText(c))
+	}
+	return sb.String()
+}
+
+// loadCSV 每行作为一个文档，章节记录为行号
+func loadCSV(ctx context.Context, content []byte) ([]schema.Document, error) {
+	docs, err := documentloaders.NewCSV(bytes.NewReader(content)).Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range docs {
+		docs[i].Metadata["section"] = fmt.Sprintf("row %v", docs[i].Metadata["row"])
+	}
+
+	return docs, nil
+}
+
+// loadPDF 每页作为一个文档，章节记录为页码
+func loadPDF(ctx context.Context, content []byte) ([]schema.Document, error) {
+	docs, err := documentloaders.NewPDF(bytes.NewReader(content), int64(len(content))).Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]schema.Document, 0, len(docs))
+	for _, doc := range docs {
+		if strings.TrimSpace(doc.PageContent) == "" {
+			continue
+		}
+		doc.Metadata["section"] = fmt.Sprintf("page %v", doc.Metadata["page"])
+		res = append(res, doc)
+	}
+
+	return res, nil
+}
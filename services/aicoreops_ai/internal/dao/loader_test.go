@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	cases := []struct {
+		format, name, content, want string
+	}{
+		{"", "runbook.md", "text", FormatMarkdown},
+		{"", "page.html", "", FormatHTML},
+		{"", "hosts.csv", "a,b", FormatCSV},
+		{"", "manual", "%PDF-1.4", FormatPDF},
+		{"", "notes", "<!DOCTYPE html><html></html>", FormatHTML},
+		{"", "notes", "# 标题\n正文", FormatMarkdown},
+		{"", "notes", "plain text", FormatText},
+		{FormatCSV, "runbook.md", "", FormatCSV},
+	}
+
+	for _, c := range cases {
+		got, err := DetectFormat(c.format, c.name, []byte(c.content))
+		if err != nil {
+			t.Fatalf("DetectFormat(%q, %q) failed: %v", c.format, c.name, err)
+		}
+		if got != c.want {
+			t.Errorf("DetectFormat(%q, %q) = %s, want %s", c.format, c.name, got, c.want)
+		}
+	}
+
+	if _, err := DetectFormat("docx", "", nil); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestLoadDocuments_Markdown(t *testing.T) {
+	content := "# 部署\n概述\n## 回滚\n```sh\n# 不是标题\nkubectl rollout undo\n```\n### 验证\n检查 Pod\n"
+
+	docs, err := LoadDocuments(context.Background(), FormatMarkdown, []byte(content))
+	if err != nil {
+		t.Fatalf("LoadDocuments failed: %v", err)
+	}
+
+	want := []string{"部署", "部署 > 回滚", "部署 > 回滚 > 验证"}
+	if len(docs) != len(want) {
+		t.Fatalf("expected %d sections, got %d", len(want), len(docs))
+	}
+	for i, doc := range docs {
+		if doc.Metadata["section"] != want[i] {
+			t.Errorf("section %d = %v, want %s", i, doc.Metadata["section"], want[i])
+		}
+		if doc.Metadata["format"] != FormatMarkdown {
+			t.Errorf("section %d format = %v", i, doc.Metadata["format"])
+		}
+	}
+}
+
+func TestLoadDocuments_HTML(t *testing.T) {
+	content := `<html><head><title>t</title><style>p{}</style></head><body>
+<h1>告警</h1><p>说明</p><script>alert(1)</script><h2>处理</h2><p>重启服务</p></body></html>`
+
+	docs, err := LoadDocuments(context.Background(), FormatHTML, []byte(content))
+	if err != nil {
+		t.Fatalf("LoadDocuments failed: %v", err)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("expected 2 sections, got %d", len(docs))
+	}
+	if docs[1].Metadata["section"] != "告警 > 处理" || docs[1].PageContent != "处理 重启服务" {
+		t.Errorf("unexpected section: %+v", docs[1])
+	}
+	if docs[0].PageContent != "告警 说明" {
+		t.Errorf("script should be stripped, got %q", docs[0].PageContent)
+	}
+}
+
+func TestLoadDocuments_CSV(t *testing.T) {
+	docs, err := LoadDocuments(context.Background(), FormatCSV, []byte("host,ip\nweb-1,10.0.0.1\nweb-2,10.0.0.2\n"))
+	if err != nil {
+		t.Fatalf("LoadDocuments failed: %v", err)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(docs))
+	}
+	if docs[1].Metadata["section"] != "row 2" {
+		t.Errorf("unexpected section: %v", docs[1].Metadata["section"])
+	}
+}
@@ -0,0 +1,232 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+// MemoryVectorStore 进程内的向量存储，按余弦相似度检索，用于离线评测和测试，不依赖 Qdrant
+type MemoryVectorStore struct {
+	embedder embeddings.Embedder
+
+	mu     sync.RWMutex
+	points []memoryPoint
+	nextID int
+}
+
+type memoryPoint struct {
+	id      int
+	vector  []float32
+	payload map[string]any
+}
+
+var (
+	_ vectorstores.VectorStore = (*MemoryVectorStore)(nil)
+	_ PointStore               = (*MemoryVectorStore)(nil)
+)
+
+func NewMemoryVectorStore(embedder embeddings.Embedder) *MemoryVectorStore {
+	return &MemoryVectorStore{embedder: embedder}
+}
+
+// AddDocuments 计算分块向量并保存，payload 结构与 langchaingo 写入 Qdrant 的一致
+func (s *MemoryVectorStore) AddDocuments(ctx context.Context, docs []schema.Document, options ...vectorstores.Option) ([]string, error) {
+	texts := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		texts = append(texts, doc.PageContent)
+	}
+
+	vectors, err := s.embedder.EmbedDocuments(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("embed documents failed: %w", err)
+	}
+	if len(vectors) != len(docs) {
+		return nil, fmt.Errorf("embed documents failed: got %d vectors for %d documents", len(vectors), len(docs))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(docs))
+	for i, doc := range docs {
+		payload := maps.Clone(doc.Metadata)
+		if payload == nil {
+			payload = make(map[string]any)
+		}
+		payload[contentKey] = doc.PageContent
+
+		s.nextID++
+		s.points = append(s.points, memoryPoint{id: s.nextID, vector: vectors[i], payload: payload})
+		ids = append(ids, strconv.Itoa(s.nextID))
+	}
+
+	return ids, nil
+}
+
+// SimilaritySearch 返回与查询最相似的 numDocuments 个分块，支持相似度阈值和 Qdrant 格式的过滤条件
+func (s *MemoryVectorStore) SimilaritySearch(ctx context.Context, query string, numDocuments int, options ...vectorstores.Option) ([]schema.Document, error) {
+	opts := vectorstores.Options{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	vector, err := s.embedder.EmbedQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("embed query failed: %w", err)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var docs []schema.Document
+	for _, p := range s.points {
+		if !matchFilter(opts.Filters, p.payload) {
+			continue
+		}
+		score := CosineSimilarity(vector, p.vector)
+		if score < opts.ScoreThreshold {
+			continue
+		}
+
+		metadata := maps.Clone(p.payload)
+		delete(metadata, contentKey)
+		content, _ := p.payload[contentKey].(string)
+		docs = append(docs, schema.Document{PageContent: content, Metadata: metadata, Score: score})
+	}
+
+	sort.SliceStable(docs, func(i, j int) bool {
+		return docs[i].Score > docs[j].Score
+	})
+	if len(docs) > numDocuments {
+		docs = docs[:numDocuments]
+	}
+
+	return docs, nil
+}
+
+// ScrollPoints 按过滤条件遍历向量点，最多返回 limit 个
+func (s *MemoryVectorStore) ScrollPoints(ctx context.Context, filter any, limit int) ([]pkg.QdrantPoint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var points []pkg.QdrantPoint
+	for _, p := range s.points {
+		if len(points) >= limit {
+			break
+		}
+		if matchFilter(filter, p.payload) {
+			points = append(points, pkg.QdrantPoint{ID: p.id, Payload: maps.Clone(p.payload)})
+		}
+	}
+
+	return points, nil
+}
+
+// DeletePoints 删除匹配过滤条件的向量点
+func (s *MemoryVectorStore) DeletePoints(ctx context.Context, filter any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.points[:0]
+	for _, p := range s.points {
+		if !matchFilter(filter, p.payload) {
+			kept = append(kept, p)
+		}
+	}
+	s.points = kept
+
+	return nil
+}
+
+// matchFilter 支持本包构建的 Qdrant 过滤条件：must / should / must_not 下的 key + match.value、match.any、
+// match.text（按未建全文索引时的子串匹配处理，忽略大小写）、is_empty 以及嵌套的过滤条件
+func matchFilter(filter any, payload map[string]any) bool {
+	f, ok := filter.(map[string]any)
+	if !ok {
+		return true
+	}
+
+	for _, c := range filterConditions(f["must"]) {
+		if !matchCondition(c, payload) {
+			return false
+		}
+	}
+	for _, c := range filterConditions(f["must_not"]) {
+		if matchCondition(c, payload) {
+			return false
+		}
+	}
+	if should := filterConditions(f["should"]); len(should) > 0 {
+		return slices.ContainsFunc(should, func(c map[string]any) bool {
+			return matchCondition(c, payload)
+		})
+	}
+
+	return true
+}
+
+func filterConditions(v any) []map[string]any {
+	conditions, _ := v.([]map[string]any)
+	return conditions
+}
+
+func matchCondition(c map[string]any, payload map[string]any) bool {
+	if _, ok := c["key"]; !ok && (c["must"] != nil || c["should"] != nil || c["must_not"] != nil) {
+		return matchFilter(c, payload)
+	}
+	if empty, ok := c["is_empty"].(map[string]any); ok {
+		key, _ := empty["key"].(string)
+		value, ok := payload[key]
+		return !ok || value == nil
+	}
+
+	key, _ := c["key"].(string)
+	match, _ := c["match"].(map[string]any)
+	value, ok := payload[key]
+	if !ok || match == nil {
+		return false
+	}
+
+	if text, ok := match["text"].(string); ok {
+		content, _ := value.(string)
+		return strings.Contains(strings.ToLower(content), strings.ToLower(text))
+	}
+	// 数值类型在 payload 与过滤条件中可能不同（int / int64），按字面值比较
+	if values, ok := match["any"].([]any); ok {
+		return slices.ContainsFunc(values, func(v any) bool {
+			return fmt.Sprint(value) == fmt.Sprint(v)
+		})
+	}
+	return fmt.Sprint(value) == fmt.Sprint(match["value"])
+}
+
+// CosineSimilarity 计算两个向量的余弦相似度，维度不一致或存在零向量时返回 0
+func CosineSimilarity(a, b []float32) float32 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return float32(dot / (math.Sqrt(normA) * math.Sqrt(normB)))
+}
@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+)
+
+type PromptTemplateDAO struct {
+	db *gorm.DB
+}
+
+func NewPromptTemplateDAO(db *gorm.DB) *PromptTemplateDAO {
+	return &PromptTemplateDAO{db: db}
+}
+
+// CreatePromptTemplate 创建模板版本
+func (d *PromptTemplateDAO) CreatePromptTemplate(ctx context.Context, tpl *model.PromptTemplate) error {
+	return d.db.WithContext(ctx).Create(tpl).Error
+}
+
+// GetPromptTemplate 获取模板，version 为 0 时返回最新版本
+func (d *PromptTemplateDAO) GetPromptTemplate(ctx context.Context, name string, version int) (*model.PromptTemplate, error) {
+	var tpl model.PromptTemplate
+	query := d.db.WithContext(ctx).Where("name = ? AND deleted_at = 0", name)
+	if version > 0 {
+		query = query.Where("version = ?", version)
+	}
+	if err := query.Order("version DESC").First(&tpl).Error; err != nil {
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+// GetMaxPromptVersion 获取模板的最大版本号，包含已删除的版本，不存在时返回 0
+func (d *PromptTemplateDAO) GetMaxPromptVersion(ctx context.Context, name string) (int, error) {
+	var version int
+	if err := d.db.WithContext(ctx).Model(&model.PromptTemplate{}).
+		Select("COALESCE(MAX(version), 0)").Where("name = ?", name).Scan(&version).Error; err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// ListPromptTemplates 获取各模板的最新版本，feature 为空时返回全部
+func (d *PromptTemplateDAO) ListPromptTemplates(ctx context.Context, feature string, offset, limit int) ([]*model.PromptTemplate, int64, error) {
+	if limit < 0 {
+		return nil, 0, fmt.Errorf("limit 不能小于0")
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("offset 不能小于0")
+	}
+
+	latest := d.db.Model(&model.PromptTemplate{}).Select("MAX(id)").Where("deleted_at = 0").Group("name")
+	query := d.db.WithContext(ctx).Model(&model.PromptTemplate{}).Where("id IN (?)", latest)
+	if feature != "" {
+		query = query.Where("feature = ?", feature)
+	}
+
+	var (
+		templates []*model.PromptTemplate
+		total     int64
+	)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Order("name").Offset(offset).Limit(limit).Find(&templates).Error; err != nil {
+		return nil, 0, err
+	}
+	return templates, total, nil
+}
+
+// DeletePromptTemplate 删除模板的所有版本（软删除）
+func (d *PromptTemplateDAO) DeletePromptTemplate(ctx context.Context, name string) error {
+	return d.db.WithContext(ctx).Model(&model.PromptTemplate{}).Where("name = ? AND deleted_at = 0", name).
+		Update("deleted_at", time.Now().Unix()).Error
+}
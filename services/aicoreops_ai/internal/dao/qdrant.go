@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
 	"github.com/tmc/langchaingo/documentloaders"
 	"github.com/tmc/langchaingo/schema"
 	"github.com/tmc/langchaingo/textsplitter"
 	"github.com/tmc/langchaingo/vectorstores"
-	"github.com/tmc/langchaingo/vectorstores/qdrant"
+	"github.com/zeromicro/go-zero/core/logx"
 )
 
 // QdrantRepository 定义向量存储库接口
@@ -17,11 +19,14 @@ type QdrantRepository interface {
 	// TextToDocuments 将文本转换为文档
 	TextToDocuments(ctx context.Context, content []byte) ([]schema.Document, error)
 
+	// FileToDocuments 按格式解析文件并分块
+	FileToDocuments(ctx context.Context, format string, content []byte) ([]schema.Document, error)
+
 	// StoreDocuments 存储文档到向量库
 	StoreDocuments(ctx context.Context, docs []schema.Document) error
 
 	// SearchSimilarDocuments 搜索相似文档
-	SearchSimilarDocuments(ctx context.Context, query string, opts ...SearchOption) ([]schema.Document, error)
+	SearchSimilarDocuments(ctx context.Context, title, query string, opts ...SearchOption) ([]schema.Document, error)
 }
 
 // SearchOption 定义搜索选项
@@ -30,6 +35,8 @@ type SearchOption func(*searchOptions)
 type searchOptions struct {
 	scoreThreshold float32
 	topK           int
+	mode           string
+	knowledgeBases []int64
 }
 
 // WithScoreThreshold 设置相似度阈值选项
@@ -46,22 +53,56 @@ func WithTopK(k int) SearchOption {
 	}
 }
 
+// WithSearchMode 设置检索模式
+func WithSearchMode(mode string) SearchOption {
+	return func(o *searchOptions) {
+		o.mode = mode
+	}
+}
+
+// WithKnowledgeBases 限定检索的知识库，不设置时不过滤
+func WithKnowledgeBases(ids []int64) SearchOption {
+	return func(o *searchOptions) {
+		o.knowledgeBases = ids
+	}
+}
+
+const (
+	// contentKey langchaingo 在 payload 中存放分块内容的字段，Qdrant 中为其建有全文索引
+	contentKey = pkg.ContentPayloadKey
+	// knowledgeBaseKey payload 中存放所属知识库ID的字段
+	knowledgeBaseKey = "kb_id"
+	// hybridCandidateFactor 混合检索时每一路候选数量相对 topK 的倍数
+	hybridCandidateFactor = 3
+	// defaultKeywordCandidateLimit 未配置时关键词检索参与 BM25 排序的候选分块上限
+	defaultKeywordCandidateLimit = 1000
+)
+
+// PointStore 按 payload 过滤遍历、删除向量点，由 pkg.QdrantClient 实现
+type PointStore interface {
+	ScrollPoints(ctx context.Context, filter any, limit int) ([]pkg.QdrantPoint, error)
+	DeletePoints(ctx context.Context, filter any) error
+}
+
 // QdrantDAO 实现向量存储库接口
 type QdrantDAO struct {
-	store *qdrant.Store
-	opts  *options
+	store  vectorstores.VectorStore
+	client PointStore
+	opts   *options
 }
 
 type options struct {
-	chunkSize    int
-	chunkOverlap int
+	chunkSize             int
+	chunkOverlap          int
+	keywordCandidateLimit int
 }
 
 // NewQdrantDAO 创建 QdrantDAO 实例
-func NewQdrantDAO(store *qdrant.Store, opts ...Option) *QdrantDAO {
+func NewQdrantDAO(store vectorstores.VectorStore, client PointStore, opts ...Option) *QdrantDAO {
 	defaultOpts := &options{
-		chunkSize:    768,
-		chunkOverlap: 64,
+		chunkSize:             768,
+		chunkOverlap:          64,
+		keywordCandidateLimit: defaultKeywordCandidateLimit,
 	}
 
 	for _, opt := range opts {
@@ -69,8 +110,9 @@ func NewQdrantDAO(store *qdrant.Store, opts ...Option) *QdrantDAO {
 	}
 
 	return &QdrantDAO{
-		store: store,
-		opts:  defaultOpts,
+		store:  store,
+		client: client,
+		opts:   defaultOpts,
 	}
 }
 
@@ -91,6 +133,15 @@ func WithChunkOverlap(overlap int) Option {
 	}
 }
 
+// WithKeywordCandidateLimit 设置关键词检索时由 Qdrant 全文匹配返回、参与 BM25 排序的分块上限，超出时截断并记录日志
+func WithKeywordCandidateLimit(limit int) Option {
+	return func(o *options) {
+		if limit > 0 {
+			o.keywordCandidateLimit = limit
+		}
+	}
+}
+
 // TextToDocuments 实现文本转文档接口
 func (q *QdrantDAO) TextToDocuments(ctx context.Context, content []byte) ([]schema.Document, error) {
 	contentReader := bytes.NewReader(content)
@@ -108,18 +159,45 @@ func (q *QdrantDAO) TextToDocuments(ctx context.Context, content []byte) ([]sche
 	return docs, nil
 }
 
+// FileToDocuments 按格式解析文件，在章节内分块，分块元数据记录来源章节
+func (q *QdrantDAO) FileToDocuments(ctx context.Context, format string, content []byte) ([]schema.Document, error) {
+	docs, err := LoadDocuments(ctx, format, content)
+	if err != nil {
+		return nil, err
+	}
+
+	splitter := textsplitter.NewRecursiveCharacter()
+	splitter.ChunkSize = q.opts.chunkSize
+	splitter.ChunkOverlap = q.opts.chunkOverlap
+
+	chunks, err := textsplitter.SplitDocuments(splitter, docs)
+	if err != nil {
+		return nil, fmt.Errorf("split documents failed: %w", err)
+	}
+
+	return chunks, nil
+}
+
 // DocumentMetadata 定义文档元数据
 type DocumentMetadata struct {
-	Title string `json:"title"`
+	DocID    string `json:"doc_id"`
+	Title    string `json:"title"`
+	Revision int64  `json:"revision"` // 每次重新入库递增，用于替换旧向量
+	// KnowledgeBaseID 所属知识库，0 为公共知识库
+	KnowledgeBaseID int64 `json:"kb_id"`
 }
 
 // StoreDocumentWithMetadata 存储带元数据的文档
-func (q *QdrantDAO) StoreDocumentWithMetadata(ctx context.Context, docs []schema.Document, title string) error {
+func (q *QdrantDAO) StoreDocumentWithMetadata(ctx context.Context, docs []schema.Document, meta DocumentMetadata) error {
 	for i := range docs {
 		if docs[i].Metadata == nil {
 			docs[i].Metadata = make(map[string]any)
 		}
-		docs[i].Metadata["title"] = title
+		docs[i].Metadata["doc_id"] = meta.DocID
+		docs[i].Metadata["title"] = meta.Title
+		docs[i].Metadata["revision"] = meta.Revision
+		docs[i].Metadata[knowledgeBaseKey] = meta.KnowledgeBaseID
+		docs[i].Metadata["chunk_index"] = i
 	}
 
 	if _, err := q.store.AddDocuments(ctx, docs); err != nil {
@@ -129,37 +207,87 @@ func (q *QdrantDAO) StoreDocumentWithMetadata(ctx context.Context, docs []schema
 	return nil
 }
 
-// SearchSimilarDocuments 实现搜索相似文档接口
+// DeleteDocumentChunks 删除文档的向量分块，keepRevision 大于 0 时保留该版本的分块
+func (q *QdrantDAO) DeleteDocumentChunks(ctx context.Context, docID string, keepRevision int64) error {
+	filter := map[string]any{
+		"must": []map[string]any{
+			{"key": "doc_id", "match": map[string]any{"value": docID}},
+		},
+	}
+	if keepRevision > 0 {
+		filter["must_not"] = []map[string]any{
+			{"key": "revision", "match": map[string]any{"value": keepRevision}},
+		}
+	}
+
+	if err := q.client.DeletePoints(ctx, filter); err != nil {
+		return fmt.Errorf("delete documents failed: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteDocumentRevision 只删除文档指定版本的向量分块，用于回滚写入失败的新版本
+func (q *QdrantDAO) DeleteDocumentRevision(ctx context.Context, docID string, revision int64) error {
+	filter := map[string]any{
+		"must": []map[string]any{
+			{"key": "doc_id", "match": map[string]any{"value": docID}},
+			{"key": "revision", "match": map[string]any{"value": revision}},
+		},
+	}
+
+	if err := q.client.DeletePoints(ctx, filter); err != nil {
+		return fmt.Errorf("delete documents failed: %w", err)
+	}
+
+	return nil
+}
+
+// SearchSimilarDocuments 实现搜索相似文档接口，按检索模式选择向量检索、关键词检索或两者融合
 func (q *QdrantDAO) SearchSimilarDocuments(ctx context.Context, title, query string, opts ...SearchOption) ([]schema.Document, error) {
 	options := &searchOptions{
 		scoreThreshold: 0.3,
 		topK:           5,
+		mode:           SearchModeVector,
 	}
 
 	for _, opt := range opts {
 		opt(options)
 	}
 
+	filter := searchFilter(title, options.knowledgeBases)
+	switch options.mode {
+	case SearchModeVector, "":
+		return q.vectorSearch(ctx, filter, query, options.scoreThreshold, options.topK)
+	case SearchModeKeyword:
+		return q.keywordSearch(ctx, filter, query, options.topK)
+	case SearchModeHybrid:
+		// 两路各多取一些候选，融合后再截断
+		candidates := options.topK * hybridCandidateFactor
+		vectorDocs, err := q.vectorSearch(ctx, filter, query, options.scoreThreshold, candidates)
+		if err != nil {
+			return nil, err
+		}
+		keywordDocs, err := q.keywordSearch(ctx, filter, query, candidates)
+		if err != nil {
+			return nil, err
+		}
+		return FuseRRF(options.topK, vectorDocs, keywordDocs), nil
+	default:
+		return nil, fmt.Errorf("unsupported search mode: %s", options.mode)
+	}
+}
+
+func (q *QdrantDAO) vectorSearch(ctx context.Context, filter map[string]any, query string, scoreThreshold float32, topK int) ([]schema.Document, error) {
 	// 添加元数据过滤选项
 	retrievalOpts := []vectorstores.Option{
-		vectorstores.WithScoreThreshold(options.scoreThreshold),
+		vectorstores.WithScoreThreshold(scoreThreshold),
 	}
-	if title != "" {
-		// 使用 Qdrant 的过滤语法
-		filter := map[string]interface{}{
-			"must": []map[string]interface{}{
-				{
-					"key": "title",
-					"match": map[string]interface{}{
-						"value": title,
-					},
-				},
-			},
-		}
+	if filter != nil {
 		retrievalOpts = append(retrievalOpts, vectorstores.WithFilters(filter))
 	}
 
-	retriever := vectorstores.ToRetriever(q.store, options.topK, retrievalOpts...)
+	retriever := vectorstores.ToRetriever(q.store, topK, retrievalOpts...)
 	docs, err := retriever.GetRelevantDocuments(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("search documents failed: %w", err)
@@ -167,3 +295,103 @@ func (q *QdrantDAO) SearchSimilarDocuments(ctx context.Context, title, query str
 
 	return docs, nil
 }
+
+// keywordSearch 由 Qdrant 全文索引筛出候选分块，再对候选计算 BM25 得分；
+// 先只取包含全部检索词的分块以缩小候选集，不足 topK 个时放宽为包含任一检索词
+func (q *QdrantDAO) keywordSearch(ctx context.Context, filter map[string]any, query string, topK int) ([]schema.Document, error) {
+	terms := keywordTerms(tokenize(query))
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	points, err := q.keywordCandidates(ctx, keywordFilter(filter, terms, true), topK)
+	if err != nil {
+		return nil, err
+	}
+	if len(points) < topK && len(terms) > 1 {
+		if points, err = q.keywordCandidates(ctx, keywordFilter(filter, terms, false), topK); err != nil {
+			return nil, err
+		}
+	}
+
+	docs := make([]schema.Document, 0, len(points))
+	for _, p := range points {
+		content, ok := p.Payload[contentKey].(string)
+		if !ok {
+			continue
+		}
+		delete(p.Payload, contentKey)
+		docs = append(docs, schema.Document{PageContent: content, Metadata: p.Payload})
+	}
+
+	return BM25Search(docs, query, topK), nil
+}
+
+// keywordCandidates 分页获取全文匹配的全部候选分块，超过上限时截断并记录日志
+func (q *QdrantDAO) keywordCandidates(ctx context.Context, filter map[string]any, topK int) ([]pkg.QdrantPoint, error) {
+	limit := max(q.opts.keywordCandidateLimit, topK)
+	points, err := q.client.ScrollPoints(ctx, filter, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("scroll documents failed: %w", err)
+	}
+	if len(points) > limit {
+		logx.WithContext(ctx).Infof("关键词检索候选分块超过上限 %d，只对前 %d 个计算 BM25 得分", limit, limit)
+		points = points[:limit]
+	}
+
+	return points, nil
+}
+
+// keywordTerms 对检索词排序去重，保证生成的过滤条件稳定
+func keywordTerms(terms []string) []string {
+	terms = slices.Clone(terms)
+	slices.Sort(terms)
+	return slices.Compact(terms)
+}
+
+// keywordFilter 在检索过滤条件上追加全文匹配条件，all 为 true 时分块内容须包含全部检索词，否则包含任一检索词即命中
+func keywordFilter(filter map[string]any, terms []string, all bool) map[string]any {
+	conditions := make([]map[string]any, 0, len(terms))
+	for _, t := range terms {
+		conditions = append(conditions, map[string]any{"key": contentKey, "match": map[string]any{"text": t}})
+	}
+
+	must := []map[string]any{{"should": conditions}}
+	if all {
+		must = conditions
+	}
+	if filter != nil {
+		must = append(must, filter)
+	}
+	return map[string]any{"must": must}
+}
+
+// searchFilter 构建按文档标题和知识库过滤的 Qdrant 过滤条件，均未指定时不过滤；
+// 公共知识库包含引入知识库之前写入、payload 中没有 kb_id 的分块
+func searchFilter(title string, kbIDs []int64) map[string]any {
+	filter := map[string]any{}
+	if title != "" {
+		filter["must"] = []map[string]any{
+			{"key": "title", "match": map[string]any{"value": title}},
+		}
+	}
+
+	if kbIDs != nil {
+		ids := make([]any, 0, len(kbIDs))
+		for _, id := range kbIDs {
+			ids = append(ids, id)
+		}
+		should := []map[string]any{
+			{"key": knowledgeBaseKey, "match": map[string]any{"any": ids}},
+		}
+		if slices.Contains(kbIDs, 0) {
+			should = append(should, map[string]any{"is_empty": map[string]any{"key": knowledgeBaseKey}})
+		}
+		filter["should"] = should
+	}
+
+	if len(filter) == 0 {
+		return nil
+	}
+	return filter
+}
@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+)
+
+type stubPointStore struct {
+	points  []pkg.QdrantPoint
+	filters []any
+	limit   int
+}
+
+func (s *stubPointStore) ScrollPoints(ctx context.Context, filter any, limit int) ([]pkg.QdrantPoint, error) {
+	s.filters, s.limit = append(s.filters, filter), limit
+	return s.points[:min(limit, len(s.points))], nil
+}
+
+func (s *stubPointStore) DeletePoints(ctx context.Context, filter any) error {
+	return nil
+}
+
+func TestKeywordSearch(t *testing.T) {
+	ctx := context.Background()
+	newStore := func() *stubPointStore {
+		return &stubPointStore{points: []pkg.QdrantPoint{
+			{Payload: map[string]any{contentKey: "数据库慢查询排查步骤", "doc_id": "c"}},
+			{Payload: map[string]any{contentKey: "出现 ERR-5003 时先检查数据库连接池", "doc_id": "b"}},
+		}}
+	}
+	terms := []map[string]any{
+		{"key": contentKey, "match": map[string]any{"text": "err-5003"}},
+		{"key": contentKey, "match": map[string]any{"text": "据库"}},
+		{"key": contentKey, "match": map[string]any{"text": "数据"}},
+	}
+
+	// 包含全部检索词的候选足够时不放宽条件
+	store := newStore()
+	q := NewQdrantDAO(nil, store, WithKeywordCandidateLimit(50))
+	docs, err := q.SearchSimilarDocuments(ctx, "", "ERR-5003 数据库", WithSearchMode(SearchModeKeyword), WithTopK(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 1 || docs[0].Metadata["doc_id"] != "b" {
+		t.Fatalf("unexpected result: %+v", docs)
+	}
+	if store.limit != 51 {
+		t.Errorf("expected candidate limit 50 plus one to detect truncation, got %d", store.limit)
+	}
+	if want := []any{map[string]any{"must": terms}}; !reflect.DeepEqual(store.filters, want) {
+		t.Errorf("filters = %v, want %v", store.filters, want)
+	}
+
+	// 不足 topK 个时放宽为包含任一检索词
+	store = newStore()
+	q = NewQdrantDAO(nil, store, WithKeywordCandidateLimit(50))
+	if _, err = q.SearchSimilarDocuments(ctx, "", "ERR-5003 数据库", WithSearchMode(SearchModeKeyword), WithTopK(5)); err != nil {
+		t.Fatal(err)
+	}
+	want := []any{
+		map[string]any{"must": terms},
+		map[string]any{"must": []map[string]any{{"should": terms}}},
+	}
+	if !reflect.DeepEqual(store.filters, want) {
+		t.Errorf("filters = %v, want %v", store.filters, want)
+	}
+
+	// 超过上限时只对上限内的候选排序
+	store = newStore()
+	q = NewQdrantDAO(nil, store, WithKeywordCandidateLimit(1))
+	docs, err = q.SearchSimilarDocuments(ctx, "", "数据库", WithSearchMode(SearchModeKeyword), WithTopK(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 1 || docs[0].Metadata["doc_id"] != "c" {
+		t.Errorf("unexpected result: %+v", docs)
+	}
+}
+
+func TestKeywordFilter(t *testing.T) {
+	base := searchFilter("runbook", nil)
+	for _, all := range []bool{true, false} {
+		f := keywordFilter(base, []string{"oom"}, all)
+		must, _ := f["must"].([]map[string]any)
+		if len(must) != 2 || !reflect.DeepEqual(must[1], base) {
+			t.Errorf("all=%v: expected text condition combined with base filter, got %v", all, f)
+		}
+	}
+
+	if got := keywordTerms([]string{"oom", "err", "oom"}); !reflect.DeepEqual(got, []string{"err", "oom"}) {
+		t.Errorf("keywordTerms = %v", got)
+	}
+}
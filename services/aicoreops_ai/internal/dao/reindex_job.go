@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+)
+
+type ReindexJobDAO struct {
+	db *gorm.DB
+}
+
+func NewReindexJobDAO(db *gorm.DB) *ReindexJobDAO {
+	return &ReindexJobDAO{db: db}
+}
+
+// CreateReindexJob 创建重建索引任务
+func (d *ReindexJobDAO) CreateReindexJob(ctx context.Context, job *model.ReindexJob) error {
+	return d.db.WithContext(ctx).Create(job).Error
+}
+
+// GetReindexJob 根据ID获取重建索引任务
+func (d *ReindexJobDAO) GetReindexJob(ctx context.Context, id int64) (*model.ReindexJob, error) {
+	var job model.ReindexJob
+	if err := d.db.WithContext(ctx).Where("id = ?", id).First(&job).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// GetLatestReindexJob 获取最近创建的重建索引任务，没有任务时返回 nil
+func (d *ReindexJobDAO) GetLatestReindexJob(ctx context.Context) (*model.ReindexJob, error) {
+	var job model.ReindexJob
+	err := d.db.WithContext(ctx).Order("id DESC").First(&job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ListReindexJobs 按创建顺序获取处于指定状态的任务
+func (d *ReindexJobDAO) ListReindexJobs(ctx context.Context, statuses []string) ([]*model.ReindexJob, error) {
+	var jobs []*model.ReindexJob
+	if err := d.db.WithContext(ctx).Where("status IN ?", statuses).Order("id ASC").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ClaimReindexJob 以当前状态为条件更新，避免多个副本同时执行同一任务
+func (d *ReindexJobDAO) ClaimReindexJob(ctx context.Context, id int64, from []string, to string, staleBefore int64) (bool, error) {
+	res := d.db.WithContext(ctx).Model(&model.ReindexJob{}).
+		Where("id = ? AND (status IN ? OR (status = ? AND updated_at < ?))", id, from, to, staleBefore).
+		Updates(map[string]any{"status": to, "message": ""})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// UpdateReindexJob 更新任务进度或状态
+func (d *ReindexJobDAO) UpdateReindexJob(ctx context.Context, id int64, fields map[string]any) error {
+	return d.db.WithContext(ctx).Model(&model.ReindexJob{}).Where("id = ?", id).Updates(fields).Error
+}
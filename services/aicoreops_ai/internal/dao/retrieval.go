@@ -0,0 +1,249 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/tmc/langchaingo/llms"
+	"github.com/tmc/langchaingo/schema"
+)
+
+// 检索模式
+const (
+	SearchModeVector  = "vector"
+	SearchModeKeyword = "keyword"
+	SearchModeHybrid  = "hybrid"
+)
+
+const (
+	// bm25K1 词频饱和参数
+	bm25K1 = 1.2
+	// bm25B 文档长度归一化参数
+	bm25B = 0.75
+	// rrfK 倒数排名融合的平滑常数
+	rrfK = 60
+)
+
+// tokenize 将文本切分为检索词：英文、数字按完整词保留（错误码、指标名、Pod 名等不拆开），中文按二元组切分
+func tokenize(text string) []string {
+	var (
+		tokens []string
+		word   []rune
+		han    []rune
+	)
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.Trim(strings.ToLower(string(word)), "-_.:/"))
+			word = word[:0]
+		}
+	}
+	flushHan := func() {
+		switch {
+		case len(han) == 1:
+			tokens = append(tokens, string(han))
+		case len(han) > 1:
+			for i := 0; i+1 < len(han); i++ {
+				tokens = append(tokens, string(han[i:i+2]))
+			}
+		}
+		han = han[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-_.:/", r):
+			flushHan()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushHan()
+		}
+	}
+	flushWord()
+	flushHan()
+
+	res := tokens[:0]
+	for _, t := range tokens {
+		if t != "" {
+			res = append(res, t)
+		}
+	}
+
+	return res
+}
+
+// BM25Search 在候选分块上计算 BM25 得分，返回得分最高的 topK 个，Score 字段记录 BM25 得分
+func BM25Search(docs []schema.Document, query string, topK int) []schema.Document {
+	terms := tokenize(query)
+	if len(terms) == 0 || len(docs) == 0 {
+		return nil
+	}
+
+	// 1. 统计词频、文档长度与文档频率
+	tfs := make([]map[string]int, len(docs))
+	lengths := make([]int, len(docs))
+	df := make(map[string]int)
+	totalLen := 0
+	for i, doc := range docs {
+		tf := make(map[string]int)
+		for _, t := range tokenize(doc.PageContent) {
+			tf[t]++
+			lengths[i]++
+		}
+		for t := range tf {
+			df[t]++
+		}
+		tfs[i] = tf
+		totalLen += lengths[i]
+	}
+	avgLen := float64(totalLen) / float64(len(docs))
+	if avgLen == 0 {
+		return nil
+	}
+
+	// 2. 计算得分
+	n := float64(len(docs))
+	res := make([]schema.Document, 0, len(docs))
+	for i, doc := range docs {
+		var score float64
+		for _, t := range terms {
+			f := float64(tfs[i][t])
+			if f == 0 {
+				continue
+			}
+			idf := math.Log(1 + (n-float64(df[t])+0.5)/(float64(df[t])+0.5))
+			score += idf * f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*float64(lengths[i])/avgLen))
+		}
+		if score > 0 {
+			doc.Score = float32(score)
+			res = append(res, doc)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Score > res[j].Score })
+	if topK > 0 && len(res) > topK {
+		res = res[:topK]
+	}
+
+	return res
+}
+
+// FusionScoreKey 元数据中记录倒数排名融合得分的字段
+const FusionScoreKey = "fusion_score"
+
+// FuseRRF 使用倒数排名融合多路检索结果并按融合得分排序；Score 字段保留分块在最先传入的、
+// 命中它的那一路结果中的原始得分（混合检索时优先为向量相似度），融合得分记录在元数据 FusionScoreKey 中
+func FuseRRF(topK int, lists ...[]schema.Document) []schema.Document {
+	var (
+		order  []string
+		docs   = make(map[string]schema.Document)
+		scores = make(map[string]float64)
+	)
+
+	for _, list := range lists {
+		for rank, doc := range list {
+			key := chunkKey(doc)
+			if _, ok := docs[key]; !ok {
+				docs[key] = doc
+				order = append(order, key)
+			}
+			scores[key] += 1 / float64(rrfK+rank+1)
+		}
+	}
+
+	res := make([]schema.Document, 0, len(order))
+	for _, key := range order {
+		doc := docs[key]
+		doc.Metadata = maps.Clone(doc.Metadata)
+		if doc.Metadata == nil {
+			doc.Metadata = make(map[string]any)
+		}
+		doc.Metadata[FusionScoreKey] = float32(scores[key])
+		res = append(res, doc)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool { return scores[chunkKey(res[i])] > scores[chunkKey(res[j])] })
+	if topK > 0 && len(res) > topK {
+		res = res[:topK]
+	}
+
+	return res
+}
+
+// chunkKey 分块唯一标识，用于多路结果去重
+func chunkKey(doc schema.Document) string {
+	if docID, ok := doc.Metadata["doc_id"]; ok {
+		return fmt.Sprintf("%v:%v", docID, doc.Metadata["chunk_index"])
+	}
+	return doc.PageContent
+}
+
+// Reranker 对检索结果重新排序
+type Reranker interface {
+	Rerank(ctx context.Context, query string, docs []schema.Document) ([]schema.Document, error)
+}
+
+// LLMReranker 使用大模型为每个分块打相关性分
+type LLMReranker struct {
+	llm llms.Model
+}
+
+// NewLLMReranker 创建基于大模型的重排序器
+func NewLLMReranker(llm llms.Model) *LLMReranker {
+	return &LLMReranker{llm: llm}
+}
+
+var jsonArrayRegexp = regexp.MustCompile(`(?s)\[.*\]`)
+
+// Rerank 让大模型一次性为所有分块给出 0-10 的相关性评分，按评分重新排序；Score 字段记录归一化到 0-1 的评分
+func (r *LLMReranker) Rerank(ctx context.Context, query string, docs []schema.Document) ([]schema.Document, error) {
+	if len(docs) <= 1 {
+		return docs, nil
+	}
+
+	var sb strings.Builder
+	for i, doc := range docs {
+		fmt.Fprintf(&sb, "[%d] %s\n\n", i, doc.PageContent)
+	}
+
+	prompt := fmt.Sprintf(`你是运维知识库的检索评估助手。请判断下列文档片段与问题的相关性，为每个片段给出 0-10 的整数评分。
+只输出 JSON 数组，数组第 i 个元素为编号 i 的片段评分，例如 [8, 2, 5]。
+
+问题: %s
+
+文档片段:
+%s`, query, sb.String())
+
+	resp, err := llms.GenerateFromSinglePrompt(ctx, r.llm, prompt, llms.WithTemperature(0))
+	if err != nil {
+		return nil, fmt.Errorf("rerank failed: %w", err)
+	}
+
+	var scores []float64
+	if err = json.Unmarshal([]byte(jsonArrayRegexp.FindString(resp)), &scores); err != nil {
+		return nil, fmt.Errorf("parse rerank result failed: %w", err)
+	}
+	if len(scores) != len(docs) {
+		return nil, fmt.Errorf("rerank result size mismatch: got %d, want %d", len(scores), len(docs))
+	}
+
+	res := make([]schema.Document, len(docs))
+	copy(res, docs)
+	for i := range res {
+		res[i].Score = float32(scores[i] / 10)
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Score > res[j].Score })
+
+	return res, nil
+}
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tmc/langchaingo/llms"
+	"github.com/tmc/langchaingo/schema"
+)
+
+func TestTokenize(t *testing.T) {
+	got := tokenize("Pod api-server-7d9f 报错 OOMKilled, node_memory_MemAvailable_bytes 下降")
+	want := []string{"pod", "api-server-7d9f", "报错", "oomkilled", "node_memory_memavailable_bytes", "下降"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tokenize = %q, want %q", got, want)
+	}
+}
+
+func chunk(docID string, index int, content string) schema.Document {
+	return schema.Document{
+		PageContent: content,
+		Metadata:    map[string]any{"doc_id": docID, "chunk_index": index},
+	}
+}
+
+func TestBM25Search(t *testing.T) {
+	docs := []schema.Document{
+		chunk("a", 0, "重启 nginx 服务后检查端口"),
+		chunk("b", 0, "出现 ERR-5003 时先检查数据库连接池"),
+		chunk("c", 0, "数据库慢查询排查步骤"),
+	}
+
+	res := BM25Search(docs, "ERR-5003 怎么处理", 2)
+	if len(res) != 1 {
+		t.Fatalf("expected 1 match, got %d", len(res))
+	}
+	if res[0].Metadata["doc_id"] != "b" || res[0].Score <= 0 {
+		t.Errorf("unexpected result: %+v", res[0])
+	}
+
+	res = BM25Search(docs, "数据库", 0)
+	if len(res) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(res))
+	}
+}
+
+func TestFuseRRF(t *testing.T) {
+	vector := []schema.Document{chunk("a", 0, "a"), chunk("b", 0, "b"), chunk("c", 0, "c")}
+	keyword := []schema.Document{chunk("c", 0, "c"), chunk("d", 0, "d")}
+	for i := range vector {
+		vector[i].Score = 0.9 - float32(i)*0.1
+	}
+	keyword[0].Score = 7.5
+
+	res := FuseRRF(3, vector, keyword)
+	if len(res) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(res))
+	}
+	if res[0].Metadata["doc_id"] != "c" {
+		t.Errorf("expected chunk found by both retrievers first, got %v", res[0].Metadata["doc_id"])
+	}
+	if res[0].Score != vector[2].Score {
+		t.Errorf("expected vector score %v to be kept, got %v", vector[2].Score, res[0].Score)
+	}
+	if fused, _ := res[0].Metadata[FusionScoreKey].(float32); fused <= 0 {
+		t.Errorf("expected fusion score in metadata, got %v", res[0].Metadata[FusionScoreKey])
+	}
+	if _, ok := vector[2].Metadata[FusionScoreKey]; ok {
+		t.Error("input metadata should not be modified")
+	}
+}
+
+type stubLLM struct {
+	reply string
+}
+
+func (s *stubLLM) GenerateContent(ctx context.Context, messages []llms.MessageContent, options ...llms.CallOption) (*llms.ContentResponse, error) {
+	return &llms.ContentResponse{Choices: []*llms.ContentChoice{{Content: s.reply}}}, nil
+}
+
+func (s *stubLLM) Call(ctx context.Context, prompt string, options ...llms.CallOption) (string, error) {
+	return s.reply, nil
+}
+
+func TestLLMReranker_Rerank(t *testing.T) {
+	docs := []schema.Document{chunk("a", 0, "a"), chunk("b", 0, "b"), chunk("c", 0, "c")}
+
+	res, err := NewLLMReranker(&stubLLM{reply: "评分如下: [2, 9, 5]"}).Rerank(context.Background(), "q", docs)
+	if err != nil {
+		t.Fatalf("Rerank failed: %v", err)
+	}
+	if res[0].Metadata["doc_id"] != "b" || res[1].Metadata["doc_id"] != "c" || res[0].Score != 0.9 {
+		t.Errorf("unexpected order: %+v", res)
+	}
+
+	if _, err = NewLLMReranker(&stubLLM{reply: "[1]"}).Rerank(context.Background(), "q", docs); err == nil {
+		t.Error("expected error on size mismatch")
+	}
+}
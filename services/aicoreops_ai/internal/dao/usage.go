@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+)
+
+// UsageDAO token 用量记录在 history 表中，删除会话不影响用量统计
+type UsageDAO struct {
+	db *gorm.DB
+}
+
+func NewUsageDAO(db *gorm.DB) *UsageDAO {
+	return &UsageDAO{db: db}
+}
+
+// SumUserTokens 统计用户自 since 起消耗的 token 数
+func (d *UsageDAO) SumUserTokens(ctx context.Context, userId int64, since int64) (int64, error) {
+	var total int64
+	if err := d.db.WithContext(ctx).Model(&model.History{}).
+		Select("COALESCE(SUM(total_tokens), 0)").
+		Where("user_id = ? AND created_at >= ?", userId, since).
+		Scan(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// GetUsageStats 按用户和日期聚合 [start, end) 内的用量，userId 为 0 时统计所有用户
+func (d *UsageDAO) GetUsageStats(ctx context.Context, userId int64, start, end int64) ([]*model.UsageStat, error) {
+	query := d.db.WithContext(ctx).Model(&model.History{}).
+		Select("user_id, DATE_FORMAT(FROM_UNIXTIME(created_at), '%Y-%m-%d') AS date, "+
+			"SUM(prompt_tokens) AS prompt_tokens, SUM(completion_tokens) AS completion_tokens, "+
+			"SUM(total_tokens) AS total_tokens, COUNT(*) AS requests").
+		Where("created_at >= ? AND created_at < ?", start, end)
+	if userId > 0 {
+		query = query.Where("user_id = ?", userId)
+	}
+
+	var stats []*model.UsageStat
+	if err := query.Group("user_id, date").Order("date, user_id").Scan(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+)
+
+type VectorCollectionDAO struct {
+	db *gorm.DB
+}
+
+func NewVectorCollectionDAO(db *gorm.DB) *VectorCollectionDAO {
+	return &VectorCollectionDAO{db: db}
+}
+
+// GetActiveCollection 获取启用的集合，尚未记录时返回 nil
+func (d *VectorCollectionDAO) GetActiveCollection(ctx context.Context) (*model.VectorCollection, error) {
+	var col model.VectorCollection
+	err := d.db.WithContext(ctx).Where("active = ?", true).Order("updated_at DESC").First(&col).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &col, nil
+}
+
+// ActivateCollection 在同一事务中停用其他集合并启用指定集合
+func (d *VectorCollectionDAO) ActivateCollection(ctx context.Context, name, embeddingModel string) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.VectorCollection{}).Where("active = ? AND name <> ?", true, name).
+			Update("active", false).Error; err != nil {
+			return err
+		}
+
+		var col model.VectorCollection
+		err := tx.Where("name = ?", name).First(&col).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Create(&model.VectorCollection{Name: name, EmbeddingModel: embeddingModel, Active: true}).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&col).Updates(map[string]any{"embedding_model": embeddingModel, "active": true}).Error
+	})
+}
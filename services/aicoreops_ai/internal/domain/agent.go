@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+const (
+	// defaultAgentMaxSteps 未配置时单次提问最多的工具调用轮数
+	defaultAgentMaxSteps = 5
+	// maxToolResultRunes 工具结果回填给大模型时保留的最大字符数
+	maxToolResultRunes = 8000
+)
+
+const agentSystemPrompt = `你是 AICoreOps 平台的运维助手，可以调用工具查询平台的实时状态，例如告警规则、服务树节点、绑定的主机以及用户的历史会话。
+需要实时数据时先调用工具，不要编造工具没有返回的信息；信息足够后直接用中文给出回答。`
+
+// AgentTool Agent 可调用的工具
+type AgentTool struct {
+	Name        string
+	Description string
+	Parameters  map[string]any // JSON Schema
+	// Call 执行工具，uid 为调用者ID，工具需按调用者身份做数据隔离
+	Call func(ctx context.Context, uid int64, args json.RawMessage) (any, error)
+}
+
+// AgentEvent Agent 执行过程中产生的工具调用或工具结果
+type AgentEvent struct {
+	ToolCall   *types.ToolCall
+	ToolResult *types.ToolResult
+}
+
+type AgentDomain struct {
+	llm      llms.Model
+	tools    map[string]*AgentTool
+	acl      map[string][]int64
+	maxSteps int
+}
+
+// NewAgentDomain 创建 Agent，acl 为工具名到允许调用的用户ID列表，未出现在 acl 中的工具对所有用户开放
+func NewAgentDomain(llm llms.Model, tools []*AgentTool, acl map[string][]int64, maxSteps int) *AgentDomain {
+	if maxSteps <= 0 {
+		maxSteps = defaultAgentMaxSteps
+	}
+
+	registry := make(map[string]*AgentTool, len(tools))
+	for _, t := range tools {
+		registry[t.Name] = t
+	}
+
+	return &AgentDomain{
+		llm:      llm,
+		tools:    registry,
+		acl:      acl,
+		maxSteps: maxSteps,
+	}
+}
+
+// Allowed 判断用户是否有权限调用工具
+func (d *AgentDomain) Allowed(uid int64, name string) bool {
+	users, ok := d.acl[name]
+	if !ok {
+		return true
+	}
+	for _, u := range users {
+		if u == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedTools 返回用户有权限调用的工具，按名称排序
+func (d *AgentDomain) AllowedTools(uid int64) []*AgentTool {
+	res := make([]*AgentTool, 0, len(d.tools))
+	for name, t := range d.tools {
+		if d.Allowed(uid, name) {
+			res = append(res, t)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// Run 执行 Agent 循环：大模型决定调用工具时执行工具并回填结果，直到给出最终回答或达到轮数上限；
+// 每次工具调用和结果都会通过 emit 推送，返回最终回答和累计的 token 用量
+func (d *AgentDomain) Run(ctx context.Context, uid int64, messages []llms.MessageContent, emit func(AgentEvent) error) (string, TokenUsage, error) {
+	var usage TokenUsage
+
+	allowed := d.AllowedTools(uid)
+	llmTools := make([]llms.Tool, 0, len(allowed))
+	for _, t := range allowed {
+		llmTools = append(llmTools, llms.Tool{
+			Type: "function",
+			Function: &llms.FunctionDefinition{
+				Name:        t.Name,
+				Description: t.Description,
+				Parameters:  t.Parameters,
+			},
+		})
+	}
+
+	history := make([]llms.MessageContent, 0, len(messages)+1)
+	history = append(history, llms.TextParts(llms.ChatMessageTypeSystem, agentSystemPrompt))
+	history = append(history, messages...)
+
+	for step := 0; step < d.maxSteps; step++ {
+		opts := []llms.CallOption{}
+		if len(llmTools) > 0 {
+			opts = append(opts, llms.WithTools(llmTools))
+		}
+
+		resp, err := d.llm.GenerateContent(ctx, history, opts...)
+		if err != nil {
+			return "", usage, fmt.Errorf("生成回答失败: %w", err)
+		}
+		if len(resp.Choices) == 0 {
+			return "", usage, fmt.Errorf("大模型未返回结果")
+		}
+		usage.Add(resp)
+
+		choice := resp.Choices[0]
+		if len(choice.ToolCalls) == 0 {
+			return choice.Content, usage, nil
+		}
+
+		// 记录大模型发起的工具调用，再逐个执行并回填结果
+		assistant := llms.MessageContent{Role: llms.ChatMessageTypeAI}
+		if choice.Content != "" {
+			assistant.Parts = append(assistant.Parts, llms.TextContent{Text: choice.Content})
+		}
+		for _, call := range choice.ToolCalls {
+			assistant.Parts = append(assistant.Parts, call)
+		}
+		history = append(history, assistant)
+
+		for _, call := range choice.ToolCalls {
+			result, err := d.callTool(ctx, uid, call, emit)
+			if err != nil {
+				return "", usage, err
+			}
+			history = append(history, llms.MessageContent{
+				Role:  llms.ChatMessageTypeTool,
+				Parts: []llms.ContentPart{result},
+			})
+		}
+	}
+
+	// 达到轮数上限时不再提供工具，要求大模型基于已有信息作答
+	resp, err := d.llm.GenerateContent(ctx, append(history,
+		llms.TextParts(llms.ChatMessageTypeHuman, "工具调用次数已达上限，请根据已获取的信息直接回答。")))
+	if err != nil {
+		return "", usage, fmt.Errorf("生成回答失败: %w", err)
+	}
+	if len(resp.Choices) == 0 {
+		return "", usage, fmt.Errorf("大模型未返回结果")
+	}
+	usage.Add(resp)
+
+	return resp.Choices[0].Content, usage, nil
+}
+
+// callTool 执行单个工具调用，工具自身的错误作为结果返回给大模型，只有推送失败才中断
+func (d *AgentDomain) callTool(ctx context.Context, uid int64, call llms.ToolCall, emit func(AgentEvent) error) (llms.ToolCallResponse, error) {
+	var name, args string
+	if call.FunctionCall != nil {
+		name, args = call.FunctionCall.Name, call.FunctionCall.Arguments
+	}
+
+	if err := emit(AgentEvent{ToolCall: &types.ToolCall{Id: call.ID, Name: name, Arguments: args}}); err != nil {
+		return llms.ToolCallResponse{}, err
+	}
+
+	content, isErr := d.invoke(ctx, uid, name, args)
+	if err := emit(AgentEvent{ToolResult: &types.ToolResult{Id: call.ID, Name: name, Content: content, IsError: isErr}}); err != nil {
+		return llms.ToolCallResponse{}, err
+	}
+
+	return llms.ToolCallResponse{ToolCallID: call.ID, Name: name, Content: content}, nil
+}
+
+func (d *AgentDomain) invoke(ctx context.Context, uid int64, name, args string) (string, bool) {
+	tool, ok := d.tools[name]
+	if !ok {
+		return fmt.Sprintf("工具 %s 不存在", name), true
+	}
+	if !d.Allowed(uid, name) {
+		return fmt.Sprintf("没有调用工具 %s 的权限", name), true
+	}
+
+	if args == "" {
+		args = "{}"
+	}
+	if !json.Valid([]byte(args)) {
+		return "工具参数不是合法的 JSON", true
+	}
+
+	res, err := tool.Call(ctx, uid, json.RawMessage(args))
+	if err != nil {
+		return fmt.Sprintf("调用工具 %s 失败: %v", name, err), true
+	}
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		return fmt.Sprintf("序列化工具结果失败: %v", err), true
+	}
+
+	content := string(b)
+	if utf8.RuneCountInString(content) > maxToolResultRunes {
+		content = truncateRunes(content, maxToolResultRunes) + "...(truncated)"
+	}
+	return content, false
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// scriptedLLM 按顺序返回预设的结果，并记录每次提供给大模型的工具
+type scriptedLLM struct {
+	choices []*llms.ContentChoice
+	tools   [][]string
+	calls   [][]llms.MessageContent
+}
+
+func (s *scriptedLLM) GenerateContent(ctx context.Context, messages []llms.MessageContent, options ...llms.CallOption) (*llms.ContentResponse, error) {
+	opts := llms.CallOptions{}
+	for _, o := range options {
+		o(&opts)
+	}
+	names := make([]string, 0, len(opts.Tools))
+	for _, t := range opts.Tools {
+		names = append(names, t.Function.Name)
+	}
+	s.tools = append(s.tools, names)
+	s.calls = append(s.calls, messages)
+
+	if len(s.choices) == 0 {
+		return nil, fmt.Errorf("no reply")
+	}
+	choice := s.choices[0]
+	s.choices = s.choices[1:]
+	return &llms.ContentResponse{Choices: []*llms.ContentChoice{choice}}, nil
+}
+
+func (s *scriptedLLM) Call(ctx context.Context, prompt string, options ...llms.CallOption) (string, error) {
+	return llms.GenerateFromSinglePrompt(ctx, s, prompt, options...)
+}
+
+func toolCallChoice(id, name, args string) *llms.ContentChoice {
+	return &llms.ContentChoice{ToolCalls: []llms.ToolCall{{
+		ID:           id,
+		Type:         "function",
+		FunctionCall: &llms.FunctionCall{Name: name, Arguments: args},
+	}}}
+}
+
+func echoTool(name string, calls *[]int64) *AgentTool {
+	return &AgentTool{
+		Name:       name,
+		Parameters: objectSchema(map[string]any{"q": map[string]any{"type": "string"}}),
+		Call: func(ctx context.Context, uid int64, args json.RawMessage) (any, error) {
+			*calls = append(*calls, uid)
+			var a struct {
+				Q string `json:"q"`
+			}
+			if err := json.Unmarshal(args, &a); err != nil {
+				return nil, err
+			}
+			return map[string]string{"echo": a.Q}, nil
+		},
+	}
+}
+
+func TestAgentRunToolLoop(t *testing.T) {
+	var calls []int64
+	llm := &scriptedLLM{choices: []*llms.ContentChoice{
+		toolCallChoice("call-1", "echo", `{"q":"cpu"}`),
+		{Content: "CPU 告警规则共 1 条", GenerationInfo: map[string]any{"PromptTokens": 120, "CompletionTokens": 30}},
+	}}
+	llm.choices[0].GenerationInfo = map[string]any{"PromptTokens": 100, "CompletionTokens": 10}
+	agent := NewAgentDomain(llm, []*AgentTool{echoTool("echo", &calls)}, nil, 3)
+
+	var events []AgentEvent
+	answer, usage, err := agent.Run(context.Background(), 7, []llms.MessageContent{
+		llms.TextParts(llms.ChatMessageTypeHuman, "有哪些 CPU 告警？"),
+	}, func(e AgentEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if answer != "CPU 告警规则共 1 条" {
+		t.Fatalf("answer = %q", answer)
+	}
+	if usage.PromptTokens != 220 || usage.CompletionTokens != 40 {
+		t.Errorf("usage = %+v, want accumulated over both calls", usage)
+	}
+	if len(calls) != 1 || calls[0] != 7 {
+		t.Fatalf("tool calls = %v, want [7]", calls)
+	}
+
+	if len(events) != 2 || events[0].ToolCall == nil || events[1].ToolResult == nil {
+		t.Fatalf("events = %+v, want call then result", events)
+	}
+	if events[0].ToolCall.Arguments != `{"q":"cpu"}` {
+		t.Errorf("call arguments = %q", events[0].ToolCall.Arguments)
+	}
+	if r := events[1].ToolResult; r.Id != "call-1" || r.IsError || r.Content != `{"echo":"cpu"}` {
+		t.Errorf("result = %+v", r)
+	}
+
+	// 第二次调用需要带上大模型的工具调用和工具结果
+	second := llm.calls[1]
+	last := second[len(second)-1]
+	if last.Role != llms.ChatMessageTypeTool {
+		t.Fatalf("last message role = %s, want tool", last.Role)
+	}
+	if resp, ok := last.Parts[0].(llms.ToolCallResponse); !ok || resp.ToolCallID != "call-1" {
+		t.Errorf("tool response = %+v", last.Parts[0])
+	}
+}
+
+func TestAgentToolACL(t *testing.T) {
+	var publicCalls, adminCalls []int64
+	llm := &scriptedLLM{choices: []*llms.ContentChoice{
+		toolCallChoice("call-1", "admin", `{"q":"x"}`),
+		{Content: "无权限"},
+	}}
+	agent := NewAgentDomain(llm, []*AgentTool{
+		echoTool("public", &publicCalls),
+		echoTool("admin", &adminCalls),
+	}, map[string][]int64{"admin": {1}}, 3)
+
+	var results []string
+	_, _, err := agent.Run(context.Background(), 2, nil, func(e AgentEvent) error {
+		if e.ToolResult != nil {
+			if !e.ToolResult.IsError {
+				t.Errorf("result should be an error: %+v", e.ToolResult)
+			}
+			results = append(results, e.ToolResult.Content)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if len(adminCalls) != 0 {
+		t.Fatalf("admin tool called by uid 2")
+	}
+	if len(results) != 1 || !strings.Contains(results[0], "权限") {
+		t.Errorf("results = %v", results)
+	}
+	// 无权限的工具不应提供给大模型
+	if got := llm.tools[0]; len(got) != 1 || got[0] != "public" {
+		t.Errorf("offered tools = %v, want [public]", got)
+	}
+	if got := agent.AllowedTools(1); len(got) != 2 {
+		t.Errorf("uid 1 allowed %d tools, want 2", len(got))
+	}
+}
+
+func TestAgentRunMaxSteps(t *testing.T) {
+	var calls []int64
+	llm := &scriptedLLM{choices: []*llms.ContentChoice{
+		toolCallChoice("call-1", "echo", `{"q":"a"}`),
+		toolCallChoice("call-2", "echo", `{"q":"b"}`),
+		{Content: "最终回答"},
+	}}
+	agent := NewAgentDomain(llm, []*AgentTool{echoTool("echo", &calls)}, nil, 2)
+
+	answer, _, err := agent.Run(context.Background(), 1, nil, func(AgentEvent) error { return nil })
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if answer != "最终回答" || len(calls) != 2 {
+		t.Fatalf("answer = %q, calls = %d", answer, len(calls))
+	}
+	// 达到上限后的最后一次调用不再提供工具
+	if got := llm.tools[2]; len(got) != 0 {
+		t.Errorf("final call offered tools %v", got)
+	}
+}
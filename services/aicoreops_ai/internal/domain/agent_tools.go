@@ -0,0 +1,287 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_common/types/prometheus"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_common/types/tree"
+	"google.golang.org/grpc/metadata"
+)
+
+// Agent 工具名称
+const (
+	ToolListAlertRules    = "list_alert_rules"
+	ToolSearchTreeNodes   = "search_tree_nodes"
+	ToolListNodeInstances = "list_node_instances"
+	ToolListChats         = "list_my_chats"
+	ToolReadChatHistory   = "read_chat_history"
+)
+
+const (
+	// defaultToolPageSize 工具默认返回的条数
+	defaultToolPageSize = 20
+	// maxToolPageSize 工具最多返回的条数
+	maxToolPageSize = 50
+)
+
+// withUID 调用下游服务时透传调用者ID，由下游服务按用户鉴权
+func withUID(ctx context.Context, uid int64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "uid", strconv.FormatInt(uid, 10))
+}
+
+func toolPageSize(n int) int32 {
+	if n <= 0 {
+		return defaultToolPageSize
+	}
+	if n > maxToolPageSize {
+		return maxToolPageSize
+	}
+	return int32(n)
+}
+
+func objectSchema(properties map[string]any, required ...string) map[string]any {
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// NewAlertRuleTool 查询告警规则
+func NewAlertRuleTool(client prometheus.PrometheusRpcClient) *AgentTool {
+	type args struct {
+		Keyword  string `json:"keyword"`
+		Severity string `json:"severity"`
+		Limit    int    `json:"limit"`
+	}
+
+	return &AgentTool{
+		Name:        ToolListAlertRules,
+		Description: "查询 Prometheus 告警规则，可按名称或表达式关键字、告警级别过滤",
+		Parameters: objectSchema(map[string]any{
+			"keyword":  map[string]any{"type": "string", "description": "匹配规则名称或 PromQL 表达式的关键字"},
+			"severity": map[string]any{"type": "string", "description": "告警级别，例如 critical、warning"},
+			"limit":    map[string]any{"type": "integer", "description": "最多返回条数，默认 20"},
+		}),
+		Call: func(ctx context.Context, uid int64, raw json.RawMessage) (any, error) {
+			var a args
+			if err := json.Unmarshal(raw, &a); err != nil {
+				return nil, err
+			}
+
+			resp, err := client.GetAlertRuleList(withUID(ctx, uid), &prometheus.GetAlertRuleListRequest{})
+			if err != nil {
+				return nil, err
+			}
+
+			limit := int(toolPageSize(a.Limit))
+			rules := make([]map[string]any, 0, limit)
+			for _, r := range resp.Data {
+				if a.Keyword != "" && !strings.Contains(r.Name, a.Keyword) && !strings.Contains(r.Expr, a.Keyword) {
+					continue
+				}
+				if a.Severity != "" && !strings.EqualFold(r.Severity, a.Severity) {
+					continue
+				}
+				rules = append(rules, map[string]any{
+					"id":           r.Id,
+					"name":         r.Name,
+					"expr":         r.Expr,
+					"severity":     r.Severity,
+					"for":          r.ForDuration,
+					"enable":       r.Enable == 1,
+					"tree_node_id": r.TreeNodeId,
+					"labels":       r.Labels,
+					"annotations":  r.Annotations,
+				})
+				if len(rules) >= limit {
+					break
+				}
+			}
+
+			return rules, nil
+		},
+	}
+}
+
+// NewTreeNodeTool 搜索服务树节点
+func NewTreeNodeTool(client tree.ResourceTreeServiceClient) *AgentTool {
+	type args struct {
+		Keyword  string `json:"keyword"`
+		CmdbType string `json:"cmdb_type"`
+		Limit    int    `json:"limit"`
+	}
+
+	return &AgentTool{
+		Name:        ToolSearchTreeNodes,
+		Description: "按关键字搜索服务树节点，返回节点ID、标题、层级和 CMDB 信息",
+		Parameters: objectSchema(map[string]any{
+			"keyword":   map[string]any{"type": "string", "description": "节点标题关键字"},
+			"cmdb_type": map[string]any{"type": "string", "description": "CMDB 资源类型"},
+			"limit":     map[string]any{"type": "integer", "description": "最多返回条数，默认 20"},
+		}),
+		Call: func(ctx context.Context, uid int64, raw json.RawMessage) (any, error) {
+			var a args
+			if err := json.Unmarshal(raw, &a); err != nil {
+				return nil, err
+			}
+
+			resp, err := client.ListTreeNode(withUID(ctx, uid), &tree.ListTreeNodeRequest{
+				PageNum:  1,
+				PageSize: toolPageSize(a.Limit),
+				Keyword:  a.Keyword,
+				CmdbType: a.CmdbType,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			nodes := make([]map[string]any, 0, len(resp.Nodes))
+			for _, n := range resp.Nodes {
+				nodes = append(nodes, map[string]any{
+					"id":          n.Id,
+					"title":       n.Title,
+					"pid":         n.Pid,
+					"level":       n.Level,
+					"is_leaf":     n.IsLeaf == 1,
+					"description": n.Description,
+					"cmdb_type":   n.CmdbType,
+					"status":      n.Status,
+				})
+			}
+
+			return map[string]any{"total": resp.Total, "nodes": nodes}, nil
+		},
+	}
+}
+
+// NewNodeInstanceTool 查询服务树节点绑定的 ECS 实例
+func NewNodeInstanceTool(client tree.EcsServiceClient) *AgentTool {
+	type args struct {
+		NodeID  int64  `json:"node_id"`
+		Status  string `json:"status"`
+		Keyword string `json:"keyword"`
+		Limit   int    `json:"limit"`
+	}
+
+	return &AgentTool{
+		Name:        ToolListNodeInstances,
+		Description: "查询服务树节点下绑定的 ECS 主机，返回实例ID、名称、IP、规格和状态",
+		Parameters: objectSchema(map[string]any{
+			"node_id": map[string]any{"type": "integer", "description": "服务树节点ID"},
+			"status":  map[string]any{"type": "string", "description": "实例状态，例如 Running、Stopped"},
+			"keyword": map[string]any{"type": "string", "description": "实例ID、名称或 IP 关键字"},
+			"limit":   map[string]any{"type": "integer", "description": "最多返回条数，默认 20"},
+		}, "node_id"),
+		Call: func(ctx context.Context, uid int64, raw json.RawMessage) (any, error) {
+			var a args
+			if err := json.Unmarshal(raw, &a); err != nil {
+				return nil, err
+			}
+			if a.NodeID <= 0 {
+				return nil, fmt.Errorf("node_id 不能为空")
+			}
+
+			resp, err := client.GetEcsList(withUID(ctx, uid), &tree.GetEcsListRequest{
+				PageNum:  1,
+				PageSize: toolPageSize(a.Limit),
+				NodeId:   a.NodeID,
+				Status:   a.Status,
+				Keyword:  a.Keyword,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			instances := make([]map[string]any, 0, len(resp.Instances))
+			for _, i := range resp.Instances {
+				instances = append(instances, map[string]any{
+					"instance_id":   i.InstanceId,
+					"instance_name": i.InstanceName,
+					"instance_type": i.InstanceType,
+					"status":        i.Status,
+					"private_ip":    i.PrivateIp,
+					"public_ip":     i.PublicIp,
+					"region_id":     i.RegionId,
+					"os_name":       i.OsName,
+				})
+			}
+
+			return map[string]any{"total": resp.Total, "instances": instances}, nil
+		},
+	}
+}
+
+// NewChatHistoryTools 查询调用者自己的历史会话，只能访问属于 uid 的会话
+func NewChatHistoryTools(sessionRepo repo.HistorySessionRepo, historyRepo repo.HistoryRepo) []*AgentTool {
+	type listArgs struct {
+		Limit int `json:"limit"`
+	}
+	type readArgs struct {
+		SessionID string `json:"session_id"`
+	}
+
+	return []*AgentTool{
+		{
+			Name:        ToolListChats,
+			Description: "列出当前用户最近的历史会话，返回会话ID和标题",
+			Parameters: objectSchema(map[string]any{
+				"limit": map[string]any{"type": "integer", "description": "最多返回条数，默认 20"},
+			}),
+			Call: func(ctx context.Context, uid int64, raw json.RawMessage) (any, error) {
+				var a listArgs
+				if err := json.Unmarshal(raw, &a); err != nil {
+					return nil, err
+				}
+
+				sessions, err := sessionRepo.GetHistorySessionList(ctx, uid, 0, int(toolPageSize(a.Limit)))
+				if err != nil {
+					return nil, err
+				}
+
+				res := make([]map[string]any, 0, len(sessions))
+				for _, s := range sessions {
+					res = append(res, map[string]any{"session_id": s.SessionID, "title": s.Title, "create_time": s.CreatedAt})
+				}
+				return res, nil
+			},
+		},
+		{
+			Name:        ToolReadChatHistory,
+			Description: "读取当前用户某个历史会话的问答记录",
+			Parameters: objectSchema(map[string]any{
+				"session_id": map[string]any{"type": "string", "description": "会话ID"},
+			}, "session_id"),
+			Call: func(ctx context.Context, uid int64, raw json.RawMessage) (any, error) {
+				var a readArgs
+				if err := json.Unmarshal(raw, &a); err != nil {
+					return nil, err
+				}
+
+				session, err := sessionRepo.GetHistorySessionBySessionID(ctx, a.SessionID)
+				if err != nil || session.UserID != uid {
+					return nil, fmt.Errorf("会话 %s 不存在", a.SessionID)
+				}
+
+				histories, err := historyRepo.GetHistoryBySessionID(ctx, a.SessionID)
+				if err != nil {
+					return nil, err
+				}
+
+				res := make([]map[string]any, 0, len(histories))
+				for _, h := range histories {
+					res = append(res, map[string]any{"question": h.Question, "answer": h.Answer, "create_time": h.CreatedAt})
+				}
+				return res, nil
+			},
+		},
+	}
+}
@@ -2,45 +2,90 @@ package domain
 
 import (
 	"context"
-	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
-	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
-	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/metadata"
 	"gorm.io/gorm"
 
 	"github.com/tmc/langchaingo/llms"
-	"github.com/tmc/langchaingo/llms/ollama"
-	"github.com/tmc/langchaingo/memory"
 	"github.com/tmc/langchaingo/schema"
-	"github.com/tmc/langchaingo/vectorstores/qdrant"
 )
 
+const (
+	// citationSnippetLength 引用摘要的最大字符数
+	citationSnippetLength = 200
+	// defaultTopK 未指定 top_k 时的召回数量
+	defaultTopK = 5
+	// rerankCandidateFactor 重排序时召回候选数量相对 topK 的倍数
+	rerankCandidateFactor = 3
+	// maxChatTitleLength 会话标题的最大字符数
+	maxChatTitleLength = 100
+	// searchSnippetContext 搜索结果中关键字前后保留的字符数
+	searchSnippetContext = 40
+	// 反馈评分范围
+	minFeedbackRating = 1
+	maxFeedbackRating = 5
+	// maxFeedbackCommentLength 反馈说明的最大字符数
+	maxFeedbackCommentLength = 2000
+)
+
+// 会话导出格式
+const (
+	ExportFormatMarkdown = "markdown"
+	ExportFormatJSON     = "json"
+)
+
+// 提问流中的控制指令
+const (
+	AskActionStop       = "stop"
+	AskActionRegenerate = "regenerate"
+)
+
+// CodeInvalidAction 控制指令无法执行时 AskQuestion 返回的状态码
+const CodeInvalidAction = 400
+
 type AIHelperDomain struct {
 	HistoryRepo        repo.HistoryRepo
 	HistorySessionRepo repo.HistorySessionRepo
+	DocumentRepo       repo.DocumentRepo
+	FeedbackRepo       repo.FeedbackRepo
 	Qdrant             *dao.QdrantDAO
+	Reranker           dao.Reranker
+	Cache              *SemanticCacheDomain
+	Redactor           *pkg.Redactor
 }
 
-func NewAIHelperDomain(db *gorm.DB, qd *qdrant.Store) *AIHelperDomain {
+// NewAIHelperDomain 创建问答领域对象，col 为创建时启用的向量集合，请求处理期间集合切换不影响本次请求；
+// keywordCandidateLimit 为关键词检索参与 BM25 排序的候选分块上限
+func NewAIHelperDomain(db *gorm.DB, col *pkg.VectorCollection, llm llms.Model, cache *SemanticCacheDomain, redactor *pkg.Redactor, keywordCandidateLimit int) *AIHelperDomain {
 	return &AIHelperDomain{
 		HistoryRepo:        dao.NewHistoryDAO(db),
 		HistorySessionRepo: dao.NewHistorySessionDAO(db),
-		Qdrant:             dao.NewQdrantDAO(qd),
+		DocumentRepo:       dao.NewDocumentDAO(db),
+		FeedbackRepo:       dao.NewFeedbackDAO(db),
+		Qdrant:             dao.NewQdrantDAO(col.Store, col.Client, dao.WithKeywordCandidateLimit(keywordCandidateLimit)),
+		Reranker:           dao.NewLLMReranker(llm),
+		Cache:              cache,
+		Redactor:           redactor,
 	}
 }
 
 type ChatSession struct {
 	UserID    int64
 	SessionID string
-	MemoryBuf *memory.ConversationTokenBuffer
+	Memory    *pkg.SessionMemory
 	IsNew     bool
 }
 
@@ -61,61 +106,276 @@ func (d *AIHelperDomain) GetHistorySessionList(ctx context.Context, uid int64, l
 	return sessions, nil
 }
 
-func (d *AIHelperDomain) GetHistoryBySessionID(ctx context.Context, sessionID string) ([]*types.GetChatHistoryResponse_ChatMessage, error) {
+// GetChatHistory 分页获取会话历史，只能查看自己的会话；会话尚未产生记录时返回空列表
+func (d *AIHelperDomain) GetChatHistory(ctx context.Context, uid int64, sessionID string, limit, offset int) ([]*types.GetChatHistoryResponse_ChatMessage, int64, error) {
+	session, err := d.HistorySessionRepo.GetHistorySessionBySessionID(ctx, sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []*types.GetChatHistoryResponse_ChatMessage{}, 0, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("获取会话失败: %w", err)
+	}
+	if session.UserID != uid {
+		return nil, 0, fmt.Errorf("会话 %s 不存在", sessionID)
+	}
+
+	histories, total, err := d.HistoryRepo.GetHistoryPage(ctx, sessionID, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("获取历史记录失败: %w", err)
+	}
+
+	return d.BuildHistoryRespModel(ctx, histories), total, nil
+}
+
+// RenameChat 重命名会话
+func (d *AIHelperDomain) RenameChat(ctx context.Context, uid int64, sessionID, title string) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return fmt.Errorf("会话标题不能为空")
+	}
+	if utf8.RuneCountInString(title) > maxChatTitleLength {
+		return fmt.Errorf("会话标题不能超过 %d 个字符", maxChatTitleLength)
+	}
+
+	if _, err := d.getOwnedSession(ctx, uid, sessionID); err != nil {
+		return err
+	}
+
+	return d.HistorySessionRepo.RenameHistorySession(ctx, sessionID, title)
+}
+
+// DeleteChat 软删除会话及其历史记录，并清理会话记忆
+func (d *AIHelperDomain) DeleteChat(ctx context.Context, uid int64, sessionID string, store pkg.SessionMemoryStore) error {
+	if _, err := d.getOwnedSession(ctx, uid, sessionID); err != nil {
+		return err
+	}
+
+	if err := d.HistorySessionRepo.DeleteHistorySessionBySessionID(ctx, sessionID); err != nil {
+		return fmt.Errorf("删除会话失败: %w", err)
+	}
+
+	if err := store.Remove(ctx, sessionID); err != nil {
+		return fmt.Errorf("清理会话记忆失败: %w", err)
+	}
+
+	return nil
+}
+
+// SearchChats 在用户自己的会话中搜索问题和回答
+func (d *AIHelperDomain) SearchChats(ctx context.Context, uid int64, keyword string, limit, offset int) ([]*types.SearchChatsResponse_ChatSearchHit, int64, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return nil, 0, fmt.Errorf("搜索关键字不能为空")
+	}
+
+	hits, total, err := d.HistoryRepo.SearchHistory(ctx, uid, keyword, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("搜索历史记录失败: %w", err)
+	}
+
+	res := make([]*types.SearchChatsResponse_ChatSearchHit, 0, len(hits))
+	for _, h := range hits {
+		snippet := matchSnippet(h.Answer, keyword)
+		if snippet == "" {
+			snippet = matchSnippet(h.Question, keyword)
+		}
+		res = append(res, &types.SearchChatsResponse_ChatSearchHit{
+			SessionId:  h.SessionID,
+			Title:      h.Title,
+			Question:   h.Question,
+			Snippet:    snippet,
+			CreateTime: h.CreatedAt,
+		})
+	}
+
+	return res, total, nil
+}
+
+// ExportChat 将会话导出为 Markdown 或 JSON
+func (d *AIHelperDomain) ExportChat(ctx context.Context, uid int64, sessionID, format string) (*types.ExportChatResponse_ExportData, error) {
+	session, err := d.getOwnedSession(ctx, uid, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
 	histories, err := d.HistoryRepo.GetHistoryBySessionID(ctx, sessionID)
 	if err != nil {
 		return nil, fmt.Errorf("获取历史记录失败: %w", err)
 	}
 
-	return d.BuildHistoryRespModel(ctx, histories), nil
+	switch format {
+	case "", ExportFormatMarkdown:
+		return &types.ExportChatResponse_ExportData{
+			Filename:    sessionID + ".md",
+			ContentType: "text/markdown; charset=utf-8",
+			Content:     renderChatMarkdown(session, histories),
+		}, nil
+	case ExportFormatJSON:
+		content, err := renderChatJSON(session, histories)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ExportChatResponse_ExportData{
+			Filename:    sessionID + ".json",
+			ContentType: "application/json",
+			Content:     content,
+		}, nil
+	default:
+		return nil, fmt.Errorf("不支持的导出格式: %s", format)
+	}
 }
 
-func (d *AIHelperDomain) UploadDocument(ctx context.Context, title, content string) error {
-	// 1. 解码 Base64 内容
-	fileBytes, err := base64.StdEncoding.DecodeString(content)
-	if err != nil {
-		return fmt.Errorf("解码 Base64 内容失败: %w", err)
+// SubmitFeedback 对自己会话中的回答评分，重复提交时覆盖之前的评价
+func (d *AIHelperDomain) SubmitFeedback(ctx context.Context, uid, historyID int64, rating int, comment string) error {
+	if rating < minFeedbackRating || rating > maxFeedbackRating {
+		return fmt.Errorf("评分必须在 %d 到 %d 之间", minFeedbackRating, maxFeedbackRating)
+	}
+	comment = strings.TrimSpace(comment)
+	if utf8.RuneCountInString(comment) > maxFeedbackCommentLength {
+		return fmt.Errorf("反馈说明不能超过 %d 个字符", maxFeedbackCommentLength)
 	}
 
-	// 2. 将文本文件拆分成文档块
-	docs, err := d.Qdrant.TextToDocuments(ctx, fileBytes)
+	history, err := d.HistoryRepo.GetHistoryByID(ctx, historyID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("问答记录 %d 不存在", historyID)
+	}
 	if err != nil {
-		return fmt.Errorf("拆分文本失败: %w", err)
+		return fmt.Errorf("获取问答记录失败: %w", err)
+	}
+	if _, err := d.getOwnedSession(ctx, uid, history.SessionID); err != nil {
+		return fmt.Errorf("问答记录 %d 不存在", historyID)
 	}
 
-	// 3. 存储到 Qdrant 向量存储中
-	if err = d.Qdrant.StoreDocumentWithMetadata(ctx, docs, title); err != nil {
-		return fmt.Errorf("添加文档失败: %w", err)
+	if err := d.FeedbackRepo.UpsertFeedback(ctx, &model.Feedback{
+		HistoryID: historyID,
+		UserID:    uid,
+		Rating:    rating,
+		Comment:   comment,
+	}); err != nil {
+		return fmt.Errorf("保存反馈失败: %w", err)
 	}
 
 	return nil
 }
 
-func (d *AIHelperDomain) LoadHistoryToMemory(ctx context.Context, sc *svc.ServiceContext, sessionID string, histories []*types.GetChatHistoryResponse_ChatMessage) error {
-	if _, ok := sc.MemoryBuf[sessionID]; ok {
+// CheckSessionOwner 提问前校验会话归属：会话属于其他用户时返回不存在，尚未保存的新会话允许使用
+func (d *AIHelperDomain) CheckSessionOwner(ctx context.Context, uid int64, sessionID string) error {
+	session, err := d.HistorySessionRepo.GetHistorySessionBySessionID(ctx, sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil
 	}
-	buf := memory.NewConversationTokenBuffer(
-		sc.LLM,
-		10000,
-	)
+	if err != nil {
+		return fmt.Errorf("获取会话失败: %w", err)
+	}
+	if session.UserID != uid {
+		return fmt.Errorf("会话 %s 不存在", sessionID)
+	}
+
+	return nil
+}
+
+// getOwnedSession 获取属于 uid 的会话，不存在或不属于该用户时统一返回不存在
+func (d *AIHelperDomain) getOwnedSession(ctx context.Context, uid int64, sessionID string) (*model.HistorySession, error) {
+	session, err := d.HistorySessionRepo.GetHistorySessionBySessionID(ctx, sessionID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("获取会话失败: %w", err)
+	}
+	if err != nil || session.UserID != uid {
+		return nil, fmt.Errorf("会话 %s 不存在", sessionID)
+	}
+
+	return session, nil
+}
+
+func renderChatMarkdown(session *model.HistorySession, histories []*model.History) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", session.Title)
+	fmt.Fprintf(&b, "> 会话ID: %s，创建时间: %s\n", session.SessionID, formatUnix(session.CreatedAt))
+
+	for i, h := range histories {
+		fmt.Fprintf(&b, "\n## %d. %s\n\n", i+1, firstLine(h.Question))
+		fmt.Fprintf(&b, "*%s*\n\n", formatUnix(h.CreatedAt))
+		fmt.Fprintf(&b, "**问题**\n\n%s\n\n", h.Question)
+		fmt.Fprintf(&b, "**回答**\n\n%s\n", h.Answer)
+	}
+
+	return b.String()
+}
+
+type chatExport struct {
+	SessionID  string              `json:"session_id"`
+	Title      string              `json:"title"`
+	CreateTime int64               `json:"create_time"`
+	Messages   []chatExportMessage `json:"messages"`
+}
+
+type chatExportMessage struct {
+	Question   string `json:"question"`
+	Answer     string `json:"answer"`
+	CreateTime int64  `json:"create_time"`
+}
+
+func renderChatJSON(session *model.HistorySession, histories []*model.History) (string, error) {
+	export := chatExport{
+		SessionID:  session.SessionID,
+		Title:      session.Title,
+		CreateTime: session.CreatedAt,
+		Messages:   make([]chatExportMessage, 0, len(histories)),
+	}
 	for _, h := range histories {
-		err := buf.SaveContext(ctx, map[string]any{"question": h.Question}, map[string]any{"answer": h.Answer})
-		if err != nil {
-			return err
-		}
+		export.Messages = append(export.Messages, chatExportMessage{
+			Question:   h.Question,
+			Answer:     h.Answer,
+			CreateTime: h.CreatedAt,
+		})
 	}
 
-	// 并发写入
-	sc.Mutex.Lock()
-	sc.MemoryBuf[sessionID] = buf
-	sc.Mutex.Unlock()
+	b, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("序列化会话失败: %w", err)
+	}
 
-	return nil
+	return string(b), nil
+}
+
+func formatUnix(ts int64) string {
+	return time.Unix(ts, 0).Format(time.DateTime)
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return truncateRunes(line, maxChatTitleLength)
+}
+
+// matchSnippet 截取关键字前后的内容，未命中时返回空
+func matchSnippet(text, keyword string) string {
+	idx := strings.Index(strings.ToLower(text), strings.ToLower(keyword))
+	if idx < 0 {
+		return ""
+	}
+
+	runes := []rune(text)
+	pos := utf8.RuneCountInString(text[:idx])
+	start := max(pos-searchSnippetContext, 0)
+	end := min(pos+utf8.RuneCountInString(keyword)+searchSnippetContext, len(runes))
+
+	snippet := strings.Join(strings.Fields(string(runes[start:end])), " ")
+	if start > 0 {
+		snippet = "..." + snippet
+	}
+	if end < len(runes) {
+		snippet += "..."
+	}
+
+	return snippet
 }
 
 // CheckSession 从上下文中获取用户ID和会话ID
 func (d *AIHelperDomain) CheckSession(ctx context.Context) (int64, string, error) {
+	return checkSession(ctx)
+}
+
+func checkSession(ctx context.Context) (int64, string, error) {
 	// 从上下文获取元数据
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -142,38 +402,133 @@ func (d *AIHelperDomain) CheckSession(ctx context.Context) (int64, string, error
 	return uid, "", nil
 }
 
-func (d *AIHelperDomain) GetMemoryBuf(ctx context.Context, sessionID string, llm *ollama.LLM, mp map[string]*memory.ConversationTokenBuffer, mutex *sync.RWMutex) (*memory.ConversationTokenBuffer, bool, error) {
-	buf, ok := mp[sessionID]
-	if !ok {
-		buf = memory.NewConversationTokenBuffer(
-			llm,
-			10000,
-		)
-		mutex.Lock()
-		mp[sessionID] = buf
-		mutex.Unlock()
+// GetMemoryBuf 获取会话记忆，不在缓存中时从 MySQL 中的会话摘要和历史记录重建
+func (d *AIHelperDomain) GetMemoryBuf(ctx context.Context, sessionID string, store pkg.SessionMemoryStore) (*pkg.SessionMemory, bool, error) {
+	return store.Get(ctx, sessionID, &historyMemorySource{history: d.HistoryRepo, sessions: d.HistorySessionRepo})
+}
+
+// historyMemorySource 会话摘要保存在 history_session 表，问答保存在 history 表
+type historyMemorySource struct {
+	history  repo.HistoryRepo
+	sessions repo.HistorySessionRepo
+}
+
+func (s *historyMemorySource) LoadMemory(ctx context.Context, sessionID string) (pkg.MemorySummary, []pkg.MemoryTurn, error) {
+	var summary pkg.MemorySummary
+
+	session, err := s.sessions.GetHistorySessionBySessionID(ctx, sessionID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return summary, nil, err
+	}
+	if session != nil {
+		summary = pkg.MemorySummary{Content: session.Summary, Turns: session.SummarizedTurns}
+	}
+
+	histories, err := s.history.GetHistoryBySessionID(ctx, sessionID)
+	if err != nil {
+		return summary, nil, err
+	}
+
+	// 备选回答替换原回答，最后生成的为准
+	turns := make([]pkg.MemoryTurn, 0, len(histories))
+	index := make(map[int64]int, len(histories))
+	for _, h := range histories {
+		if i, ok := index[h.ParentID]; ok {
+			turns[i].Answer = h.Answer
+			continue
+		}
+		index[h.ID] = len(turns)
+		turns = append(turns, pkg.MemoryTurn{Question: h.Question, Answer: h.Answer})
 	}
 
-	return buf, ok, nil
+	// 已被摘要覆盖的问答不再重复加载
+	if summary.Turns >= len(turns) {
+		return summary, nil, nil
+	}
+	return summary, turns[summary.Turns:], nil
 }
 
-func (d *AIHelperDomain) RetrieveRelevantDocs(ctx context.Context, title, question string, scoreThreshold float32, topK int) ([]schema.Document, error) {
-	docs, err := d.Qdrant.SearchSimilarDocuments(ctx, title, question, dao.WithScoreThreshold(scoreThreshold), dao.WithTopK(topK))
+func (s *historyMemorySource) SaveSummary(ctx context.Context, sessionID string, summary pkg.MemorySummary) error {
+	return s.sessions.UpdateHistorySessionSummary(ctx, sessionID, summary.Content, summary.Turns)
+}
+
+// RetrieveRelevantDocs 按请求指定的检索模式在可读知识库中召回文档，scope 为 nil 时不限制知识库；
+// 开启重排序时先多召回一些候选再由大模型排序
+func (d *AIHelperDomain) RetrieveRelevantDocs(ctx context.Context, req *types.AskQuestionRequest, scope *KnowledgeScope) ([]schema.Document, error) {
+	topK := int(req.TopK)
+	if topK <= 0 {
+		topK = defaultTopK
+	}
+
+	candidates := topK
+	if req.Rerank {
+		candidates = topK * rerankCandidateFactor
+	}
+
+	opts := []dao.SearchOption{
+		dao.WithScoreThreshold(req.ScoreThreshold),
+		dao.WithTopK(candidates),
+		dao.WithSearchMode(req.RetrievalMode),
+	}
+	if scope != nil {
+		opts = append(opts, dao.WithKnowledgeBases(scope.IDs))
+	}
+
+	docs, err := d.Qdrant.SearchSimilarDocuments(ctx, req.Title, req.Question, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Rerank {
+		reranked, err := d.Reranker.Rerank(ctx, req.Question, docs)
+		if err != nil {
+			// 重排序失败不影响回答，沿用召回顺序
+			logx.WithContext(ctx).Errorf("重排序失败: %v", err)
+		} else {
+			docs = reranked
+		}
+	}
+
+	if len(docs) > topK {
+		docs = docs[:topK]
+	}
+
 	return docs, nil
 }
 
-func (d *AIHelperDomain) SaveHistory(ctx context.Context, question, answer string, session *ChatSession) error {
+// Answer 一轮生成的回答
+type Answer struct {
+	Content   string
+	Truncated bool  // 被 stop 指令中断，只包含已生成的部分
+	ParentID  int64 // 重新生成时为原问答记录ID
+}
+
+// SaveHistory 保存问答记录、本次消耗的 token 及检索参数，返回问答记录ID；
+// 重新生成的回答保存为原问答的备选回答，并替换会话记忆中的原回答
+func (d *AIHelperDomain) SaveHistory(ctx context.Context, req *types.AskQuestionRequest, answer Answer, usage TokenUsage, session *ChatSession) (int64, error) {
+	question := req.Question
+
 	// 1. 会话内容
-	if err := d.HistoryRepo.CreateHistory(ctx, &model.History{
-		SessionID: session.SessionID,
-		Question:  question,
-		Answer:    answer,
-	}); err != nil {
-		return fmt.Errorf("保存历史记录失败: %w", err)
+	history := &model.History{
+		SessionID:        session.SessionID,
+		Question:         question,
+		Answer:           answer.Content,
+		ParentID:         answer.ParentID,
+		Truncated:        answer.Truncated,
+		UserID:           session.UserID,
+		Model:            usage.Model,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		TotalTokens:      usage.TotalTokens(),
+		ScoreThreshold:   req.ScoreThreshold,
+		TopK:             int(req.TopK),
+		RetrievalMode:    req.RetrievalMode,
+		Rerank:           req.Rerank,
+		Temperature:      req.Temperature,
+		TopP:             req.TopP,
+	}
+	if err := d.HistoryRepo.CreateHistory(ctx, history); err != nil {
+		return 0, fmt.Errorf("保存历史记录失败: %w", err)
 	}
 
 	// 2. 会话 Session
@@ -184,7 +539,7 @@ func (d *AIHelperDomain) SaveHistory(ctx context.Context, question, answer strin
 			Title:     question,
 		})
 		if err != nil {
-			return fmt.Errorf("创建新会话失败: %w", err)
+			return 0, fmt.Errorf("创建新会话失败: %w", err)
 		}
 
 		// 避免重复存储
@@ -192,54 +547,92 @@ func (d *AIHelperDomain) SaveHistory(ctx context.Context, question, answer strin
 	}
 
 	// 3. 缓存
-	if err := session.MemoryBuf.SaveContext(ctx, map[string]any{"question": question}, map[string]any{"answer": answer}); err != nil {
-		return fmt.Errorf("保存历史记录失败: %w", err)
+	save := session.Memory.Save
+	if answer.ParentID > 0 {
+		save = session.Memory.ReviseLast
+	}
+	if err := save(ctx, question, answer.Content); err != nil {
+		return 0, fmt.Errorf("保存历史记录失败: %w", err)
 	}
 
-	return nil
+	return history.ID, nil
 }
 
-func (d *AIHelperDomain) BuildContext(ctx context.Context, buf *memory.ConversationTokenBuffer, req *types.AskQuestionRequest) ([]llms.MessageContent, error) {
+// BuildContext 按提示词模板构建提问上下文，同时返回检索到的文档用于生成引用
+// history 为会话记忆，重新生成回答时不包含被替换的那一轮
+func (d *AIHelperDomain) BuildContext(ctx context.Context, uid int64, history string, req *types.AskQuestionRequest, tpl *model.PromptTemplate, scope *KnowledgeScope) ([]llms.MessageContent, []schema.Document, error) {
 	// 1. 检索相关文档
-	docs, err := d.RetrieveRelevantDocs(ctx, req.Title, req.Question, req.ScoreThreshold, int(req.TopK))
+	docs, err := d.RetrieveRelevantDocs(ctx, req, scope)
 	if err != nil {
-		return nil, fmt.Errorf("检索相关文档失败: %w", err)
+		return nil, nil, fmt.Errorf("检索相关文档失败: %w", err)
 	}
 
-	// 2. 获取历史记录
-	history, err := buf.LoadMemoryVariables(context.Background(), map[string]any{})
+	// 2. 渲染模板
+	prompt, err := RenderPrompt(tpl, PromptData{
+		User:     uid,
+		Question: req.Question,
+		Docs:     NewPromptDocs(docs),
+		History:  history,
+		Now:      time.Now(),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("获取历史记录失败: %v", err)
+		return nil, nil, err
 	}
 
 	// 3. 构建上下文
-	var contextTexts []string
-	for _, doc := range docs {
-		contextTexts = append(contextTexts, doc.PageContent)
+	return promptMessages(prompt, req.Question), docs, nil
+}
+
+// promptMessages 依次组装系统提示词、上下文和问题
+func promptMessages(prompt *RenderedPrompt, question string) []llms.MessageContent {
+	content := []llms.MessageContent{llms.TextParts(llms.ChatMessageTypeSystem, prompt.System)}
+	if prompt.Context != "" {
+		content = append(content, llms.TextParts(llms.ChatMessageTypeHuman, prompt.Context))
 	}
+	return append(content, llms.TextParts(llms.ChatMessageTypeHuman, question))
+}
 
-	for _, h := range history {
-		contextTexts = append(contextTexts, h.(string))
+// BuildCitations 将检索到的文档分块转换为引用列表
+func (d *AIHelperDomain) BuildCitations(docs []schema.Document) []*types.Citation {
+	citations := make([]*types.Citation, 0, len(docs))
+	for _, doc := range docs {
+		title, _ := doc.Metadata["title"].(string)
+		section, _ := doc.Metadata["section"].(string)
+		fusionScore, _ := doc.Metadata[dao.FusionScoreKey].(float32)
+		citations = append(citations, &types.Citation{
+			Title:       title,
+			ChunkId:     fmt.Sprintf("%v:%v", doc.Metadata["doc_id"], doc.Metadata["chunk_index"]),
+			Score:       doc.Score,
+			Snippet:     buildSnippet(doc.PageContent),
+			Section:     section,
+			FusionScore: fusionScore,
+		})
 	}
 
-	content := []llms.MessageContent{
-		llms.TextParts(llms.ChatMessageTypeSystem, "请你仔细思考，读取上下文内容给出高质量的回答"),
-		// 上下文
-		llms.TextParts(llms.ChatMessageTypeHuman, strings.Join(contextTexts, "\n")),
-		// 问题
-		llms.TextParts(llms.ChatMessageTypeHuman, req.Question),
+	return citations
+}
+
+// buildSnippet 截取分块开头作为摘要
+func buildSnippet(content string) string {
+	content = strings.Join(strings.Fields(content), " ")
+	runes := []rune(content)
+	if len(runes) <= citationSnippetLength {
+		return content
 	}
 
-	return content, nil
+	return string(runes[:citationSnippetLength]) + "..."
 }
 
 func (d *AIHelperDomain) BuildHistoryRespModel(ctx context.Context, histories []*model.History) []*types.GetChatHistoryResponse_ChatMessage {
 	res := make([]*types.GetChatHistoryResponse_ChatMessage, 0, len(histories))
 	for _, h := range histories {
 		res = append(res, &types.GetChatHistoryResponse_ChatMessage{
+			Id:         h.ID,
 			Question:   h.Question,
 			Answer:     h.Answer,
 			CreateTime: h.CreatedAt,
+			ParentId:   h.ParentID,
+			Truncated:  h.Truncated,
 		})
 	}
 
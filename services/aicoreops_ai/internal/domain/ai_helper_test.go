@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"github.com/tmc/langchaingo/llms"
+	"gorm.io/gorm"
+)
+
+func TestMatchSnippet(t *testing.T) {
+	text := strings.Repeat("前", 50) + "Pod OOMKilled 后自动重启" + strings.Repeat("后", 50)
+
+	snippet := matchSnippet(text, "oomkilled")
+	if !strings.Contains(snippet, "OOMKilled") {
+		t.Fatalf("snippet %q does not contain keyword", snippet)
+	}
+	if !strings.HasPrefix(snippet, "...") || !strings.HasSuffix(snippet, "...") {
+		t.Errorf("snippet %q should be elided on both sides", snippet)
+	}
+
+	if got := matchSnippet("短文本 keyword", "keyword"); got != "短文本 keyword" {
+		t.Errorf("short snippet = %q", got)
+	}
+	if got := matchSnippet(text, "missing"); got != "" {
+		t.Errorf("unmatched snippet = %q", got)
+	}
+}
+
+func TestRenderChatExport(t *testing.T) {
+	session := &model.HistorySession{SessionID: "s-1", Title: "排查 OOM", CreatedAt: 1700000000}
+	histories := []*model.History{
+		{Question: "为什么 Pod 被 OOMKilled？\n附日志", Answer: "内存限制过低", CreatedAt: 1700000010},
+		{Question: "如何调整？", Answer: "提高 limits.memory", CreatedAt: 1700000020},
+	}
+
+	md := renderChatMarkdown(session, histories)
+	for _, want := range []string{"# 排查 OOM", "会话ID: s-1", "## 1. 为什么 Pod 被 OOMKilled？\n", "## 2. 如何调整？", "提高 limits.memory"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown missing %q:\n%s", want, md)
+		}
+	}
+
+	content, err := renderChatJSON(session, histories)
+	if err != nil {
+		t.Fatalf("renderChatJSON: %v", err)
+	}
+	var export chatExport
+	if err := json.Unmarshal([]byte(content), &export); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if export.SessionID != "s-1" || len(export.Messages) != 2 || export.Messages[1].Answer != "提高 limits.memory" {
+		t.Errorf("export = %+v", export)
+	}
+}
+
+func TestSubmitFeedbackValidation(t *testing.T) {
+	d := &AIHelperDomain{}
+	for _, rating := range []int{0, 6} {
+		if err := d.SubmitFeedback(context.Background(), 1, 1, rating, ""); err == nil {
+			t.Errorf("rating %d should be rejected", rating)
+		}
+	}
+	if err := d.SubmitFeedback(context.Background(), 1, 1, 5, strings.Repeat("长", maxFeedbackCommentLength+1)); err == nil {
+		t.Error("long comment should be rejected")
+	}
+}
+
+// fakeSessionRepo 只实现按会话ID查询，其余方法未实现
+type fakeSessionRepo struct {
+	repo.HistorySessionRepo
+	sessions map[string]*model.HistorySession
+}
+
+func (f *fakeSessionRepo) GetHistorySessionBySessionID(ctx context.Context, sessionID string) (*model.HistorySession, error) {
+	session, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return session, nil
+}
+
+func TestCheckSessionOwner(t *testing.T) {
+	d := &AIHelperDomain{HistorySessionRepo: &fakeSessionRepo{sessions: map[string]*model.HistorySession{
+		"s1": {SessionID: "s1", UserID: 1},
+	}}}
+	ctx := context.Background()
+
+	if err := d.CheckSessionOwner(ctx, 1, "s1"); err != nil {
+		t.Errorf("owner should pass: %v", err)
+	}
+	if err := d.CheckSessionOwner(ctx, 2, "s1"); err == nil {
+		t.Error("other user should be rejected")
+	}
+	if err := d.CheckSessionOwner(ctx, 2, "new"); err != nil {
+		t.Errorf("unsaved session should pass: %v", err)
+	}
+}
+
+// fakeProvider 为 fakeLLM 补充向量化接口，满足 pkg.LLMProvider
+type fakeProvider struct {
+	*fakeLLM
+}
+
+func (f fakeProvider) CreateEmbedding(ctx context.Context, texts []string) ([][]float32, error) {
+	return make([][]float32, len(texts)), nil
+}
+
+// fakeMemorySource 记录最近一次保存的会话摘要
+type fakeMemorySource struct {
+	summary pkg.MemorySummary
+	turns   []pkg.MemoryTurn
+}
+
+func (f *fakeMemorySource) LoadMemory(ctx context.Context, sessionID string) (pkg.MemorySummary, []pkg.MemoryTurn, error) {
+	return f.summary, f.turns, nil
+}
+
+func (f *fakeMemorySource) SaveSummary(ctx context.Context, sessionID string, summary pkg.MemorySummary) error {
+	f.summary = summary
+	return nil
+}
+
+func TestSessionMemorySummarize(t *testing.T) {
+	ctx := context.Background()
+	llm := &fakeLLM{replies: []string{"order-svc 数据库连接池耗尽", "order-svc 连接池耗尽，已扩容至 200"}}
+	source := &fakeMemorySource{}
+	store := pkg.NewLocalMemoryStore(fakeProvider{llm}, 100, 10, time.Minute)
+
+	mem, exists, err := store.Get(ctx, "s-1", source)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if exists {
+		t.Fatalf("new session should not exist")
+	}
+
+	// 每轮回答都超过上限，保存后较早的问答应被压缩进摘要，而不是被丢弃
+	answer := strings.Repeat("order-svc connection pool exhausted ", 40)
+	if err := mem.Save(ctx, "哪个服务出问题了？", answer); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := mem.Save(ctx, "怎么处理？", answer); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	if len(llm.calls) != 2 {
+		t.Fatalf("summarize calls = %d, want 2", len(llm.calls))
+	}
+	// 第二次压缩需要合并已有摘要
+	if prompt := llm.calls[1][0].Parts[0].(llms.TextContent).Text; !strings.Contains(prompt, "order-svc 数据库连接池耗尽") {
+		t.Errorf("second prompt does not include previous summary:\n%s", prompt)
+	}
+	if source.summary.Turns != 2 || source.summary.Content != "order-svc 连接池耗尽，已扩容至 200" {
+		t.Errorf("persisted summary = %+v", source.summary)
+	}
+
+	history, err := mem.History(ctx)
+	if err != nil {
+		t.Fatalf("History: %v", err)
+	}
+	if !strings.HasPrefix(history, "Summary: order-svc 连接池耗尽") {
+		t.Errorf("history should start with summary:\n%s", history)
+	}
+
+	// 重建时摘要置于最前，之后是摘要未覆盖的问答
+	source.turns = []pkg.MemoryTurn{{Question: "现在恢复了吗？", Answer: "已恢复"}}
+	mem, exists, err = pkg.NewLocalMemoryStore(fakeProvider{llm}, 100, 10, time.Minute).Get(ctx, "s-1", source)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !exists {
+		t.Errorf("summarized session should exist")
+	}
+	history, _ = mem.History(ctx)
+	want := "Summary: order-svc 连接池耗尽，已扩容至 200\nHuman: 现在恢复了吗？\nAI: 已恢复"
+	if history != want {
+		t.Errorf("history = %q, want %q", history, want)
+	}
+}
+
+func TestSessionMemoryReviseLast(t *testing.T) {
+	ctx := context.Background()
+	source := &fakeMemorySource{turns: []pkg.MemoryTurn{
+		{Question: "哪个服务出问题了？", Answer: "order-svc"},
+		{Question: "怎么处理？", Answer: "重启"},
+	}}
+	mem, _, err := pkg.NewLocalMemoryStore(fakeProvider{&fakeLLM{}}, 1000, 10, time.Minute).Get(ctx, "s-1", source)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	// 重新生成时不应参考被替换的回答
+	history, err := mem.HistoryBefore(ctx, "怎么处理？")
+	if err != nil {
+		t.Fatalf("HistoryBefore: %v", err)
+	}
+	if want := "Human: 哪个服务出问题了？\nAI: order-svc"; history != want {
+		t.Errorf("history before = %q, want %q", history, want)
+	}
+	// 问题不是最后一轮时保留全部历史
+	if history, _ = mem.HistoryBefore(ctx, "哪个服务出问题了？"); !strings.Contains(history, "AI: 重启") {
+		t.Errorf("history before unrelated question dropped last turn:\n%s", history)
+	}
+
+	if err := mem.ReviseLast(ctx, "怎么处理？", "扩容连接池"); err != nil {
+		t.Fatalf("ReviseLast: %v", err)
+	}
+	history, _ = mem.History(ctx)
+	want := "Human: 哪个服务出问题了？\nAI: order-svc\nHuman: 怎么处理？\nAI: 扩容连接池"
+	if history != want {
+		t.Errorf("history = %q, want %q", history, want)
+	}
+}
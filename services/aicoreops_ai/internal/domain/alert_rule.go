@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_common/types/prometheus"
+	pm "github.com/prometheus/common/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+const (
+	// defaultAlertRuleAttempts 未配置时最多调用大模型的次数
+	defaultAlertRuleAttempts = 3
+	// maxAlertRuleNameLength 与告警规则名称的校验规则一致
+	maxAlertRuleNameLength = 50
+	// alertRuleEnabled 告警规则启用状态，与 prometheus 服务一致：1 启用，2 禁用
+	alertRuleEnabled = 1
+)
+
+const alertRuleSystemPrompt = `你是一名资深 SRE，负责把自然语言描述的告警需求转换为 Prometheus 告警规则。
+要求:
+1. expr 必须是合法的 PromQL，表达式有结果即触发告警，阈值直接写在表达式中；
+2. 比例类告警用 rate 或 increase 计算，例如 5xx 比例为 5xx 请求速率除以总请求速率；
+3. for_duration 使用 Prometheus 时长格式，如 30s、5m、1h，对应需求中的持续时间；
+4. severity 只能是 critical、warning、info 之一；
+5. annotations 至少包含 summary 和 description，可以使用 $labels 和 $value 模板变量。
+请只输出一个 JSON 对象，不要输出任何其他内容，格式如下:
+{"name": "规则名称", "expr": "PromQL 表达式", "for_duration": "10m", "severity": "critical|warning|info", "labels": {"key": "value"}, "annotations": {"summary": "摘要", "description": "描述"}, "explanation": "表达式说明"}`
+
+const alertRuleRetryPrompt = `上一次输出未通过校验: %s
+请修正后重新输出完整的 JSON 对象。`
+
+// alertSeverities 告警级别，未识别的级别按 warning 处理
+var alertSeverities = []string{"critical", "warning", "info"}
+
+// PromQLChecker 校验 PromQL 表达式，表达式不合法时返回原因，调用失败时返回 error
+type PromQLChecker func(ctx context.Context, expr string) (string, error)
+
+// NewPromQLRpcChecker 通过 prometheus 服务的 CheckPromqlExpr 接口校验，与创建告警规则时使用同一个解析器
+func NewPromQLRpcChecker(client prometheus.PrometheusRpcClient) PromQLChecker {
+	return func(ctx context.Context, expr string) (string, error) {
+		_, err := client.CheckPromqlExpr(ctx, &prometheus.CheckPromqlExprRequest{Expr: expr})
+		if err == nil {
+			return "", nil
+		}
+
+		// 解析失败由 prometheus 服务以普通错误返回，其余状态码视为调用失败
+		st := status.Convert(err)
+		if st.Code() != codes.Unknown {
+			return "", err
+		}
+		return st.Message(), nil
+	}
+}
+
+// GeneratedAlertRule 生成的告警规则，Rule 可直接作为 CreateAlertRuleRequest.Rule 提交
+type GeneratedAlertRule struct {
+	Rule        *prometheus.AlertRule
+	Explanation string
+	Attempts    int
+}
+
+// alertRuleOutput 大模型输出的 JSON 结构
+type alertRuleOutput struct {
+	Name        string            `json:"name"`
+	Expr        string            `json:"expr"`
+	ForDuration string            `json:"for_duration"`
+	Severity    string            `json:"severity"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	Explanation string            `json:"explanation"`
+}
+
+type AlertRuleDomain struct {
+	llm         llms.Model
+	check       PromQLChecker
+	maxAttempts int
+}
+
+func NewAlertRuleDomain(llm llms.Model, check PromQLChecker, maxAttempts int) *AlertRuleDomain {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultAlertRuleAttempts
+	}
+
+	return &AlertRuleDomain{
+		llm:         llm,
+		check:       check,
+		maxAttempts: maxAttempts,
+	}
+}
+
+func (d *AlertRuleDomain) CheckSession(ctx context.Context) (int64, error) {
+	uid, _, err := checkSession(ctx)
+	return uid, err
+}
+
+// GenerateAlertRule 根据描述生成告警规则；输出不合法或 PromQL 未通过校验时把错误交给大模型修正，最多尝试 maxAttempts 次
+func (d *AlertRuleDomain) GenerateAlertRule(ctx context.Context, uid int64, req *GenerateAlertRuleParams, tpl *model.PromptTemplate) (*GeneratedAlertRule, error) {
+	description := strings.TrimSpace(req.Description)
+	if description == "" {
+		return nil, fmt.Errorf("告警需求描述不能为空")
+	}
+
+	prompt, err := RenderPrompt(tpl, PromptData{User: uid, Question: description, Now: time.Now()})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []llms.MessageContent{
+		llms.TextParts(llms.ChatMessageTypeSystem, prompt.System),
+		llms.TextParts(llms.ChatMessageTypeHuman, prompt.Context),
+	}
+
+	var problem string
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		resp, err := d.llm.GenerateContent(ctx, messages, llms.WithJSONMode(), llms.WithTemperature(0))
+		if err != nil {
+			return nil, fmt.Errorf("生成告警规则失败: %w", err)
+		}
+		if len(resp.Choices) == 0 {
+			return nil, fmt.Errorf("LLM 返回结果为空")
+		}
+		content := resp.Choices[0].Content
+
+		var output *alertRuleOutput
+		output, problem = parseAlertRuleOutput(content)
+		if problem == "" {
+			if problem, err = d.check(ctx, output.Expr); err != nil {
+				return nil, fmt.Errorf("校验 PromQL 失败: %w", err)
+			}
+		}
+		if problem == "" {
+			return &GeneratedAlertRule{
+				Rule:        buildAlertRule(uid, req, output),
+				Explanation: output.Explanation,
+				Attempts:    attempt,
+			}, nil
+		}
+
+		messages = append(messages,
+			llms.TextParts(llms.ChatMessageTypeAI, content),
+			llms.TextParts(llms.ChatMessageTypeHuman, fmt.Sprintf(alertRuleRetryPrompt, problem)),
+		)
+	}
+
+	return nil, fmt.Errorf("尝试 %d 次仍未生成合法的告警规则，最后一次错误: %s", d.maxAttempts, problem)
+}
+
+// GenerateAlertRuleParams 生成告警规则的参数，关联的实例池、发送组和树节点原样写入规则
+type GenerateAlertRuleParams struct {
+	Description string
+	Name        string
+	PoolID      int64
+	SendGroupID int64
+	TreeNodeID  int64
+}
+
+// parseAlertRuleOutput 解析大模型输出，返回需要大模型修正的问题
+func parseAlertRuleOutput(content string) (*alertRuleOutput, string) {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end <= start {
+		return nil, "输出不是 JSON 对象"
+	}
+
+	var output alertRuleOutput
+	if err := json.Unmarshal([]byte(content[start:end+1]), &output); err != nil {
+		return nil, fmt.Sprintf("JSON 解析失败: %v", err)
+	}
+
+	output.Name = strings.TrimSpace(output.Name)
+	if output.Name == "" {
+		return nil, "name 不能为空"
+	}
+	output.Expr = strings.TrimSpace(output.Expr)
+	if output.Expr == "" {
+		return nil, "expr 不能为空"
+	}
+	if _, err := pm.ParseDuration(output.ForDuration); err != nil {
+		return nil, fmt.Sprintf("for_duration %q 不是合法的 Prometheus 时长: %v", output.ForDuration, err)
+	}
+
+	return &output, ""
+}
+
+func buildAlertRule(uid int64, req *GenerateAlertRuleParams, output *alertRuleOutput) *prometheus.AlertRule {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = truncateRunes(output.Name, maxAlertRuleNameLength)
+	}
+
+	severity := strings.ToLower(strings.TrimSpace(output.Severity))
+	if !slices.Contains(alertSeverities, severity) {
+		severity = "warning"
+	}
+
+	// 告警路由通常按 severity 标签匹配，与规则的告警级别保持一致
+	labels := map[string]string{}
+	for k, v := range output.Labels {
+		labels[k] = v
+	}
+	labels["severity"] = severity
+
+	return &prometheus.AlertRule{
+		Name:        name,
+		UserId:      uid,
+		PoolId:      req.PoolID,
+		SendGroupId: req.SendGroupID,
+		TreeNodeId:  req.TreeNodeID,
+		Enable:      alertRuleEnabled,
+		Expr:        output.Expr,
+		Severity:    severity,
+		ForDuration: output.ForDuration,
+		Labels:      keyValuePairs(labels),
+		Annotations: keyValuePairs(output.Annotations),
+	}
+}
+
+// keyValuePairs 转换为 prometheus 服务使用的 key=value 格式，按 key 排序
+func keyValuePairs(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+m[k])
+	}
+
+	return pairs
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// fakeChecker 表达式包含 bad 时视为解析失败
+func fakeChecker(checked *[]string) PromQLChecker {
+	return func(ctx context.Context, expr string) (string, error) {
+		*checked = append(*checked, expr)
+		if strings.Contains(expr, "bad") {
+			return "1:1: parse error: unexpected identifier \"bad\"", nil
+		}
+		return "", nil
+	}
+}
+
+func TestGenerateAlertRuleRetry(t *testing.T) {
+	llm := &scriptedLLM{choices: []*llms.ContentChoice{
+		{Content: `{"name": "HighErrorRate", "expr": "bad(http_requests_total)", "for_duration": "10m"}`},
+		{Content: "规则如下:\n" + `{"name": "HighErrorRate", "expr": "sum(rate(http_requests_total{code=~\"5..\"}[5m])) / sum(rate(http_requests_total[5m])) > 0.05", "for_duration": "10m", "severity": "Critical", "labels": {"team": "sre"}, "annotations": {"summary": "5xx 比例过高", "description": "当前值 $value"}, "explanation": "5xx 请求占比"}`},
+	}}
+	var checked []string
+	d := NewAlertRuleDomain(llm, fakeChecker(&checked), 3)
+
+	result, err := d.GenerateAlertRule(context.Background(), 7, &GenerateAlertRuleParams{
+		Description: "5xx 比例超过 5% 持续 10 分钟",
+		PoolID:      1,
+		SendGroupID: 2,
+		TreeNodeID:  3,
+	}, builtinPrompts[PromptFeatureAlertRule])
+	if err != nil {
+		t.Fatalf("GenerateAlertRule: %v", err)
+	}
+	if result.Attempts != 2 || len(checked) != 2 {
+		t.Fatalf("attempts = %d, checked = %v", result.Attempts, checked)
+	}
+
+	// 第二次调用带上上一次的输出和解析错误
+	retry := llm.calls[1]
+	if len(retry) != 4 || retry[2].Role != llms.ChatMessageTypeAI {
+		t.Fatalf("retry messages = %+v", retry)
+	}
+	if msg := retry[3].Parts[0].(llms.TextContent).Text; !strings.Contains(msg, "parse error") {
+		t.Errorf("retry prompt = %q", msg)
+	}
+
+	rule := result.Rule
+	if rule.Name != "HighErrorRate" || rule.UserId != 7 || rule.PoolId != 1 || rule.SendGroupId != 2 || rule.TreeNodeId != 3 {
+		t.Errorf("rule = %+v", rule)
+	}
+	if rule.Severity != "critical" || rule.ForDuration != "10m" || rule.Enable != alertRuleEnabled {
+		t.Errorf("rule = %+v", rule)
+	}
+	if want := []string{"severity=critical", "team=sre"}; !slices.Equal(rule.Labels, want) {
+		t.Errorf("labels = %v, want %v", rule.Labels, want)
+	}
+	if want := []string{"description=当前值 $value", "summary=5xx 比例过高"}; !slices.Equal(rule.Annotations, want) {
+		t.Errorf("annotations = %v, want %v", rule.Annotations, want)
+	}
+}
+
+func TestGenerateAlertRuleMaxAttempts(t *testing.T) {
+	llm := &scriptedLLM{choices: []*llms.ContentChoice{
+		{Content: "not json"},
+		{Content: `{"name": "x", "expr": "up == 0", "for_duration": "ten minutes"}`},
+		{Content: `{"name": "x", "expr": "bad", "for_duration": "5m"}`},
+		{Content: `{"name": "x", "expr": "up == 0", "for_duration": "5m"}`},
+	}}
+	var checked []string
+	d := NewAlertRuleDomain(llm, fakeChecker(&checked), 3)
+
+	_, err := d.GenerateAlertRule(context.Background(), 1, &GenerateAlertRuleParams{Description: "实例宕机"}, builtinPrompts[PromptFeatureAlertRule])
+	if err == nil || !strings.Contains(err.Error(), "parse error") {
+		t.Fatalf("err = %v", err)
+	}
+	if len(llm.calls) != 3 {
+		t.Errorf("llm calls = %d, want 3", len(llm.calls))
+	}
+	// 输出格式不合法时不调用 prometheus 校验
+	if len(checked) != 1 {
+		t.Errorf("checked = %v", checked)
+	}
+
+	// 校验服务不可用时直接返回错误，不再重试
+	failing := func(ctx context.Context, expr string) (string, error) {
+		return "", errors.New("connection refused")
+	}
+	d = NewAlertRuleDomain(&scriptedLLM{choices: []*llms.ContentChoice{
+		{Content: `{"name": "x", "expr": "up == 0", "for_duration": "5m"}`},
+	}}, failing, 0)
+	if _, err := d.GenerateAlertRule(context.Background(), 1, &GenerateAlertRuleParams{Description: "实例宕机", Name: "InstanceDown"}, builtinPrompts[PromptFeatureAlertRule]); err == nil || !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("err = %v", err)
+	}
+}
@@ -0,0 +1,342 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"gorm.io/gorm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// 修复任务状态
+const (
+	FixStatusPlanned         = "planned"
+	FixStatusPendingApproval = "pending_approval"
+	FixStatusRejected        = "rejected"
+	FixStatusRunning         = "running"
+	FixStatusSucceeded       = "succeeded"
+	FixStatusFailed          = "failed"
+)
+
+// defaultFixExecuteTimeout 未配置时单次修复的执行超时时间
+const defaultFixExecuteTimeout = 5 * time.Minute
+
+// fixResultTimeout 记录修复结果的超时时间，与执行超时分开，执行超时后仍能写入结果
+const fixResultTimeout = 10 * time.Second
+
+const autoFixSystemPrompt = `你是一名资深 SRE，需要向审批人说明一次 Kubernetes 自动修复的计划变更。
+请用简洁的中文说明: 1. 将要修改哪些资源及具体变化；2. 该变更为什么能缓解问题；3. 可能的风险和回滚方式。
+只依据给出的计划变更说明，不要编造计划中没有的操作。`
+
+type AutoFixDomain struct {
+	FixRunRepo     repo.FixRunRepo
+	env            *fixEnv
+	playbooks      map[string]Playbook
+	approvers      []int64
+	selfApprove    bool
+	executeTimeout time.Duration
+	explainer      llms.Model
+}
+
+// AutoFixOption 自动修复配置选项
+type AutoFixOption func(*AutoFixDomain)
+
+// WithApprovers 追加可审批修复任务的用户，未配置任何审批人时所有修复任务都无法审批
+func WithApprovers(uids []int64) AutoFixOption {
+	return func(d *AutoFixDomain) {
+		d.approvers = append(d.approvers, uids...)
+	}
+}
+
+// WithSelfApprove 允许发起人审批自己的修复任务
+func WithSelfApprove(allow bool) AutoFixOption {
+	return func(d *AutoFixDomain) {
+		d.selfApprove = allow
+	}
+}
+
+// WithExecuteTimeout 设置单次修复的执行超时时间
+func WithExecuteTimeout(timeout time.Duration) AutoFixOption {
+	return func(d *AutoFixDomain) {
+		if timeout > 0 {
+			d.executeTimeout = timeout
+		}
+	}
+}
+
+// WithExplainer 使用大模型为修复计划生成说明，未配置时只返回计划变更
+func WithExplainer(llm llms.Model) AutoFixOption {
+	return func(d *AutoFixDomain) {
+		d.explainer = llm
+	}
+}
+
+// NewAutoFixDomain 创建自动修复领域对象，client 为 nil 时只能查询历史记录
+func NewAutoFixDomain(db *gorm.DB, client kubernetes.Interface, executor PodExecutor, opts ...AutoFixOption) *AutoFixDomain {
+	d := &AutoFixDomain{
+		FixRunRepo:     dao.NewFixRunDAO(db),
+		playbooks:      defaultPlaybooks(),
+		executeTimeout: defaultFixExecuteTimeout,
+	}
+	if client != nil {
+		d.env = &fixEnv{client: client, executor: executor}
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// CheckSession 从上下文中获取用户ID
+func (d *AutoFixDomain) CheckSession(ctx context.Context) (int64, error) {
+	uid, _, err := checkSession(ctx)
+	return uid, err
+}
+
+// SubmitFixTask 校验参数并生成修复计划，按 tpl 为计划生成说明；dry run 只记录计划，否则进入待审批状态
+func (d *AutoFixDomain) SubmitFixTask(ctx context.Context, uid int64, task, podID string, params map[string]string, dryRun bool, tpl *model.PromptTemplate) (*model.FixRun, error) {
+	if d.env == nil {
+		return nil, fmt.Errorf("未配置 Kubernetes，无法执行自动修复")
+	}
+
+	playbook, ok := d.playbooks[task]
+	if !ok {
+		return nil, fmt.Errorf("不支持的修复任务: %s", task)
+	}
+	if err := playbook.Validate(params); err != nil {
+		return nil, err
+	}
+
+	namespace, podName, err := ParsePodID(podID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 根据集群当前状态生成计划
+	pod, err := d.env.client.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("获取 Pod 失败: %w", err)
+	}
+	plan, err := playbook.Plan(ctx, d.env, pod, params)
+	if err != nil {
+		return nil, fmt.Errorf("生成修复计划失败: %w", err)
+	}
+
+	// 2. 持久化执行记录
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	status := FixStatusPendingApproval
+	if dryRun {
+		status = FixStatusPlanned
+	}
+	run := &model.FixRun{
+		RunID:     uuid.New().String(),
+		Task:      task,
+		Namespace: namespace,
+		PodName:   podName,
+		Params:    string(paramsJSON),
+		DryRun:    dryRun,
+		Plan:      string(planJSON),
+		Diff:      plan.Diff(),
+		Status:    status,
+		CreatorID: uid,
+	}
+	run.Explanation = d.explainPlan(ctx, uid, run, tpl)
+	if err = d.FixRunRepo.CreateFixRun(ctx, run); err != nil {
+		return nil, fmt.Errorf("保存修复任务失败: %w", err)
+	}
+
+	return run, nil
+}
+
+// explainPlan 让大模型向审批人说明计划变更；说明仅供参考，生成失败时记录日志并返回空字符串，不影响提交
+func (d *AutoFixDomain) explainPlan(ctx context.Context, uid int64, run *model.FixRun, tpl *model.PromptTemplate) string {
+	if d.explainer == nil || tpl == nil {
+		return ""
+	}
+
+	prompt, err := RenderPrompt(tpl, PromptData{
+		User:  uid,
+		Task:  run.Task,
+		PodID: run.Namespace + "/" + run.PodName,
+		Diff:  run.Diff,
+		Now:   time.Now(),
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("渲染修复计划说明模板失败: %v", err)
+		return ""
+	}
+
+	resp, err := d.explainer.GenerateContent(ctx, []llms.MessageContent{
+		llms.TextParts(llms.ChatMessageTypeSystem, prompt.System),
+		llms.TextParts(llms.ChatMessageTypeHuman, prompt.Context),
+	}, llms.WithTemperature(0))
+	if err != nil || len(resp.Choices) == 0 {
+		logx.WithContext(ctx).Errorf("生成修复计划说明失败: %v", err)
+		return ""
+	}
+
+	return strings.TrimSpace(resp.Choices[0].Content)
+}
+
+// GetFixRun 获取修复任务执行记录，只有发起人和审批人可以查看
+func (d *AutoFixDomain) GetFixRun(ctx context.Context, uid int64, runID string) (*model.FixRun, error) {
+	run, err := d.getFixRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run.CreatorID != uid && !d.isApprover(uid) {
+		return nil, fmt.Errorf("无权查看该修复任务")
+	}
+	return run, nil
+}
+
+func (d *AutoFixDomain) getFixRun(ctx context.Context, runID string) (*model.FixRun, error) {
+	run, err := d.FixRunRepo.GetFixRunByRunID(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("获取修复任务失败: %w", err)
+	}
+	return run, nil
+}
+
+// isApprover 判断用户是否在审批人名单中
+func (d *AutoFixDomain) isApprover(uid int64) bool {
+	return slices.Contains(d.approvers, uid)
+}
+
+// ApproveFixRun 审批修复任务，只有审批人可以审批，批准后异步执行，可通过 GetFixRun 轮询结果
+func (d *AutoFixDomain) ApproveFixRun(ctx context.Context, uid int64, runID string, approved bool, comment string) (*model.FixRun, error) {
+	if !d.isApprover(uid) {
+		return nil, fmt.Errorf("无权审批修复任务")
+	}
+
+	run, err := d.getFixRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run.Status != FixStatusPendingApproval {
+		return nil, fmt.Errorf("修复任务当前状态为 %s，无法审批", run.Status)
+	}
+	if run.CreatorID == uid && !d.selfApprove {
+		return nil, fmt.Errorf("不能审批自己发起的修复任务")
+	}
+
+	to := FixStatusRejected
+	if approved {
+		if d.env == nil {
+			return nil, fmt.Errorf("未配置 Kubernetes，无法执行自动修复")
+		}
+		to = FixStatusRunning
+	}
+
+	// 以状态为条件更新，并发审批时只有一个能成功
+	ok, err := d.FixRunRepo.TransitFixRun(ctx, runID, FixStatusPendingApproval, to, map[string]any{
+		"approver_id": uid,
+		"approved_at": time.Now().Unix(),
+		"message":     comment,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("更新修复任务失败: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("修复任务已被其他人审批")
+	}
+
+	if approved {
+		threading.GoSafe(func() {
+			d.executeFixRun(run)
+		})
+	}
+
+	return d.getFixRun(ctx, runID)
+}
+
+// executeFixRun 执行已批准的修复任务并记录结果
+func (d *AutoFixDomain) executeFixRun(run *model.FixRun) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.executeTimeout)
+	defer cancel()
+
+	status, message := FixStatusSucceeded, "执行成功"
+	if err := d.execute(ctx, run); err != nil {
+		status, message = FixStatusFailed, err.Error()
+	}
+
+	resultCtx, resultCancel := context.WithTimeout(context.Background(), fixResultTimeout)
+	defer resultCancel()
+	if _, err := d.FixRunRepo.TransitFixRun(resultCtx, run.RunID, FixStatusRunning, status, map[string]any{
+		"message":     message,
+		"finished_at": time.Now().Unix(),
+	}); err != nil {
+		logx.WithContext(resultCtx).Errorf("更新修复任务 %s 状态失败: %v", run.RunID, err)
+	}
+}
+
+func (d *AutoFixDomain) execute(ctx context.Context, run *model.FixRun) error {
+	playbook, ok := d.playbooks[run.Task]
+	if !ok {
+		return fmt.Errorf("不支持的修复任务: %s", run.Task)
+	}
+
+	var plan FixPlan
+	if err := json.Unmarshal([]byte(run.Plan), &plan); err != nil {
+		return fmt.Errorf("解析修复计划失败: %w", err)
+	}
+
+	return playbook.Execute(ctx, d.env, &plan)
+}
+
+// ParsePodID 解析 namespace/name 格式的 Pod ID，省略命名空间时使用 default
+func ParsePodID(podID string) (string, string, error) {
+	namespace, name, found := strings.Cut(podID, "/")
+	if !found {
+		namespace, name = metav1.NamespaceDefault, podID
+	}
+	if namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("无效的 Pod ID: %s", podID)
+	}
+	return namespace, name, nil
+}
+
+func (d *AutoFixDomain) BuildFixRunRespModel(run *model.FixRun) *types.FixRun {
+	var params map[string]string
+	_ = json.Unmarshal([]byte(run.Params), &params)
+
+	return &types.FixRun{
+		RunId:       run.RunID,
+		Task:        run.Task,
+		PodId:       run.Namespace + "/" + run.PodName,
+		Params:      params,
+		DryRun:      run.DryRun,
+		Status:      run.Status,
+		Diff:        run.Diff,
+		Explanation: run.Explanation,
+		Message:     run.Message,
+		CreatorId:   run.CreatorID,
+		ApproverId:  run.ApproverID,
+		CreateTime:  run.CreatedAt,
+		UpdateTime:  run.UpdatedAt,
+	}
+}
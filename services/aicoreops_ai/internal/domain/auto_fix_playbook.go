@@ -0,0 +1,599 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// 修复剧本名称
+const (
+	PlaybookFixOOM           = "fixOOM"
+	PlaybookRestartCrashLoop = "restartCrashLoop"
+	PlaybookScaleUp          = "scaleUp"
+	PlaybookClearDisk        = "clearDisk"
+)
+
+const (
+	kindDeployment  = "Deployment"
+	kindStatefulSet = "StatefulSet"
+	kindPod         = "Pod"
+)
+
+// PodExecutor 在 Pod 容器内执行命令
+type PodExecutor interface {
+	Exec(ctx context.Context, namespace, pod, container string, command []string) (string, error)
+}
+
+// FixChange 一项计划变更，执行时会校验 Before 与集群当前状态一致，防止计划过期后误操作
+type FixChange struct {
+	Kind      string   `json:"kind"`
+	Namespace string   `json:"namespace"`
+	Name      string   `json:"name"`
+	UID       string   `json:"uid,omitempty"`
+	Container string   `json:"container,omitempty"`
+	Field     string   `json:"field"`
+	Before    string   `json:"before"`
+	After     string   `json:"after"`
+	Command   []string `json:"command,omitempty"`
+}
+
+// FixPlan 修复计划
+type FixPlan struct {
+	Changes []FixChange `json:"changes"`
+}
+
+// Diff 以文本形式展示计划变更
+func (p *FixPlan) Diff() string {
+	var sb strings.Builder
+	for _, c := range p.Changes {
+		fmt.Fprintf(&sb, "%s %s/%s", c.Kind, c.Namespace, c.Name)
+		if c.Container != "" {
+			fmt.Fprintf(&sb, " container=%s", c.Container)
+		}
+		fmt.Fprintf(&sb, " %s:\n- %s\n+ %s\n", c.Field, c.Before, c.After)
+	}
+	return sb.String()
+}
+
+// fixEnv 剧本执行所需的集群访问能力
+type fixEnv struct {
+	client   kubernetes.Interface
+	executor PodExecutor
+}
+
+// Playbook 修复剧本
+type Playbook interface {
+	// Validate 校验参数
+	Validate(params map[string]string) error
+	// Plan 根据目标 Pod 的当前状态生成计划变更，不修改集群
+	Plan(ctx context.Context, env *fixEnv, pod *corev1.Pod, params map[string]string) (*FixPlan, error)
+	// Execute 执行计划变更
+	Execute(ctx context.Context, env *fixEnv, plan *FixPlan) error
+}
+
+// defaultPlaybooks 内置修复剧本
+func defaultPlaybooks() map[string]Playbook {
+	return map[string]Playbook{
+		PlaybookFixOOM:           &fixOOMPlaybook{},
+		PlaybookRestartCrashLoop: &restartCrashLoopPlaybook{},
+		PlaybookScaleUp:          &scaleUpPlaybook{},
+		PlaybookClearDisk:        &clearDiskPlaybook{},
+	}
+}
+
+// checkParams 校验参数名是否都在允许范围内
+func checkParams(params map[string]string, allowed ...string) error {
+	for k := range params {
+		found := false
+		for _, a := range allowed {
+			if k == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("不支持的参数: %s", k)
+		}
+	}
+	return nil
+}
+
+func parseIntParam(params map[string]string, key string, def, min, max int) (int, error) {
+	v, ok := params[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("参数 %s 必须是整数", key)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("参数 %s 必须在 %d 到 %d 之间", key, min, max)
+	}
+	return n, nil
+}
+
+func parseQuantityParam(params map[string]string, key, def string) (resource.Quantity, error) {
+	v := params[key]
+	if v == "" {
+		v = def
+	}
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("参数 %s 不是合法的资源数量: %s", key, v)
+	}
+	if q.Sign() <= 0 {
+		return resource.Quantity{}, fmt.Errorf("参数 %s 必须大于 0", key)
+	}
+	return q, nil
+}
+
+// workload Pod 所属的工作负载，屏蔽 Deployment 与 StatefulSet 的差异
+type workload struct {
+	kind      string
+	namespace string
+	name      string
+	replicas  *int32 // 指向工作负载的副本数字段，修改后调用 update 生效
+	template  *corev1.PodTemplateSpec
+	update    func(ctx context.Context) error
+}
+
+// resolveWorkloadRef 沿 ownerReferences 找到 Pod 所属的 Deployment 或 StatefulSet
+func (e *fixEnv) resolveWorkloadRef(ctx context.Context, pod *corev1.Pod) (string, string, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "", "", fmt.Errorf("Pod %s/%s 不受控制器管理", pod.Namespace, pod.Name)
+	}
+
+	switch owner.Kind {
+	case kindStatefulSet:
+		return kindStatefulSet, owner.Name, nil
+	case "ReplicaSet":
+		rs, err := e.client.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("获取 ReplicaSet 失败: %w", err)
+		}
+		if rsOwner := metav1.GetControllerOf(rs); rsOwner != nil && rsOwner.Kind == kindDeployment {
+			return kindDeployment, rsOwner.Name, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("Pod %s/%s 不属于 Deployment 或 StatefulSet", pod.Namespace, pod.Name)
+}
+
+func (e *fixEnv) getWorkload(ctx context.Context, kind, namespace, name string) (*workload, error) {
+	switch kind {
+	case kindDeployment:
+		d, err := e.client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("获取 Deployment 失败: %w", err)
+		}
+		if d.Spec.Replicas == nil {
+			d.Spec.Replicas = ptrInt32(1)
+		}
+		return &workload{
+			kind: kind, namespace: namespace, name: name,
+			replicas: d.Spec.Replicas,
+			template: &d.Spec.Template,
+			update: func(ctx context.Context) error {
+				_, err := e.client.AppsV1().Deployments(namespace).Update(ctx, d, metav1.UpdateOptions{})
+				return err
+			},
+		}, nil
+	case kindStatefulSet:
+		s, err := e.client.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("获取 StatefulSet 失败: %w", err)
+		}
+		if s.Spec.Replicas == nil {
+			s.Spec.Replicas = ptrInt32(1)
+		}
+		return &workload{
+			kind: kind, namespace: namespace, name: name,
+			replicas: s.Spec.Replicas,
+			template: &s.Spec.Template,
+			update: func(ctx context.Context) error {
+				_, err := e.client.AppsV1().StatefulSets(namespace).Update(ctx, s, metav1.UpdateOptions{})
+				return err
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("不支持的工作负载类型: %s", kind)
+	}
+}
+
+func (e *fixEnv) podWorkload(ctx context.Context, pod *corev1.Pod) (*workload, error) {
+	kind, name, err := e.resolveWorkloadRef(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+	return e.getWorkload(ctx, kind, pod.Namespace, name)
+}
+
+func findContainer(template *corev1.PodTemplateSpec, name string) *corev1.Container {
+	for i := range template.Spec.Containers {
+		if template.Spec.Containers[i].Name == name {
+			return &template.Spec.Containers[i]
+		}
+	}
+	return nil
+}
+
+func ptrInt32(v int32) *int32 {
+	return &v
+}
+
+// fixOOMPlaybook 按倍数调高容器内存限制
+type fixOOMPlaybook struct{}
+
+func (p *fixOOMPlaybook) Validate(params map[string]string) error {
+	if err := checkParams(params, "container", "factor", "memory", "max"); err != nil {
+		return err
+	}
+	if v := params["factor"]; v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f <= 1 || f > 4 {
+			return fmt.Errorf("参数 factor 必须是 1 到 4 之间的小数")
+		}
+	}
+	if params["memory"] != "" {
+		if _, err := parseQuantityParam(params, "memory", ""); err != nil {
+			return err
+		}
+	}
+	_, err := parseQuantityParam(params, "max", "8Gi")
+	return err
+}
+
+func (p *fixOOMPlaybook) Plan(ctx context.Context, env *fixEnv, pod *corev1.Pod, params map[string]string) (*FixPlan, error) {
+	w, err := env.podWorkload(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未指定容器时优先选择被 OOMKilled 的容器
+	name := params["container"]
+	if name == "" {
+		name = oomKilledContainer(pod)
+	}
+	c := findContainer(w.template, name)
+	if c == nil {
+		return nil, fmt.Errorf("容器 %s 不存在", name)
+	}
+
+	current, ok := c.Resources.Limits[corev1.ResourceMemory]
+	if !ok && params["memory"] == "" {
+		return nil, fmt.Errorf("容器 %s 未设置内存限制，请通过参数 memory 指定", c.Name)
+	}
+
+	var target resource.Quantity
+	if params["memory"] != "" {
+		target, _ = parseQuantityParam(params, "memory", "")
+	} else {
+		factor := 1.5
+		if v := params["factor"]; v != "" {
+			factor, _ = strconv.ParseFloat(v, 64)
+		}
+		target = *resource.NewQuantity(int64(float64(current.Value())*factor), resource.BinarySI)
+	}
+
+	max, _ := parseQuantityParam(params, "max", "8Gi")
+	if target.Cmp(max) > 0 {
+		target = max
+	}
+	if ok && target.Cmp(current) <= 0 {
+		return nil, fmt.Errorf("容器 %s 内存限制 %s 已达到上限 %s", c.Name, current.String(), max.String())
+	}
+
+	before := ""
+	if ok {
+		before = current.String()
+	}
+
+	return &FixPlan{Changes: []FixChange{{
+		Kind:      w.kind,
+		Namespace: w.namespace,
+		Name:      w.name,
+		Container: c.Name,
+		Field:     "resources.limits.memory",
+		Before:    before,
+		After:     target.String(),
+	}}}, nil
+}
+
+func (p *fixOOMPlaybook) Execute(ctx context.Context, env *fixEnv, plan *FixPlan) error {
+	for _, change := range plan.Changes {
+		w, err := env.getWorkload(ctx, change.Kind, change.Namespace, change.Name)
+		if err != nil {
+			return err
+		}
+		c := findContainer(w.template, change.Container)
+		if c == nil {
+			return fmt.Errorf("容器 %s 不存在", change.Container)
+		}
+
+		current := ""
+		if q, ok := c.Resources.Limits[corev1.ResourceMemory]; ok {
+			current = q.String()
+		}
+		if current != change.Before {
+			return fmt.Errorf("内存限制已从 %s 变为 %s，计划已过期", change.Before, current)
+		}
+
+		target, err := resource.ParseQuantity(change.After)
+		if err != nil {
+			return err
+		}
+		if c.Resources.Limits == nil {
+			c.Resources.Limits = corev1.ResourceList{}
+		}
+		c.Resources.Limits[corev1.ResourceMemory] = target
+		if err = w.update(ctx); err != nil {
+			return fmt.Errorf("更新 %s 失败: %w", w.kind, err)
+		}
+	}
+	return nil
+}
+
+func oomKilledContainer(pod *corev1.Pod) string {
+	for _, s := range pod.Status.ContainerStatuses {
+		if t := s.LastTerminationState.Terminated; t != nil && t.Reason == "OOMKilled" {
+			return s.Name
+		}
+		if t := s.State.Terminated; t != nil && t.Reason == "OOMKilled" {
+			return s.Name
+		}
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
+
+// restartCrashLoopPlaybook 删除处于 CrashLoopBackOff 的 Pod，由控制器重建
+type restartCrashLoopPlaybook struct{}
+
+func (p *restartCrashLoopPlaybook) Validate(params map[string]string) error {
+	if err := checkParams(params, "force"); err != nil {
+		return err
+	}
+	if v := params["force"]; v != "" {
+		if _, err := strconv.ParseBool(v); err != nil {
+			return fmt.Errorf("参数 force 必须是布尔值")
+		}
+	}
+	return nil
+}
+
+func (p *restartCrashLoopPlaybook) Plan(ctx context.Context, env *fixEnv, pod *corev1.Pod, params map[string]string) (*FixPlan, error) {
+	// 没有控制器的 Pod 删除后不会重建
+	if metav1.GetControllerOf(pod) == nil {
+		return nil, fmt.Errorf("Pod %s/%s 不受控制器管理，删除后无法重建", pod.Namespace, pod.Name)
+	}
+
+	var (
+		crashLoop bool
+		restarts  int32
+	)
+	for _, s := range pod.Status.ContainerStatuses {
+		if s.State.Waiting != nil && s.State.Waiting.Reason == "CrashLoopBackOff" {
+			crashLoop = true
+		}
+		restarts += s.RestartCount
+	}
+	force, _ := strconv.ParseBool(params["force"])
+	if !crashLoop && !force {
+		return nil, fmt.Errorf("Pod %s/%s 未处于 CrashLoopBackOff 状态", pod.Namespace, pod.Name)
+	}
+
+	return &FixPlan{Changes: []FixChange{{
+		Kind:      kindPod,
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		UID:       string(pod.UID),
+		Field:     "pod",
+		Before:    fmt.Sprintf("running, phase=%s, restarts=%d", pod.Status.Phase, restarts),
+		After:     "deleted, recreated by controller",
+	}}}, nil
+}
+
+func (p *restartCrashLoopPlaybook) Execute(ctx context.Context, env *fixEnv, plan *FixPlan) error {
+	for _, change := range plan.Changes {
+		// 以 UID 作为前置条件，避免删除计划之后重建的新 Pod
+		uid := types.UID(change.UID)
+		if err := env.client.CoreV1().Pods(change.Namespace).Delete(ctx, change.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &uid},
+		}); err != nil {
+			return fmt.Errorf("删除 Pod 失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// scaleUpPlaybook 增加工作负载副本数
+type scaleUpPlaybook struct{}
+
+func (p *scaleUpPlaybook) Validate(params map[string]string) error {
+	if err := checkParams(params, "replicas", "step", "max"); err != nil {
+		return err
+	}
+	if _, err := parseIntParam(params, "replicas", 0, 1, 1000); err != nil {
+		return err
+	}
+	if _, err := parseIntParam(params, "step", 1, 1, 100); err != nil {
+		return err
+	}
+	_, err := parseIntParam(params, "max", 20, 1, 1000)
+	return err
+}
+
+func (p *scaleUpPlaybook) Plan(ctx context.Context, env *fixEnv, pod *corev1.Pod, params map[string]string) (*FixPlan, error) {
+	w, err := env.podWorkload(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	current := *w.replicas
+	target, _ := parseIntParam(params, "replicas", 0, 1, 1000)
+	if target == 0 {
+		step, _ := parseIntParam(params, "step", 1, 1, 100)
+		target = int(current) + step
+	}
+	max, _ := parseIntParam(params, "max", 20, 1, 1000)
+	if target > max {
+		target = max
+	}
+	if target <= int(current) {
+		return nil, fmt.Errorf("%s %s/%s 当前副本数 %d 已不低于目标副本数 %d", w.kind, w.namespace, w.name, current, target)
+	}
+
+	return &FixPlan{Changes: []FixChange{{
+		Kind:      w.kind,
+		Namespace: w.namespace,
+		Name:      w.name,
+		Field:     "replicas",
+		Before:    strconv.Itoa(int(current)),
+		After:     strconv.Itoa(target),
+	}}}, nil
+}
+
+func (p *scaleUpPlaybook) Execute(ctx context.Context, env *fixEnv, plan *FixPlan) error {
+	for _, change := range plan.Changes {
+		w, err := env.getWorkload(ctx, change.Kind, change.Namespace, change.Name)
+		if err != nil {
+			return err
+		}
+		if current := strconv.Itoa(int(*w.replicas)); current != change.Before {
+			return fmt.Errorf("副本数已从 %s 变为 %s，计划已过期", change.Before, current)
+		}
+
+		target, err := strconv.Atoi(change.After)
+		if err != nil {
+			return err
+		}
+		*w.replicas = int32(target)
+		if err = w.update(ctx); err != nil {
+			return fmt.Errorf("更新 %s 失败: %w", w.kind, err)
+		}
+	}
+	return nil
+}
+
+// clearDiskPlaybook 删除容器内指定目录下的过期文件
+type clearDiskPlaybook struct{}
+
+var diskPatternRegexp = regexp.MustCompile(`^[A-Za-z0-9._*?\-]+$`)
+
+// protectedPaths 禁止清理的系统目录
+var protectedPaths = map[string]bool{
+	"/": true, "/bin": true, "/boot": true, "/dev": true, "/etc": true, "/lib": true, "/lib64": true,
+	"/proc": true, "/root": true, "/sbin": true, "/sys": true, "/usr": true, "/var": true,
+}
+
+// maxPlanFiles 计划中最多展示的文件数
+const maxPlanFiles = 50
+
+func (p *clearDiskPlaybook) Validate(params map[string]string) error {
+	if err := checkParams(params, "container", "path", "pattern", "days"); err != nil {
+		return err
+	}
+
+	dir := params["path"]
+	if dir == "" {
+		return fmt.Errorf("参数 path 不能为空")
+	}
+	if !path.IsAbs(dir) || path.Clean(dir) != dir {
+		return fmt.Errorf("参数 path 必须是规范的绝对路径")
+	}
+	if protectedPaths[dir] {
+		return fmt.Errorf("禁止清理系统目录: %s", dir)
+	}
+	if v := params["pattern"]; v != "" && !diskPatternRegexp.MatchString(v) {
+		return fmt.Errorf("参数 pattern 只能包含字母、数字及 . _ - * ?")
+	}
+	_, err := parseIntParam(params, "days", 7, 0, 3650)
+	return err
+}
+
+func (p *clearDiskPlaybook) Plan(ctx context.Context, env *fixEnv, pod *corev1.Pod, params map[string]string) (*FixPlan, error) {
+	if env.executor == nil {
+		return nil, fmt.Errorf("未配置 Pod 命令执行器")
+	}
+
+	container := params["container"]
+	if container == "" && len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+	pattern := params["pattern"]
+	if pattern == "" {
+		pattern = "*.log"
+	}
+	days, _ := parseIntParam(params, "days", 7, 0, 3650)
+
+	// 命令以参数数组传递，不经过 shell，避免注入
+	find := []string{"find", params["path"], "-type", "f", "-name", pattern, "-mtime", fmt.Sprintf("+%d", days)}
+	out, err := env.executor.Exec(ctx, pod.Namespace, pod.Name, container, find)
+	if err != nil {
+		return nil, fmt.Errorf("查找待清理文件失败: %w", err)
+	}
+
+	var files []string
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("目录 %s 下没有匹配 %s 且超过 %d 天的文件", params["path"], pattern, days)
+	}
+	shown := files
+	if len(shown) > maxPlanFiles {
+		shown = shown[:maxPlanFiles]
+	}
+	before := fmt.Sprintf("%d files: %s", len(files), strings.Join(shown, " "))
+	if len(files) > len(shown) {
+		before += " ..."
+	}
+
+	return &FixPlan{Changes: []FixChange{{
+		Kind:      kindPod,
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		UID:       string(pod.UID),
+		Container: container,
+		Field:     "files",
+		Before:    before,
+		After:     "deleted",
+		Command:   append(find, "-delete"),
+	}}}, nil
+}
+
+func (p *clearDiskPlaybook) Execute(ctx context.Context, env *fixEnv, plan *FixPlan) error {
+	if env.executor == nil {
+		return fmt.Errorf("未配置 Pod 命令执行器")
+	}
+
+	for _, change := range plan.Changes {
+		pod, err := env.client.CoreV1().Pods(change.Namespace).Get(ctx, change.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("获取 Pod 失败: %w", err)
+		}
+		if string(pod.UID) != change.UID {
+			return fmt.Errorf("Pod %s/%s 已重建，计划已过期", change.Namespace, change.Name)
+		}
+		if _, err = env.executor.Exec(ctx, change.Namespace, change.Name, change.Container, change.Command); err != nil {
+			return fmt.Errorf("清理文件失败: %w", err)
+		}
+	}
+	return nil
+}
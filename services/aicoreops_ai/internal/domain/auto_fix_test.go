@@ -0,0 +1,346 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// fakeExecutor 记录执行的命令并返回预设输出
+type fakeExecutor struct {
+	output   string
+	commands [][]string
+}
+
+func (f *fakeExecutor) Exec(ctx context.Context, namespace, pod, container string, command []string) (string, error) {
+	f.commands = append(f.commands, command)
+	return f.output, nil
+}
+
+type fakeFixRunRepo struct {
+	runs map[string]*model.FixRun
+}
+
+func (f *fakeFixRunRepo) CreateFixRun(ctx context.Context, run *model.FixRun) error {
+	f.runs[run.RunID] = run
+	return nil
+}
+
+func (f *fakeFixRunRepo) GetFixRunByRunID(ctx context.Context, runID string) (*model.FixRun, error) {
+	run, ok := f.runs[runID]
+	if !ok {
+		return nil, fmt.Errorf("record not found")
+	}
+	res := *run
+	return &res, nil
+}
+
+func (f *fakeFixRunRepo) TransitFixRun(ctx context.Context, runID, from, to string, fields map[string]any) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	run, ok := f.runs[runID]
+	if !ok || run.Status != from {
+		return false, nil
+	}
+	run.Status = to
+	if approver, ok := fields["approver_id"].(int64); ok {
+		run.ApproverID = approver
+	}
+	if message, ok := fields["message"].(string); ok {
+		run.Message = message
+	}
+	return true, nil
+}
+
+// blockingPlaybook 执行时一直阻塞到上下文结束
+type blockingPlaybook struct {
+	Playbook
+}
+
+func (blockingPlaybook) Execute(ctx context.Context, env *fixEnv, plan *FixPlan) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func newFixEnv(memoryLimit string, replicas int32, executor PodExecutor) (*fixEnv, *corev1.Pod) {
+	isController := true
+	limits := corev1.ResourceList{}
+	if memoryLimit != "" {
+		limits[corev1.ResourceMemory] = resource.MustParse(memoryLimit)
+	}
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "prod"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: "app", Resources: corev1.ResourceRequirements{Limits: limits}},
+			}}},
+		},
+	}
+	rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+		Name: "web-7d9f", Namespace: "prod",
+		OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web", Controller: &isController}},
+	}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web-7d9f-abcde", Namespace: "prod", UID: "uid-1",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web-7d9f", Controller: &isController}},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:                 "app",
+			RestartCount:         5,
+			State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+		}}},
+	}
+
+	return &fixEnv{client: fake.NewSimpleClientset(deploy, rs, pod), executor: executor}, pod
+}
+
+func TestFixOOMPlaybook(t *testing.T) {
+	ctx := context.Background()
+	env, pod := newFixEnv("512Mi", 2, nil)
+	p := &fixOOMPlaybook{}
+
+	params := map[string]string{"factor": "2"}
+	if err := p.Validate(params); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	plan, err := p.Plan(ctx, env, pod, params)
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	change := plan.Changes[0]
+	if change.Kind != kindDeployment || change.Name != "web" || change.Before != "512Mi" || change.After != "1Gi" {
+		t.Fatalf("unexpected plan: %+v", change)
+	}
+	if !strings.Contains(plan.Diff(), "+ 1Gi") {
+		t.Errorf("unexpected diff: %s", plan.Diff())
+	}
+
+	if err = p.Execute(ctx, env, plan); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	d, _ := env.client.AppsV1().Deployments("prod").Get(ctx, "web", metav1.GetOptions{})
+	if got := d.Spec.Template.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory]; got.String() != "1Gi" {
+		t.Errorf("expected memory limit 1Gi, got %s", got.String())
+	}
+
+	// 计划已执行后再次执行应检测到状态变化
+	if err = p.Execute(ctx, env, plan); err == nil {
+		t.Error("expected stale plan error")
+	}
+}
+
+func TestFixOOMPlaybook_Validate(t *testing.T) {
+	p := &fixOOMPlaybook{}
+	for _, params := range []map[string]string{
+		{"factor": "0.5"},
+		{"memory": "abc"},
+		{"unknown": "1"},
+	} {
+		if err := p.Validate(params); err == nil {
+			t.Errorf("expected error for params %v", params)
+		}
+	}
+}
+
+func TestScaleUpPlaybook(t *testing.T) {
+	ctx := context.Background()
+	env, pod := newFixEnv("", 2, nil)
+	p := &scaleUpPlaybook{}
+
+	plan, err := p.Plan(ctx, env, pod, map[string]string{"step": "10", "max": "5"})
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if plan.Changes[0].Before != "2" || plan.Changes[0].After != "5" {
+		t.Fatalf("unexpected plan: %+v", plan.Changes[0])
+	}
+	if err = p.Execute(ctx, env, plan); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	d, _ := env.client.AppsV1().Deployments("prod").Get(ctx, "web", metav1.GetOptions{})
+	if *d.Spec.Replicas != 5 {
+		t.Errorf("expected 5 replicas, got %d", *d.Spec.Replicas)
+	}
+
+	if _, err = p.Plan(ctx, env, pod, map[string]string{"max": "5"}); err == nil {
+		t.Error("expected error when already at max replicas")
+	}
+}
+
+func TestRestartCrashLoopPlaybook(t *testing.T) {
+	ctx := context.Background()
+	env, pod := newFixEnv("", 1, nil)
+	p := &restartCrashLoopPlaybook{}
+
+	plan, err := p.Plan(ctx, env, pod, nil)
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if err = p.Execute(ctx, env, plan); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if _, err = env.client.CoreV1().Pods("prod").Get(ctx, pod.Name, metav1.GetOptions{}); err == nil {
+		t.Error("expected pod to be deleted")
+	}
+
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{}
+	if _, err = p.Plan(ctx, env, pod, nil); err == nil {
+		t.Error("expected error for healthy pod")
+	}
+}
+
+func TestClearDiskPlaybook(t *testing.T) {
+	ctx := context.Background()
+	executor := &fakeExecutor{output: "/data/logs/a.log\n/data/logs/b.log\n"}
+	env, pod := newFixEnv("", 1, executor)
+	p := &clearDiskPlaybook{}
+
+	for _, params := range []map[string]string{
+		{"path": "/"},
+		{"path": "/data/../etc"},
+		{"path": "data"},
+		{"path": "/data", "pattern": "*.log; rm -rf /"},
+	} {
+		if err := p.Validate(params); err == nil {
+			t.Errorf("expected error for params %v", params)
+		}
+	}
+
+	params := map[string]string{"path": "/data/logs", "days": "3"}
+	if err := p.Validate(params); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	plan, err := p.Plan(ctx, env, pod, params)
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if !strings.HasPrefix(plan.Changes[0].Before, "2 files") {
+		t.Errorf("unexpected plan: %+v", plan.Changes[0])
+	}
+	if err = p.Execute(ctx, env, plan); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	last := executor.commands[len(executor.commands)-1]
+	if last[len(last)-1] != "-delete" || last[1] != "/data/logs" || last[7] != "+3" {
+		t.Errorf("unexpected command: %v", last)
+	}
+}
+
+func TestParsePodID(t *testing.T) {
+	if ns, name, err := ParsePodID("web-1"); err != nil || ns != "default" || name != "web-1" {
+		t.Errorf("unexpected result: %s %s %v", ns, name, err)
+	}
+	if ns, name, err := ParsePodID("prod/web-1"); err != nil || ns != "prod" || name != "web-1" {
+		t.Errorf("unexpected result: %s %s %v", ns, name, err)
+	}
+	if _, _, err := ParsePodID("a/b/c"); err == nil {
+		t.Error("expected error for invalid pod id")
+	}
+}
+
+func TestApproveFixRun(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeFixRunRepo{runs: map[string]*model.FixRun{
+		"run-1": {RunID: "run-1", Status: FixStatusPendingApproval, CreatorID: 1},
+		"run-2": {RunID: "run-2", Status: FixStatusPendingApproval, CreatorID: 2},
+	}}
+	d := &AutoFixDomain{FixRunRepo: repo}
+	WithApprovers([]int64{2})(d)
+	WithApprovers([]int64{9})(d)
+
+	if _, err := d.ApproveFixRun(ctx, 3, "run-1", false, ""); err == nil {
+		t.Error("expected user outside approvers to be rejected")
+	}
+	if _, err := d.ApproveFixRun(ctx, 2, "run-2", false, ""); err == nil {
+		t.Error("expected approver to be rejected on own run without self approve")
+	}
+
+	run, err := d.ApproveFixRun(ctx, 9, "run-1", false, "风险过高")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Status != FixStatusRejected || run.ApproverID != 9 {
+		t.Errorf("unexpected run: %+v", run)
+	}
+}
+
+func TestGetFixRun(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeFixRunRepo{runs: map[string]*model.FixRun{
+		"run-1": {RunID: "run-1", Status: FixStatusPendingApproval, CreatorID: 1},
+	}}
+	d := &AutoFixDomain{FixRunRepo: repo}
+	WithApprovers([]int64{2})(d)
+
+	for _, uid := range []int64{1, 2} {
+		if _, err := d.GetFixRun(ctx, uid, "run-1"); err != nil {
+			t.Errorf("uid %d: unexpected error: %v", uid, err)
+		}
+	}
+	if _, err := d.GetFixRun(ctx, 3, "run-1"); err == nil {
+		t.Error("expected other users to be denied")
+	}
+}
+
+func TestExecuteFixRunTimeout(t *testing.T) {
+	repo := &fakeFixRunRepo{runs: map[string]*model.FixRun{
+		"run-1": {RunID: "run-1", Task: "block", Plan: "{}", Status: FixStatusRunning},
+	}}
+	d := &AutoFixDomain{
+		FixRunRepo:     repo,
+		playbooks:      map[string]Playbook{"block": blockingPlaybook{}},
+		executeTimeout: 10 * time.Millisecond,
+	}
+
+	d.executeFixRun(repo.runs["run-1"])
+
+	// 执行超时后仍要记录失败结果，否则任务一直停留在执行中
+	if run := repo.runs["run-1"]; run.Status != FixStatusFailed || !strings.Contains(run.Message, context.DeadlineExceeded.Error()) {
+		t.Errorf("unexpected run: %+v", run)
+	}
+}
+
+func TestSubmitFixTaskExplanation(t *testing.T) {
+	ctx := context.Background()
+	env, _ := newFixEnv("512Mi", 2, nil)
+	repo := &fakeFixRunRepo{runs: map[string]*model.FixRun{}}
+	llm := &scriptedLLM{choices: []*llms.ContentChoice{{Content: " 将 web 的内存上限调整为 1Gi \n"}}}
+	d := &AutoFixDomain{FixRunRepo: repo, env: env, playbooks: defaultPlaybooks()}
+	WithExplainer(llm)(d)
+
+	run, err := d.SubmitFixTask(ctx, 1, "fixOOM", "prod/web-7d9f-abcde", map[string]string{"factor": "2"}, true, builtinPrompts[PromptFeatureAutoFix])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Explanation != "将 web 的内存上限调整为 1Gi" {
+		t.Errorf("unexpected explanation: %q", run.Explanation)
+	}
+	if len(llm.calls) != 1 || !strings.Contains(llm.calls[0][1].Parts[0].(llms.TextContent).Text, strings.TrimSpace(run.Diff)) {
+		t.Errorf("expected the plan diff in the prompt, got %+v", llm.calls)
+	}
+
+	// 说明生成失败不影响提交
+	run, err = d.SubmitFixTask(ctx, 1, "fixOOM", "prod/web-7d9f-abcde", map[string]string{"factor": "2"}, true, builtinPrompts[PromptFeatureAutoFix])
+	if err != nil || run.Explanation != "" || run.Diff == "" {
+		t.Errorf("run = %+v, err = %v", run, err)
+	}
+}
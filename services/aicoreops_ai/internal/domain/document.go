@@ -0,0 +1,297 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+
+	"github.com/tmc/langchaingo/schema"
+)
+
+// UploadDocument 上传文档到知识库，同一知识库中内容相同的文档只入库一次，返回值 duplicated 表示命中已有文档，
+// report 为写入向量库前分块的脱敏情况
+func (d *AIHelperDomain) UploadDocument(ctx context.Context, ownerID, kbID int64, title, format, content string) (*model.Document, bool, pkg.RedactionReport, error) {
+	// 1. 解码并拆分文档
+	docs, hash, format, err := d.splitDocument(ctx, title, format, content)
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	// 2. 按内容哈希去重
+	existing, err := d.DocumentRepo.GetDocumentByHash(ctx, kbID, hash)
+	if err != nil {
+		return nil, false, nil, fmt.Errorf("查询文档失败: %w", err)
+	}
+	if existing != nil {
+		return existing, true, nil, nil
+	}
+	report := d.redactChunks(docs)
+
+	// 3. 存储到 Qdrant 向量存储中
+	doc := &model.Document{
+		DocID:           uuid.New().String(),
+		Title:           title,
+		OwnerID:         ownerID,
+		KnowledgeBaseID: kbID,
+		ContentHash:     hash,
+		Format:          format,
+		ChunkCount:      len(docs),
+		Content:         content,
+	}
+	if err = d.Qdrant.StoreDocumentWithMetadata(ctx, docs, dao.DocumentMetadata{
+		DocID:           doc.DocID,
+		Title:           doc.Title,
+		Revision:        time.Now().UnixNano(),
+		KnowledgeBaseID: kbID,
+	}); err != nil {
+		return nil, false, nil, fmt.Errorf("添加文档失败: %w", err)
+	}
+
+	// 4. 保存文档元数据，失败时回滚已写入的向量；并发上传相同内容时唯一索引冲突，返回先入库的文档
+	if err = d.DocumentRepo.CreateDocument(ctx, doc); err != nil {
+		if delErr := d.Qdrant.DeleteDocumentChunks(ctx, doc.DocID, 0); delErr != nil {
+			return nil, false, nil, fmt.Errorf("保存文档失败: %w, 回滚向量失败: %v", err, delErr)
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			existing, getErr := d.DocumentRepo.GetDocumentByHash(ctx, kbID, hash)
+			if getErr == nil && existing != nil {
+				return existing, true, nil, nil
+			}
+		}
+		return nil, false, nil, fmt.Errorf("保存文档失败: %w", err)
+	}
+	d.Cache.Invalidate(kbID)
+
+	return doc, false, report, nil
+}
+
+// GetDocumentList 获取可访问知识库中的文档列表
+func (d *AIHelperDomain) GetDocumentList(ctx context.Context, scope *KnowledgeScope, limit, offset int) ([]*types.Document, int64, error) {
+	docs, total, err := d.DocumentRepo.GetDocumentList(ctx, scope.IDs, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("获取文档列表失败: %w", err)
+	}
+
+	res := make([]*types.Document, 0, len(docs))
+	for _, doc := range docs {
+		res = append(res, d.BuildDocumentRespModel(doc, scope.Name(doc.KnowledgeBaseID)))
+	}
+
+	return res, total, nil
+}
+
+// GetDocument 获取文档详情，不在可访问知识库中的文档视为不存在
+func (d *AIHelperDomain) GetDocument(ctx context.Context, scope *KnowledgeScope, docID string) (*types.Document, error) {
+	doc, err := d.getScopedDocument(ctx, scope, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.BuildDocumentRespModel(doc, scope.Name(doc.KnowledgeBaseID)), nil
+}
+
+// UpdateDocument 更新可写知识库中的文档，重新分块写入新版本向量后删除旧版本
+func (d *AIHelperDomain) UpdateDocument(ctx context.Context, scope *KnowledgeScope, docID, title, format, content string) (*types.Document, error) {
+	doc, err := d.getScopedDocument(ctx, scope, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	if title != "" {
+		doc.Title = title
+	}
+	if content == "" {
+		content = doc.Content
+		if format == "" {
+			format = doc.Format
+		}
+	}
+
+	// 1. 重新拆分，内容不能与其他文档重复
+	docs, hash, format, err := d.splitDocument(ctx, doc.Title, format, content)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := d.DocumentRepo.GetDocumentByHash(ctx, doc.KnowledgeBaseID, hash)
+	if err != nil {
+		return nil, fmt.Errorf("查询文档失败: %w", err)
+	}
+	if existing != nil && existing.DocID != doc.DocID {
+		return nil, fmt.Errorf("文档内容与已有文档 %s 重复", existing.DocID)
+	}
+	if report := d.redactChunks(docs); len(report) > 0 {
+		logx.WithContext(ctx).Infof("文档 %s 分块已脱敏: %s", doc.DocID, report)
+	}
+
+	// 2. 写入新版本向量
+	revision := time.Now().UnixNano()
+	if err = d.Qdrant.StoreDocumentWithMetadata(ctx, docs, dao.DocumentMetadata{
+		DocID:           doc.DocID,
+		Title:           doc.Title,
+		Revision:        revision,
+		KnowledgeBaseID: doc.KnowledgeBaseID,
+	}); err != nil {
+		return nil, fmt.Errorf("添加文档失败: %w", err)
+	}
+
+	// 3. 更新元数据，失败时回滚新版本向量，旧版本仍然有效
+	doc.ContentHash = hash
+	doc.Format = format
+	doc.ChunkCount = len(docs)
+	doc.Content = content
+	if err = d.DocumentRepo.UpdateDocument(ctx, doc); err != nil {
+		if delErr := d.Qdrant.DeleteDocumentRevision(ctx, doc.DocID, revision); delErr != nil {
+			return nil, fmt.Errorf("更新文档失败: %w, 回滚向量失败: %v", err, delErr)
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("文档内容与已有文档重复")
+		}
+		return nil, fmt.Errorf("更新文档失败: %w", err)
+	}
+
+	// 4. 删除旧版本向量
+	if err = d.Qdrant.DeleteDocumentChunks(ctx, doc.DocID, revision); err != nil {
+		return nil, fmt.Errorf("删除旧版本向量失败: %w", err)
+	}
+	d.Cache.Invalidate(doc.KnowledgeBaseID)
+
+	return d.BuildDocumentRespModel(doc, scope.Name(doc.KnowledgeBaseID)), nil
+}
+
+// DeleteDocument 删除可写知识库中的文档，先删除向量避免残留分块仍被检索到
+func (d *AIHelperDomain) DeleteDocument(ctx context.Context, scope *KnowledgeScope, docID string) error {
+	doc, err := d.getScopedDocument(ctx, scope, docID)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Qdrant.DeleteDocumentChunks(ctx, docID, 0); err != nil {
+		return fmt.Errorf("删除文档向量失败: %w", err)
+	}
+	d.Cache.Invalidate(doc.KnowledgeBaseID)
+
+	if err := d.DocumentRepo.DeleteDocument(ctx, docID); err != nil {
+		return fmt.Errorf("删除文档失败: %w", err)
+	}
+
+	return nil
+}
+
+// reindexDocument 按 MySQL 中保存的原文重新分块写入向量库，已删除的文档只删除向量
+func (d *AIHelperDomain) reindexDocument(ctx context.Context, doc *model.Document) error {
+	if err := d.Qdrant.DeleteDocumentChunks(ctx, doc.DocID, 0); err != nil {
+		return fmt.Errorf("删除文档向量失败: %w", err)
+	}
+	if doc.DeletedAt > 0 {
+		return nil
+	}
+
+	docs, _, _, err := d.splitDocument(ctx, doc.Title, doc.Format, doc.Content)
+	if err != nil {
+		return err
+	}
+	d.redactChunks(docs)
+
+	if err = d.Qdrant.StoreDocumentWithMetadata(ctx, docs, dao.DocumentMetadata{
+		DocID:           doc.DocID,
+		Title:           doc.Title,
+		Revision:        time.Now().UnixNano(),
+		KnowledgeBaseID: doc.KnowledgeBaseID,
+	}); err != nil {
+		return fmt.Errorf("添加文档失败: %w", err)
+	}
+
+	return nil
+}
+
+// getScopedDocument 获取文档并校验所属知识库在访问范围内，可写范围还校验公共知识库中文档的上传者
+func (d *AIHelperDomain) getScopedDocument(ctx context.Context, scope *KnowledgeScope, docID string) (*model.Document, error) {
+	doc, err := d.DocumentRepo.GetDocumentByDocID(ctx, docID)
+	if err != nil {
+		return nil, fmt.Errorf("获取文档失败: %w", err)
+	}
+	if !scope.Contains(doc.KnowledgeBaseID) {
+		return nil, fmt.Errorf("文档 %s 不存在或无权访问", docID)
+	}
+	if !scope.canModify(doc) {
+		return nil, fmt.Errorf("无权修改其他用户上传的公共文档 %s", docID)
+	}
+
+	return doc, nil
+}
+
+// splitDocument 解码、识别格式并拆分文档，返回分块、内容哈希与实际格式
+func (d *AIHelperDomain) splitDocument(ctx context.Context, title, format, content string) ([]schema.Document, string, string, error) {
+	// 1. 解码 Base64 内容
+	fileBytes, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("解码 Base64 内容失败: %w", err)
+	}
+
+	// 2. 识别文档格式
+	format, err = dao.DetectFormat(format, title, fileBytes)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	// 3. 将文件拆分成文档块
+	docs, err := d.Qdrant.FileToDocuments(ctx, format, fileBytes)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("拆分文档失败: %w", err)
+	}
+	if len(docs) == 0 {
+		return nil, "", "", fmt.Errorf("文档中没有可提取的文本")
+	}
+
+	sum := sha256.Sum256(fileBytes)
+	return docs, hex.EncodeToString(sum[:]), format, nil
+}
+
+// redactChunks 写入向量库前对分块脱敏，原文仍保存在 MySQL 中，重新入库时再次脱敏
+func (d *AIHelperDomain) redactChunks(docs []schema.Document) pkg.RedactionReport {
+	var report pkg.RedactionReport
+	for i := range docs {
+		var r pkg.RedactionReport
+		docs[i].PageContent, r = d.Redactor.Redact(docs[i].PageContent)
+		report = report.Merge(r)
+	}
+
+	return report
+}
+
+// BuildRedactions 转换脱敏报告，按规则名称排序
+func BuildRedactions(report pkg.RedactionReport) []*types.Redaction {
+	redactions := make([]*types.Redaction, 0, len(report))
+	for _, rule := range report.Rules() {
+		redactions = append(redactions, &types.Redaction{Rule: rule, Count: int32(report[rule])})
+	}
+
+	return redactions
+}
+
+func (d *AIHelperDomain) BuildDocumentRespModel(doc *model.Document, kbName string) *types.Document {
+	return &types.Document{
+		KnowledgeBase: kbName,
+		DocId:         doc.DocID,
+		Title:         doc.Title,
+		OwnerId:       doc.OwnerID,
+		ContentHash:   doc.ContentHash,
+		ChunkCount:    int32(doc.ChunkCount),
+		Format:        doc.Format,
+		Content:       doc.Content,
+		CreateTime:    doc.CreatedAt,
+		UpdateTime:    doc.UpdatedAt,
+	}
+}
@@ -0,0 +1,338 @@
+package domain
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/tmc/langchaingo/llms"
+	"github.com/tmc/langchaingo/schema"
+)
+
+// EvalCase 评测集中的一条问答
+type EvalCase struct {
+	Question     string   `json:"question"`
+	Answer       string   `json:"answer,omitempty"`        // 参考答案，为空时不计算答案相似度
+	RelevantDocs []string `json:"relevant_docs,omitempty"` // 应召回的文档ID或标题，为空时不计算召回指标
+	Title        string   `json:"title,omitempty"`         // 限定检索的文档标题
+}
+
+// EvalOptions 评测使用的检索参数，与 AskQuestionRequest 中的参数含义一致
+type EvalOptions struct {
+	ScoreThreshold float32 `json:"score_threshold"`
+	TopK           int     `json:"top_k"`
+	RetrievalMode  string  `json:"retrieval_mode"`
+	Rerank         bool    `json:"rerank"`
+	RetrievalOnly  bool    `json:"retrieval_only"` // 只评测检索，不调用大模型生成回答
+}
+
+// EvalCaseResult 单条问答的评测结果
+type EvalCaseResult struct {
+	Question       string   `json:"question"`
+	Retrieved      []string `json:"retrieved"` // 按排名去重后的文档ID或标题
+	Recall         float64  `json:"recall"`
+	ReciprocalRank float64  `json:"reciprocal_rank"`
+	Answer         string   `json:"answer,omitempty"`
+	Similarity     float64  `json:"similarity"`
+	Error          string   `json:"error,omitempty"`
+}
+
+// EvalReport 评测汇总，指标为参与计算的问答的平均值
+type EvalReport struct {
+	Options          EvalOptions       `json:"options"`
+	Cases            []*EvalCaseResult `json:"cases"`
+	RecallAtK        float64           `json:"recall_at_k"`
+	MRR              float64           `json:"mrr"`
+	AnswerSimilarity float64           `json:"answer_similarity"`
+	Failed           int               `json:"failed"`
+}
+
+// EvalDomain 离线评测：回放问答集，统计检索召回率、MRR 和回答与参考答案的相似度
+type EvalDomain struct {
+	retriever *AIHelperDomain
+	llm       llms.Model
+	embedder  embeddings.Embedder
+	prompt    *model.PromptTemplate
+}
+
+// NewEvalDomain 创建评测领域对象，embedder 用于计算答案相似度，tpl 为空时使用内置对话模板
+func NewEvalDomain(retriever *AIHelperDomain, llm llms.Model, embedder embeddings.Embedder, tpl *model.PromptTemplate) *EvalDomain {
+	if tpl == nil {
+		tpl = builtinPrompts[PromptFeatureChat]
+	}
+
+	return &EvalDomain{
+		retriever: retriever,
+		llm:       llm,
+		embedder:  embedder,
+		prompt:    tpl,
+	}
+}
+
+// LoadCorpus 将文件写入检索使用的向量存储，文档ID和标题均为文件名，用于在内存向量库中评测
+func (d *EvalDomain) LoadCorpus(ctx context.Context, name string, content []byte) error {
+	format, err := dao.DetectFormat("", name, content)
+	if err != nil {
+		return err
+	}
+
+	docs, err := d.retriever.Qdrant.FileToDocuments(ctx, format, content)
+	if err != nil {
+		return fmt.Errorf("拆分文档 %s 失败: %w", name, err)
+	}
+
+	if err := d.retriever.Qdrant.StoreDocumentWithMetadata(ctx, docs, dao.DocumentMetadata{
+		DocID:    name,
+		Title:    name,
+		Revision: 1,
+	}); err != nil {
+		return fmt.Errorf("写入文档 %s 失败: %w", name, err)
+	}
+
+	return nil
+}
+
+// LoadEvalCases 读取 JSON 数组或每行一条的 JSONL 格式问答集
+func LoadEvalCases(r io.Reader) ([]EvalCase, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("读取问答集失败: %w", err)
+	}
+
+	var cases []EvalCase
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &cases); err != nil {
+			return nil, fmt.Errorf("解析问答集失败: %w", err)
+		}
+	} else {
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+		for line := 1; scanner.Scan(); line++ {
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			var c EvalCase
+			if err := json.Unmarshal([]byte(text), &c); err != nil {
+				return nil, fmt.Errorf("解析问答集第 %d 行失败: %w", line, err)
+			}
+			cases = append(cases, c)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("读取问答集失败: %w", err)
+		}
+	}
+
+	for i, c := range cases {
+		if strings.TrimSpace(c.Question) == "" {
+			return nil, fmt.Errorf("第 %d 条问答缺少问题", i+1)
+		}
+	}
+
+	return cases, nil
+}
+
+// Run 依次评测每条问答，单条失败记录错误并继续
+func (d *EvalDomain) Run(ctx context.Context, cases []EvalCase, opts EvalOptions) (*EvalReport, error) {
+	if opts.TopK <= 0 {
+		opts.TopK = defaultTopK
+	}
+
+	report := &EvalReport{Options: opts}
+	var retrievalCases, answerCases int
+	for _, c := range cases {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		res, err := d.evalCase(ctx, c, opts)
+		if err != nil {
+			res.Error = err.Error()
+			report.Failed++
+			report.Cases = append(report.Cases, res)
+			continue
+		}
+		report.Cases = append(report.Cases, res)
+
+		if len(c.RelevantDocs) > 0 {
+			retrievalCases++
+			report.RecallAtK += res.Recall
+			report.MRR += res.ReciprocalRank
+		}
+		if !opts.RetrievalOnly && c.Answer != "" {
+			answerCases++
+			report.AnswerSimilarity += res.Similarity
+		}
+	}
+
+	if retrievalCases > 0 {
+		report.RecallAtK /= float64(retrievalCases)
+		report.MRR /= float64(retrievalCases)
+	}
+	if answerCases > 0 {
+		report.AnswerSimilarity /= float64(answerCases)
+	}
+
+	return report, nil
+}
+
+func (d *EvalDomain) evalCase(ctx context.Context, c EvalCase, opts EvalOptions) (*EvalCaseResult, error) {
+	res := &EvalCaseResult{Question: c.Question}
+
+	// 1. 检索，与线上提问使用同一套检索逻辑
+	req := &types.AskQuestionRequest{
+		Question:       c.Question,
+		Title:          c.Title,
+		ScoreThreshold: opts.ScoreThreshold,
+		TopK:           int32(opts.TopK),
+		RetrievalMode:  opts.RetrievalMode,
+		Rerank:         opts.Rerank,
+	}
+	docs, err := d.retriever.RetrieveRelevantDocs(ctx, req, nil)
+	if err != nil {
+		return res, fmt.Errorf("检索失败: %w", err)
+	}
+
+	res.Retrieved = rankedDocKeys(docs)
+	res.Recall, res.ReciprocalRank = retrievalMetrics(docs, c.RelevantDocs)
+
+	if opts.RetrievalOnly {
+		return res, nil
+	}
+
+	// 2. 生成回答
+	prompt, err := RenderPrompt(d.prompt, PromptData{
+		Question: c.Question,
+		Docs:     NewPromptDocs(docs),
+		Now:      time.Now(),
+	})
+	if err != nil {
+		return res, err
+	}
+
+	completion, err := d.llm.GenerateContent(ctx, promptMessages(prompt, c.Question))
+	if err != nil {
+		return res, fmt.Errorf("生成回答失败: %w", err)
+	}
+	if len(completion.Choices) == 0 {
+		return res, fmt.Errorf("生成回答失败: 大模型未返回结果")
+	}
+	res.Answer = completion.Choices[0].Content
+
+	// 3. 与参考答案比较
+	if c.Answer == "" {
+		return res, nil
+	}
+	vectors, err := d.embedder.EmbedDocuments(ctx, []string{c.Answer, res.Answer})
+	if err != nil {
+		return res, fmt.Errorf("计算答案相似度失败: %w", err)
+	}
+	if len(vectors) != 2 {
+		return res, fmt.Errorf("计算答案相似度失败: 向量数量为 %d", len(vectors))
+	}
+	res.Similarity = float64(dao.CosineSimilarity(vectors[0], vectors[1]))
+
+	return res, nil
+}
+
+// rankedDocKeys 将分块按所属文档去重，保留首次出现的排名
+func rankedDocKeys(docs []schema.Document) []string {
+	var (
+		keys []string
+		seen = make(map[string]bool)
+	)
+	for _, doc := range docs {
+		key := docKey(doc)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// retrievalMetrics 计算 recall@k 和倒数排名，排名按文档去重后计算，文档ID或标题匹配均视为命中
+func retrievalMetrics(docs []schema.Document, relevant []string) (float64, float64) {
+	if len(relevant) == 0 {
+		return 0, 0
+	}
+
+	want := make(map[string]bool, len(relevant))
+	for _, r := range relevant {
+		want[r] = true
+	}
+
+	var (
+		found = make(map[string]bool)
+		seen  = make(map[string]bool)
+		rank  int
+		rr    float64
+	)
+	for _, doc := range docs {
+		key := docKey(doc)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		rank++
+
+		title, _ := doc.Metadata["title"].(string)
+		hit := false
+		for _, k := range []string{key, title} {
+			if want[k] {
+				found[k] = true
+				hit = true
+			}
+		}
+		if hit && rr == 0 {
+			rr = 1 / float64(rank)
+		}
+	}
+
+	hits := 0
+	for _, r := range relevant {
+		if found[r] {
+			hits++
+		}
+	}
+
+	return float64(hits) / float64(len(relevant)), rr
+}
+
+func docKey(doc schema.Document) string {
+	if id, ok := doc.Metadata["doc_id"].(string); ok && id != "" {
+		return id
+	}
+	title, _ := doc.Metadata["title"].(string)
+	return title
+}
+
+// WriteText 以表格形式输出评测结果
+func (r *EvalReport) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "#\tRECALL\tRR\tSIMILARITY\tQUESTION\tERROR")
+	for i, c := range r.Cases {
+		fmt.Fprintf(tw, "%d\t%.3f\t%.3f\t%.3f\t%s\t%s\n", i+1, c.Recall, c.ReciprocalRank, c.Similarity, truncateRunes(c.Question, 40), c.Error)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\nmode=%s top_k=%d score_threshold=%.2f rerank=%v\nrecall@%d=%.4f mrr=%.4f answer_similarity=%.4f failed=%d/%d\n",
+		r.Options.RetrievalMode, r.Options.TopK, r.Options.ScoreThreshold, r.Options.Rerank,
+		r.Options.TopK, r.RecallAtK, r.MRR, r.AnswerSimilarity, r.Failed, len(r.Cases))
+	return err
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+
+	"github.com/tmc/langchaingo/llms"
+	"github.com/tmc/langchaingo/schema"
+)
+
+// keywordEmbedder 按固定词表生成向量，包含词表中的词则对应维度为 1
+type keywordEmbedder []string
+
+func (k keywordEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		v, _ := k.EmbedQuery(ctx, text)
+		vectors = append(vectors, v)
+	}
+	return vectors, nil
+}
+
+func (k keywordEmbedder) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	text = strings.ToLower(text)
+	v := make([]float32, len(k))
+	for i, word := range k {
+		if strings.Contains(text, word) {
+			v[i] = 1
+		}
+	}
+	return v, nil
+}
+
+func newTestEvalDomain(t *testing.T, llm llms.Model) *EvalDomain {
+	embedder := keywordEmbedder{"oom", "memory", "磁盘", "日志", "nginx", "重启"}
+	store := dao.NewMemoryVectorStore(embedder)
+	retriever := &AIHelperDomain{Qdrant: dao.NewQdrantDAO(store, store)}
+	eval := NewEvalDomain(retriever, llm, embedder, nil)
+
+	corpus := map[string]string{
+		"oom.md":   "# OOM 排查\nPod 被 OOMKilled 时调大 memory limits",
+		"disk.md":  "# 磁盘告警\n磁盘使用率超过 90% 时清理日志",
+		"nginx.md": "# nginx\n修改配置后重启 nginx 服务",
+	}
+	for _, name := range []string{"oom.md", "disk.md", "nginx.md"} {
+		if err := eval.LoadCorpus(context.Background(), name, []byte(corpus[name])); err != nil {
+			t.Fatalf("LoadCorpus(%s): %v", name, err)
+		}
+	}
+
+	return eval
+}
+
+func TestEvalRun(t *testing.T) {
+	llm := &scriptedLLM{choices: []*llms.ContentChoice{
+		{Content: "调大 memory limits"},
+		{Content: "重启 nginx"},
+		{Content: "先看磁盘"},
+	}}
+	eval := newTestEvalDomain(t, llm)
+
+	cases, err := LoadEvalCases(strings.NewReader(`
+{"question": "Pod OOM 了怎么调 memory", "answer": "调大 memory limits", "relevant_docs": ["oom.md"]}
+{"question": "磁盘满了怎么清理日志", "answer": "清理日志", "relevant_docs": ["disk.md"]}
+
+{"question": "重启 nginx 后磁盘告警", "relevant_docs": ["disk.md"]}
+`))
+	if err != nil {
+		t.Fatalf("LoadEvalCases: %v", err)
+	}
+
+	report, err := eval.Run(context.Background(), cases, EvalOptions{TopK: 2, ScoreThreshold: 0.1})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if report.Failed != 0 {
+		t.Fatalf("failed cases: %+v", report.Cases)
+	}
+
+	// 第三个问题的相关文档排在第二位
+	if got := report.Cases[2].Retrieved; len(got) != 2 || got[0] != "nginx.md" || got[1] != "disk.md" {
+		t.Errorf("retrieved = %v", got)
+	}
+	if report.RecallAtK != 1 {
+		t.Errorf("recall@2 = %v, want 1", report.RecallAtK)
+	}
+	if want := (1 + 1 + 0.5) / 3; math.Abs(report.MRR-want) > 1e-9 {
+		t.Errorf("mrr = %v, want %v", report.MRR, want)
+	}
+	// 只有前两个问题有参考答案：一个完全一致，一个完全不相关
+	if math.Abs(report.AnswerSimilarity-0.5) > 1e-6 {
+		t.Errorf("answer similarity = %v, want 0.5", report.AnswerSimilarity)
+	}
+
+	// 生成回答时带上检索到的文档
+	if ctx := llm.calls[0][1].Parts[0].(llms.TextContent).Text; !strings.Contains(ctx, "OOMKilled") {
+		t.Errorf("context = %q", ctx)
+	}
+
+	var b strings.Builder
+	if err := report.WriteText(&b); err != nil || !strings.Contains(b.String(), "recall@2=1.0000") {
+		t.Errorf("report = %s, err = %v", b.String(), err)
+	}
+}
+
+func TestEvalRetrievalOnly(t *testing.T) {
+	llm := &scriptedLLM{}
+	eval := newTestEvalDomain(t, llm)
+
+	cases := []EvalCase{{Question: "重启 nginx 后磁盘告警", RelevantDocs: []string{"disk.md"}}}
+	report, err := eval.Run(context.Background(), cases, EvalOptions{TopK: 1, ScoreThreshold: 0.1, RetrievalOnly: true})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if report.RecallAtK != 0 || report.MRR != 0 {
+		t.Errorf("recall@1 = %v, mrr = %v, want 0", report.RecallAtK, report.MRR)
+	}
+	if len(llm.calls) != 0 {
+		t.Errorf("retrieval only should not call the llm")
+	}
+
+	// 关键词检索通过内存向量库遍历分块
+	report, err = eval.Run(context.Background(), []EvalCase{{Question: "清理日志", RelevantDocs: []string{"disk.md"}}},
+		EvalOptions{TopK: 1, RetrievalMode: dao.SearchModeKeyword, RetrievalOnly: true})
+	if err != nil || report.RecallAtK != 1 {
+		t.Errorf("keyword report = %+v, err = %v", report, err)
+	}
+}
+
+func TestRetrievalMetrics(t *testing.T) {
+	doc := func(id, title string) schema.Document {
+		return schema.Document{Metadata: map[string]any{"doc_id": id, "title": title}}
+	}
+	docs := []schema.Document{doc("a", "A"), doc("a", "A"), doc("b", "B"), doc("c", "C")}
+
+	recall, rr := retrievalMetrics(docs, []string{"C", "missing"})
+	if recall != 0.5 || math.Abs(rr-1.0/3) > 1e-9 {
+		t.Errorf("recall = %v, rr = %v", recall, rr)
+	}
+	if recall, rr := retrievalMetrics(docs, nil); recall != 0 || rr != 0 {
+		t.Errorf("no relevant docs: %v %v", recall, rr)
+	}
+
+	if _, err := LoadEvalCases(strings.NewReader(`[{"question": ""}]`)); err == nil {
+		t.Error("empty question should be rejected")
+	}
+}
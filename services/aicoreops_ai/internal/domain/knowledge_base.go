@@ -0,0 +1,389 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+)
+
+// PublicKnowledgeBase 公共知识库名称，未指定知识库的文档写入公共知识库，所有用户可读可上传，文档只有上传者和管理员可以修改
+const PublicKnowledgeBase = "public"
+
+// 知识库成员前缀，成员格式为 user:<用户ID> 或 role:<角色名>
+const (
+	memberUserPrefix = "user:"
+	memberRolePrefix = "role:"
+)
+
+var knowledgeBaseNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
+
+// Principal 调用方身份，角色由网关从 Casbin 查询后通过 metadata 传入
+type Principal struct {
+	UID   int64
+	Roles []string
+	Admin bool
+}
+
+// KnowledgeScope 调用方可访问的知识库，IDs 用于过滤文档和向量，0 表示公共知识库
+type KnowledgeScope struct {
+	IDs   []int64
+	Names map[int64]string
+	// writer 可写范围的调用方，用于校验公共知识库中文档的修改权限
+	writer *Principal
+}
+
+// Contains 判断知识库是否在可访问范围内，scope 为 nil 时不限制
+func (s *KnowledgeScope) Contains(id int64) bool {
+	return s == nil || slices.Contains(s.IDs, id)
+}
+
+// Name 返回知识库名称，公共知识库返回空字符串
+func (s *KnowledgeScope) Name(id int64) string {
+	if s == nil {
+		return ""
+	}
+	return s.Names[id]
+}
+
+// canModify 判断能否修改文档，公共知识库中的文档只有上传者和管理员可以修改，只读范围不校验
+func (s *KnowledgeScope) canModify(doc *model.Document) bool {
+	if s == nil || s.writer == nil || doc.KnowledgeBaseID != 0 {
+		return true
+	}
+	return s.writer.Admin || doc.OwnerID == s.writer.UID
+}
+
+type KnowledgeBaseDomain struct {
+	KnowledgeBaseRepo repo.KnowledgeBaseRepo
+	DocumentRepo      repo.DocumentRepo
+	admins            []int64
+}
+
+func NewKnowledgeBaseDomain(db *gorm.DB, admins []int64) *KnowledgeBaseDomain {
+	return &KnowledgeBaseDomain{
+		KnowledgeBaseRepo: dao.NewKnowledgeBaseDAO(db),
+		DocumentRepo:      dao.NewDocumentDAO(db),
+		admins:            admins,
+	}
+}
+
+// CheckPrincipal 从上下文中获取用户ID和角色
+func (d *KnowledgeBaseDomain) CheckPrincipal(ctx context.Context) (*Principal, error) {
+	uid, _, err := checkSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, r := range md.Get("roles") {
+			if r = strings.TrimSpace(r); r != "" {
+				roles = append(roles, r)
+			}
+		}
+	}
+
+	return &Principal{UID: uid, Roles: roles, Admin: slices.Contains(d.admins, uid)}, nil
+}
+
+// CreateKnowledgeBase 创建知识库，指定所属团队时创建人必须属于该团队
+func (d *KnowledgeBaseDomain) CreateKnowledgeBase(ctx context.Context, p *Principal, kb *model.KnowledgeBase, readers, writers []string) (*model.KnowledgeBase, error) {
+	if err := validateKnowledgeBaseName(kb.Name); err != nil {
+		return nil, err
+	}
+	if err := checkOwnerTeam(p, kb.OwnerTeam); err != nil {
+		return nil, err
+	}
+	if err := setMembers(kb, readers, writers); err != nil {
+		return nil, err
+	}
+
+	_, err := d.KnowledgeBaseRepo.GetKnowledgeBaseByName(ctx, kb.Name)
+	if err == nil {
+		return nil, fmt.Errorf("知识库 %s 已存在", kb.Name)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("查询知识库失败: %w", err)
+	}
+
+	kb.CreatorID = p.UID
+	if err := d.KnowledgeBaseRepo.CreateKnowledgeBase(ctx, kb); err != nil {
+		return nil, fmt.Errorf("创建知识库失败: %w", err)
+	}
+
+	return kb, nil
+}
+
+// UpdateKnowledgeBase 更新知识库属性和成员，只有管理员、创建人和所属团队成员可以修改
+func (d *KnowledgeBaseDomain) UpdateKnowledgeBase(ctx context.Context, p *Principal, update *model.KnowledgeBase, readers, writers []string) (*model.KnowledgeBase, error) {
+	kb, err := d.getManagedKnowledgeBase(ctx, p, update.Name)
+	if err != nil {
+		return nil, err
+	}
+	if update.OwnerTeam != kb.OwnerTeam {
+		if err := checkOwnerTeam(p, update.OwnerTeam); err != nil {
+			return nil, err
+		}
+	}
+
+	kb.Description = update.Description
+	kb.OwnerTeam = update.OwnerTeam
+	kb.TreeNodeID = update.TreeNodeID
+	kb.Public = update.Public
+	if err := setMembers(kb, readers, writers); err != nil {
+		return nil, err
+	}
+
+	if err := d.KnowledgeBaseRepo.UpdateKnowledgeBase(ctx, kb); err != nil {
+		return nil, fmt.Errorf("更新知识库失败: %w", err)
+	}
+
+	return kb, nil
+}
+
+// DeleteKnowledgeBase 删除知识库，知识库中仍有文档时不允许删除
+func (d *KnowledgeBaseDomain) DeleteKnowledgeBase(ctx context.Context, p *Principal, name string) error {
+	kb, err := d.getManagedKnowledgeBase(ctx, p, name)
+	if err != nil {
+		return err
+	}
+
+	count, err := d.DocumentRepo.CountDocuments(ctx, kb.ID)
+	if err != nil {
+		return fmt.Errorf("统计知识库文档失败: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("知识库 %s 中还有 %d 个文档，请先删除文档", name, count)
+	}
+
+	if err := d.KnowledgeBaseRepo.DeleteKnowledgeBase(ctx, kb.ID); err != nil {
+		return fmt.Errorf("删除知识库失败: %w", err)
+	}
+
+	return nil
+}
+
+// ListKnowledgeBases 获取调用方有权读取的知识库
+func (d *KnowledgeBaseDomain) ListKnowledgeBases(ctx context.Context, p *Principal, treeNodeID int64) ([]*types.KnowledgeBase, error) {
+	kbs, err := d.KnowledgeBaseRepo.ListKnowledgeBases(ctx, treeNodeID)
+	if err != nil {
+		return nil, fmt.Errorf("获取知识库列表失败: %w", err)
+	}
+
+	res := make([]*types.KnowledgeBase, 0, len(kbs))
+	for _, kb := range kbs {
+		if p.canRead(kb) {
+			res = append(res, d.BuildKnowledgeBaseRespModel(p, kb))
+		}
+	}
+
+	return res, nil
+}
+
+// ReadableScope 获取可检索的知识库范围，names 为空时返回所有有权读取的知识库，指定了无权读取的知识库时返回错误
+func (d *KnowledgeBaseDomain) ReadableScope(ctx context.Context, p *Principal, names []string) (*KnowledgeScope, error) {
+	return d.scope(ctx, p, names, p.canRead, "读取")
+}
+
+// WritableScope 获取可修改文档的知识库范围，公共知识库中只能修改自己上传的文档
+func (d *KnowledgeBaseDomain) WritableScope(ctx context.Context, p *Principal) (*KnowledgeScope, error) {
+	scope, err := d.scope(ctx, p, nil, p.canWrite, "写入")
+	if err != nil {
+		return nil, err
+	}
+	scope.writer = p
+	return scope, nil
+}
+
+// WritableKnowledgeBase 获取上传文档的目标知识库ID，名称为空时写入公共知识库
+func (d *KnowledgeBaseDomain) WritableKnowledgeBase(ctx context.Context, p *Principal, name string) (int64, error) {
+	if name == "" || name == PublicKnowledgeBase {
+		return 0, nil
+	}
+
+	scope, err := d.scope(ctx, p, []string{name}, p.canWrite, "写入")
+	if err != nil {
+		return 0, err
+	}
+	return scope.IDs[0], nil
+}
+
+func (d *KnowledgeBaseDomain) scope(ctx context.Context, p *Principal, names []string, allowed func(*model.KnowledgeBase) bool, action string) (*KnowledgeScope, error) {
+	kbs, err := d.KnowledgeBaseRepo.ListKnowledgeBases(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("获取知识库列表失败: %w", err)
+	}
+
+	byName := make(map[string]*model.KnowledgeBase, len(kbs))
+	for _, kb := range kbs {
+		byName[kb.Name] = kb
+	}
+
+	scope := &KnowledgeScope{Names: map[int64]string{}}
+	add := func(kb *model.KnowledgeBase) {
+		if !slices.Contains(scope.IDs, kb.ID) {
+			scope.IDs = append(scope.IDs, kb.ID)
+			scope.Names[kb.ID] = kb.Name
+		}
+	}
+
+	if len(names) == 0 {
+		scope.IDs = append(scope.IDs, 0)
+		for _, kb := range kbs {
+			if allowed(kb) {
+				add(kb)
+			}
+		}
+		return scope, nil
+	}
+
+	for _, name := range names {
+		if name == PublicKnowledgeBase {
+			if !slices.Contains(scope.IDs, 0) {
+				scope.IDs = append(scope.IDs, 0)
+			}
+			continue
+		}
+		kb, ok := byName[name]
+		if !ok || !allowed(kb) {
+			return nil, fmt.Errorf("知识库 %s 不存在或无权%s", name, action)
+		}
+		add(kb)
+	}
+
+	return scope, nil
+}
+
+// getManagedKnowledgeBase 获取知识库并校验管理权限
+func (d *KnowledgeBaseDomain) getManagedKnowledgeBase(ctx context.Context, p *Principal, name string) (*model.KnowledgeBase, error) {
+	kb, err := d.KnowledgeBaseRepo.GetKnowledgeBaseByName(ctx, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("知识库 %s 不存在", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取知识库失败: %w", err)
+	}
+	if !p.canManage(kb) {
+		if p.canRead(kb) {
+			return nil, fmt.Errorf("无权管理知识库 %s", name)
+		}
+		return nil, fmt.Errorf("知识库 %s 不存在", name)
+	}
+
+	return kb, nil
+}
+
+func (d *KnowledgeBaseDomain) BuildKnowledgeBaseRespModel(p *Principal, kb *model.KnowledgeBase) *types.KnowledgeBase {
+	return &types.KnowledgeBase{
+		Name:        kb.Name,
+		Description: kb.Description,
+		OwnerTeam:   kb.OwnerTeam,
+		TreeNodeId:  kb.TreeNodeID,
+		Public:      kb.Public,
+		Readers:     decodeMembers(kb.Readers),
+		Writers:     decodeMembers(kb.Writers),
+		CreatorId:   kb.CreatorID,
+		Writable:    p.canWrite(kb),
+		CreateTime:  kb.CreatedAt,
+		UpdateTime:  kb.UpdatedAt,
+	}
+}
+
+// canManage 管理员、创建人和所属团队成员可以修改知识库设置
+func (p *Principal) canManage(kb *model.KnowledgeBase) bool {
+	return p.Admin || kb.CreatorID == p.UID || (kb.OwnerTeam != "" && slices.Contains(p.Roles, kb.OwnerTeam))
+}
+
+func (p *Principal) canWrite(kb *model.KnowledgeBase) bool {
+	return p.canManage(kb) || p.isMember(decodeMembers(kb.Writers))
+}
+
+func (p *Principal) canRead(kb *model.KnowledgeBase) bool {
+	return kb.Public || p.canWrite(kb) || p.isMember(decodeMembers(kb.Readers))
+}
+
+func (p *Principal) isMember(members []string) bool {
+	for _, m := range members {
+		if id, ok := strings.CutPrefix(m, memberUserPrefix); ok && id == strconv.FormatInt(p.UID, 10) {
+			return true
+		}
+		if role, ok := strings.CutPrefix(m, memberRolePrefix); ok && slices.Contains(p.Roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateKnowledgeBaseName(name string) error {
+	if !knowledgeBaseNamePattern.MatchString(name) {
+		return fmt.Errorf("知识库名称只能包含字母、数字、下划线、点和中划线，且不超过 64 个字符")
+	}
+	if name == PublicKnowledgeBase {
+		return fmt.Errorf("知识库名称 %s 已被公共知识库占用", name)
+	}
+	return nil
+}
+
+// checkOwnerTeam 只能把知识库归属到自己所在的团队，管理员不受限制
+func checkOwnerTeam(p *Principal, team string) error {
+	if team == "" || p.Admin || slices.Contains(p.Roles, team) {
+		return nil
+	}
+	return fmt.Errorf("不属于团队 %s，不能将知识库归属到该团队", team)
+}
+
+func setMembers(kb *model.KnowledgeBase, readers, writers []string) error {
+	var err error
+	if kb.Readers, err = encodeMembers(readers); err != nil {
+		return err
+	}
+	if kb.Writers, err = encodeMembers(writers); err != nil {
+		return err
+	}
+	return nil
+}
+
+// encodeMembers 校验成员格式并去重后序列化
+func encodeMembers(members []string) (string, error) {
+	normalized := make([]string, 0, len(members))
+	for _, m := range members {
+		m = strings.TrimSpace(m)
+		if id, ok := strings.CutPrefix(m, memberUserPrefix); ok {
+			if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+				return "", fmt.Errorf("成员 %s 的用户ID不合法", m)
+			}
+		} else if role, ok := strings.CutPrefix(m, memberRolePrefix); !ok || role == "" {
+			return "", fmt.Errorf("成员 %s 格式不合法，应为 user:<用户ID> 或 role:<角色名>", m)
+		}
+		if !slices.Contains(normalized, m) {
+			normalized = append(normalized, m)
+		}
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return "", fmt.Errorf("序列化成员失败: %w", err)
+	}
+	return string(data), nil
+}
+
+func decodeMembers(data string) []string {
+	var members []string
+	if data == "" {
+		return members
+	}
+	_ = json.Unmarshal([]byte(data), &members)
+	return members
+}
@@ -0,0 +1,341 @@
+package domain
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"gorm.io/gorm"
+
+	"github.com/tmc/langchaingo/schema"
+)
+
+// fakeKnowledgeBaseRepo 在内存中保存知识库
+type fakeKnowledgeBaseRepo struct {
+	kbs []*model.KnowledgeBase
+}
+
+func (f *fakeKnowledgeBaseRepo) CreateKnowledgeBase(ctx context.Context, kb *model.KnowledgeBase) error {
+	kb.ID = int64(len(f.kbs) + 1)
+	f.kbs = append(f.kbs, kb)
+	return nil
+}
+
+func (f *fakeKnowledgeBaseRepo) GetKnowledgeBaseByName(ctx context.Context, name string) (*model.KnowledgeBase, error) {
+	for _, kb := range f.kbs {
+		if kb.Name == name && kb.DeletedAt == 0 {
+			return kb, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (f *fakeKnowledgeBaseRepo) GetKnowledgeBaseByID(ctx context.Context, id int64) (*model.KnowledgeBase, error) {
+	for _, kb := range f.kbs {
+		if kb.ID == id && kb.DeletedAt == 0 {
+			return kb, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (f *fakeKnowledgeBaseRepo) ListKnowledgeBases(ctx context.Context, treeNodeID int64) ([]*model.KnowledgeBase, error) {
+	var res []*model.KnowledgeBase
+	for _, kb := range f.kbs {
+		if kb.DeletedAt == 0 && (treeNodeID == 0 || kb.TreeNodeID == treeNodeID) {
+			res = append(res, kb)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeKnowledgeBaseRepo) UpdateKnowledgeBase(ctx context.Context, kb *model.KnowledgeBase) error {
+	return nil
+}
+
+func (f *fakeKnowledgeBaseRepo) DeleteKnowledgeBase(ctx context.Context, id int64) error {
+	for _, kb := range f.kbs {
+		if kb.ID == id {
+			kb.DeletedAt = 1
+		}
+	}
+	return nil
+}
+
+// fakeDocumentCounter 只实现知识库删除时用到的文档统计
+type fakeDocumentCounter struct {
+	repo.DocumentRepo
+	counts map[int64]int64
+}
+
+func (f *fakeDocumentCounter) CountDocuments(ctx context.Context, kbID int64) (int64, error) {
+	return f.counts[kbID], nil
+}
+
+func newTestKnowledgeBaseDomain() *KnowledgeBaseDomain {
+	return &KnowledgeBaseDomain{
+		KnowledgeBaseRepo: &fakeKnowledgeBaseRepo{},
+		DocumentRepo:      &fakeDocumentCounter{counts: map[int64]int64{}},
+		admins:            []int64{1},
+	}
+}
+
+func TestKnowledgeBaseAccess(t *testing.T) {
+	ctx := context.Background()
+	d := newTestKnowledgeBaseDomain()
+
+	sre := &Principal{UID: 10, Roles: []string{"sre"}}
+	dba := &Principal{UID: 20, Roles: []string{"dba"}}
+	dev := &Principal{UID: 30, Roles: []string{"dev"}}
+	admin := &Principal{UID: 1, Admin: true}
+
+	if _, err := d.CreateKnowledgeBase(ctx, dev, &model.KnowledgeBase{Name: "sre-runbooks", OwnerTeam: "sre"}, nil, nil); err == nil {
+		t.Fatal("non-member should not create a knowledge base for the team")
+	}
+	if _, err := d.CreateKnowledgeBase(ctx, sre, &model.KnowledgeBase{Name: PublicKnowledgeBase}, nil, nil); err == nil {
+		t.Fatal("public is reserved")
+	}
+	if _, err := d.CreateKnowledgeBase(ctx, sre, &model.KnowledgeBase{Name: "x"}, []string{"group:dev"}, nil); err == nil {
+		t.Fatal("invalid member should be rejected")
+	}
+
+	if _, err := d.CreateKnowledgeBase(ctx, sre, &model.KnowledgeBase{Name: "sre-runbooks", OwnerTeam: "sre"},
+		[]string{"role:dev", "role:dev"}, []string{"user:20"}); err != nil {
+		t.Fatalf("CreateKnowledgeBase: %v", err)
+	}
+	if _, err := d.CreateKnowledgeBase(ctx, dba, &model.KnowledgeBase{Name: "dba-secrets", OwnerTeam: "dba"}, nil, nil); err != nil {
+		t.Fatalf("CreateKnowledgeBase: %v", err)
+	}
+	if _, err := d.CreateKnowledgeBase(ctx, admin, &model.KnowledgeBase{Name: "faq", Public: true}, nil, nil); err != nil {
+		t.Fatalf("CreateKnowledgeBase: %v", err)
+	}
+
+	names := func(kbs []*types.KnowledgeBase) []string {
+		var res []string
+		for _, kb := range kbs {
+			res = append(res, kb.Name)
+		}
+		return res
+	}
+	for _, tc := range []struct {
+		p    *Principal
+		want []string
+	}{
+		{sre, []string{"sre-runbooks", "faq"}},
+		{dba, []string{"sre-runbooks", "dba-secrets", "faq"}},
+		{dev, []string{"sre-runbooks", "faq"}},
+		{&Principal{UID: 40}, []string{"faq"}},
+		{admin, []string{"sre-runbooks", "dba-secrets", "faq"}},
+	} {
+		kbs, err := d.ListKnowledgeBases(ctx, tc.p, 0)
+		if err != nil {
+			t.Fatalf("ListKnowledgeBases: %v", err)
+		}
+		if got := names(kbs); !slices.Equal(got, tc.want) {
+			t.Errorf("uid %d: readable = %v, want %v", tc.p.UID, got, tc.want)
+		}
+	}
+
+	// 检索范围包含公共知识库；指定无权读取的知识库时报错
+	scope, err := d.ReadableScope(ctx, dev, nil)
+	if err != nil || !slices.Equal(scope.IDs, []int64{0, 1, 3}) {
+		t.Errorf("dev scope = %+v, err = %v", scope, err)
+	}
+	scope, err = d.ReadableScope(ctx, dev, []string{"sre-runbooks"})
+	if err != nil || !slices.Equal(scope.IDs, []int64{1}) || scope.Name(1) != "sre-runbooks" {
+		t.Errorf("dev scope = %+v, err = %v", scope, err)
+	}
+	if _, err := d.ReadableScope(ctx, dev, []string{"dba-secrets"}); err == nil {
+		t.Error("dev should not read dba-secrets")
+	}
+
+	// 可读不代表可写：dev 只能读 sre-runbooks，dba 通过 user 成员可写
+	if _, err := d.WritableKnowledgeBase(ctx, dev, "sre-runbooks"); err == nil {
+		t.Error("dev should not write sre-runbooks")
+	}
+	if id, err := d.WritableKnowledgeBase(ctx, dba, "sre-runbooks"); err != nil || id != 1 {
+		t.Errorf("dba write sre-runbooks = %d, %v", id, err)
+	}
+	if id, err := d.WritableKnowledgeBase(ctx, dev, ""); err != nil || id != 0 {
+		t.Errorf("public write = %d, %v", id, err)
+	}
+
+	// 只有管理员、创建人和所属团队可以修改设置；有文档时不能删除
+	if _, err := d.UpdateKnowledgeBase(ctx, dba, &model.KnowledgeBase{Name: "sre-runbooks", OwnerTeam: "sre"}, nil, nil); err == nil {
+		t.Error("writer should not manage the knowledge base")
+	}
+	if _, err := d.UpdateKnowledgeBase(ctx, sre, &model.KnowledgeBase{Name: "dba-secrets"}, nil, nil); err == nil || !strings.Contains(err.Error(), "不存在") {
+		t.Errorf("unreadable knowledge base should look missing, err = %v", err)
+	}
+	d.DocumentRepo.(*fakeDocumentCounter).counts[1] = 2
+	if err := d.DeleteKnowledgeBase(ctx, sre, "sre-runbooks"); err == nil {
+		t.Error("knowledge base with documents should not be deleted")
+	}
+	if _, err := d.UpdateKnowledgeBase(ctx, sre, &model.KnowledgeBase{Name: "sre-runbooks", OwnerTeam: "sre"}, nil, nil); err != nil {
+		t.Fatalf("UpdateKnowledgeBase: %v", err)
+	}
+	if _, err := d.ReadableScope(ctx, dev, []string{"sre-runbooks"}); err == nil {
+		t.Error("dev lost read access after readers were cleared")
+	}
+}
+
+func TestRetrieveScopedDocs(t *testing.T) {
+	ctx := context.Background()
+	store := dao.NewMemoryVectorStore(keywordEmbedder{"nginx"})
+	d := &AIHelperDomain{Qdrant: dao.NewQdrantDAO(store, store)}
+
+	// 引入知识库之前写入的分块没有 kb_id，归入公共知识库
+	if _, err := store.AddDocuments(ctx, []schema.Document{{PageContent: "legacy nginx", Metadata: map[string]any{"doc_id": "legacy"}}}); err != nil {
+		t.Fatal(err)
+	}
+	for id, doc := range map[int64]string{0: "public", 1: "sre", 2: "dba"} {
+		docs := []schema.Document{{PageContent: doc + " nginx"}}
+		if err := d.Qdrant.StoreDocumentWithMetadata(ctx, docs, dao.DocumentMetadata{DocID: doc, Title: doc, Revision: 1, KnowledgeBaseID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, mode := range []string{dao.SearchModeVector, dao.SearchModeKeyword} {
+		req := &types.AskQuestionRequest{Question: "nginx", TopK: 10, RetrievalMode: mode}
+		docs, err := d.RetrieveRelevantDocs(ctx, req, &KnowledgeScope{IDs: []int64{0, 1}})
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		got := rankedDocKeys(docs)
+		slices.Sort(got)
+		if want := []string{"legacy", "public", "sre"}; !slices.Equal(got, want) {
+			t.Errorf("%s: retrieved = %v, want %v", mode, got, want)
+		}
+
+		docs, err = d.RetrieveRelevantDocs(ctx, req, &KnowledgeScope{IDs: []int64{2}})
+		if got := rankedDocKeys(docs); err != nil || !slices.Equal(got, []string{"dba"}) {
+			t.Errorf("%s: retrieved = %v, err = %v", mode, got, err)
+		}
+	}
+}
+
+// fakeDocumentRepo 在内存中保存文档，updateErr 非 nil 时更新失败
+type fakeDocumentRepo struct {
+	repo.DocumentRepo
+	docs      map[string]*model.Document
+	updateErr error
+}
+
+func (f *fakeDocumentRepo) GetDocumentByDocID(ctx context.Context, docID string) (*model.Document, error) {
+	doc, ok := f.docs[docID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	clone := *doc
+	return &clone, nil
+}
+
+func (f *fakeDocumentRepo) GetDocumentByHash(ctx context.Context, kbID int64, hash string) (*model.Document, error) {
+	for _, doc := range f.docs {
+		if doc.KnowledgeBaseID == kbID && doc.ContentHash == hash {
+			return doc, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeDocumentRepo) UpdateDocument(ctx context.Context, doc *model.Document) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	f.docs[doc.DocID] = doc
+	return nil
+}
+
+func (f *fakeDocumentRepo) DeleteDocument(ctx context.Context, docID string) error {
+	delete(f.docs, docID)
+	return nil
+}
+
+func TestPublicDocumentWriteAccess(t *testing.T) {
+	ctx := context.Background()
+	kb := newTestKnowledgeBaseDomain()
+	store := dao.NewMemoryVectorStore(keywordEmbedder{"nginx"})
+	docs := &fakeDocumentRepo{docs: map[string]*model.Document{
+		"a": {DocID: "a", OwnerID: 10, KnowledgeBaseID: 0},
+	}}
+	d := &AIHelperDomain{DocumentRepo: docs, Qdrant: dao.NewQdrantDAO(store, store)}
+
+	alice := &Principal{UID: 10}
+	bob := &Principal{UID: 20}
+	admin := &Principal{UID: 1, Admin: true}
+	writable := func(p *Principal) *KnowledgeScope {
+		scope, err := kb.WritableScope(ctx, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return scope
+	}
+
+	// 其他用户可以读取但不能修改公共文档
+	readable, err := kb.ReadableScope(ctx, bob, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.GetDocument(ctx, readable, "a"); err != nil {
+		t.Errorf("bob read public doc: %v", err)
+	}
+	if err := d.DeleteDocument(ctx, writable(bob), "a"); err == nil {
+		t.Error("bob deleted alice's public doc")
+	}
+	if _, err := d.UpdateDocument(ctx, writable(bob), "a", "renamed", "", ""); err == nil {
+		t.Error("bob updated alice's public doc")
+	}
+	if _, ok := docs.docs["a"]; !ok {
+		t.Fatal("public doc removed by bob")
+	}
+
+	if err := d.DeleteDocument(ctx, writable(alice), "a"); err != nil {
+		t.Errorf("alice delete own public doc: %v", err)
+	}
+	docs.docs["b"] = &model.Document{DocID: "b", OwnerID: 10}
+	if err := d.DeleteDocument(ctx, writable(admin), "b"); err != nil {
+		t.Errorf("admin delete public doc: %v", err)
+	}
+	if len(docs.docs) != 0 {
+		t.Errorf("docs left = %v", docs.docs)
+	}
+}
+
+func TestUpdateDocumentRollback(t *testing.T) {
+	ctx := context.Background()
+	store := dao.NewMemoryVectorStore(keywordEmbedder{"nginx"})
+	docs := &fakeDocumentRepo{
+		docs: map[string]*model.Document{
+			"a": {DocID: "a", Title: "a.txt", OwnerID: 10, Format: dao.FormatText, Content: base64.StdEncoding.EncodeToString([]byte("old nginx"))},
+		},
+		updateErr: gorm.ErrDuplicatedKey,
+	}
+	d := &AIHelperDomain{DocumentRepo: docs, Qdrant: dao.NewQdrantDAO(store, store)}
+	if err := d.Qdrant.StoreDocumentWithMetadata(ctx, []schema.Document{{PageContent: "old nginx"}},
+		dao.DocumentMetadata{DocID: "a", Title: "a.txt", Revision: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	scope := &KnowledgeScope{IDs: []int64{0}}
+	content := base64.StdEncoding.EncodeToString([]byte("new nginx"))
+	if _, err := d.UpdateDocument(ctx, scope, "a", "", "", content); err == nil {
+		t.Fatal("expected update to fail")
+	}
+
+	// 元数据更新失败时只保留旧版本的向量
+	points, err := store.ScrollPoints(ctx, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) != 1 || fmt.Sprint(points[0].Payload["revision"]) != "1" {
+		t.Errorf("points = %+v, want only the old revision", points)
+	}
+}
@@ -0,0 +1,328 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+const (
+	// defaultWindowTokens 单个分析窗口默认的 token 上限
+	defaultWindowTokens = 2048
+	// maxAnalyzeWindows 单次分析最多调用 LLM 的窗口数，超出部分按优先级丢弃
+	maxAnalyzeWindows = 8
+	// maxTemplateSampleRunes 单条样例日志保留的最大字符数
+	maxTemplateSampleRunes = 512
+)
+
+const logAnalysisSystemPrompt = `你是一名资深 SRE，负责根据日志诊断线上故障。
+日志已按模板聚类，每行格式为 "[出现次数] 日志模板"，其中 <TIME> <IP> <UUID> <HEX> <NUM> 为被替换掉的变量。
+请只输出一个 JSON 对象，不要输出任何其他内容，格式如下:
+{"root_cause": "可能的根因", "affected_components": ["受影响的组件"], "severity": "critical|high|medium|low", "remediation_steps": ["修复步骤"]}`
+
+const logMergeSystemPrompt = `你是一名资深 SRE。下面是同一批日志分窗口诊断得到的多个 JSON 结果，请综合它们给出最终结论。
+请只输出一个 JSON 对象，不要输出任何其他内容，格式如下:
+{"root_cause": "可能的根因", "affected_components": ["受影响的组件"], "severity": "critical|high|medium|low", "remediation_steps": ["修复步骤"]}`
+
+var (
+	logTimeRegexp  = regexp.MustCompile(`\d{4}[-/]\d{2}[-/]\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?`)
+	logUUIDRegexp  = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	logIPRegexp    = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}(?::\d+)?\b`)
+	logHexRegexp   = regexp.MustCompile(`(?i)\b(?:0x[0-9a-f]+|[0-9a-f]{12,})\b`)
+	logNumRegexp   = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	logLevelRegexp = regexp.MustCompile(`(?i)\b(fatal|panic|critical|error|err|exception|warn|warning)\b`)
+)
+
+// severityRank 严重程度排序，数值越大越严重
+var severityRank = map[string]int{
+	"low":      1,
+	"medium":   2,
+	"high":     3,
+	"critical": 4,
+}
+
+// LogTemplate 聚类后的日志模板
+type LogTemplate struct {
+	Template string
+	Count    int
+	Sample   string
+	priority int
+	first    int
+}
+
+// LogAnalysisResult 日志分析结果
+type LogAnalysisResult struct {
+	RootCause          string   `json:"root_cause"`
+	AffectedComponents []string `json:"affected_components"`
+	Severity           string   `json:"severity"`
+	RemediationSteps   []string `json:"remediation_steps"`
+
+	Templates []*LogTemplate `json:"-"`
+	Windows   int            `json:"-"`
+}
+
+type LogAnalysisDomain struct {
+	llm llms.Model
+}
+
+func NewLogAnalysisDomain(llm llms.Model) *LogAnalysisDomain {
+	return &LogAnalysisDomain{
+		llm: llm,
+	}
+}
+
+// AnalyzeLogs 聚类日志、按 token 切分窗口，逐窗口按提示词模板调用 LLM 诊断后合并结论
+func (d *LogAnalysisDomain) AnalyzeLogs(ctx context.Context, logData string, windowTokens int, tpl *model.PromptTemplate) (*LogAnalysisResult, error) {
+	if windowTokens <= 0 {
+		windowTokens = defaultWindowTokens
+	}
+
+	// 1. 日志聚类
+	templates := ClusterLogTemplates(logData)
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("日志内容为空")
+	}
+
+	// 2. 切分窗口
+	windows := SplitLogWindows(templates, windowTokens)
+	if len(windows) > maxAnalyzeWindows {
+		windows = windows[:maxAnalyzeWindows]
+	}
+
+	// 3. 逐窗口分析
+	partials := make([]*LogAnalysisResult, 0, len(windows))
+	for i, window := range windows {
+		prompt, err := RenderPrompt(tpl, PromptData{Logs: window, Now: time.Now()})
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := d.generateResult(ctx, prompt.System, prompt.Context)
+		if err != nil {
+			return nil, fmt.Errorf("分析第 %d 个日志窗口失败: %w", i+1, err)
+		}
+		partials = append(partials, res)
+	}
+
+	// 4. 合并结论
+	result, err := d.mergeResults(ctx, partials)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Templates = templates
+	result.Windows = len(windows)
+
+	return result, nil
+}
+
+func (d *LogAnalysisDomain) mergeResults(ctx context.Context, partials []*LogAnalysisResult) (*LogAnalysisResult, error) {
+	if len(partials) == 1 {
+		return partials[0], nil
+	}
+
+	payload, err := json.Marshal(partials)
+	if err != nil {
+		return nil, fmt.Errorf("序列化分窗口结果失败: %w", err)
+	}
+
+	// LLM 合并失败时退化为本地合并，避免整次分析作废
+	if res, err := d.generateResult(ctx, logMergeSystemPrompt, string(payload)); err == nil {
+		return res, nil
+	}
+
+	return mergeResultsLocally(partials), nil
+}
+
+func (d *LogAnalysisDomain) generateResult(ctx context.Context, systemPrompt, content string) (*LogAnalysisResult, error) {
+	resp, err := d.llm.GenerateContent(ctx, []llms.MessageContent{
+		llms.TextParts(llms.ChatMessageTypeSystem, systemPrompt),
+		llms.TextParts(llms.ChatMessageTypeHuman, content),
+	}, llms.WithJSONMode(), llms.WithTemperature(0))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("LLM 返回结果为空")
+	}
+
+	return parseLogAnalysisResult(resp.Choices[0].Content)
+}
+
+// ClusterLogTemplates 将日志按变量脱敏后的模板聚类，错误级别高、出现次数多的模板排在前面
+func ClusterLogTemplates(logData string) []*LogTemplate {
+	index := make(map[string]*LogTemplate)
+	templates := make([]*LogTemplate, 0)
+
+	for i, line := range strings.Split(logData, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		tpl := normalizeLogLine(line)
+		if t, ok := index[tpl]; ok {
+			t.Count++
+			continue
+		}
+
+		t := &LogTemplate{
+			Template: tpl,
+			Count:    1,
+			Sample:   truncateRunes(line, maxTemplateSampleRunes),
+			priority: logLinePriority(line),
+			first:    i,
+		}
+		index[tpl] = t
+		templates = append(templates, t)
+	}
+
+	sort.SliceStable(templates, func(i, j int) bool {
+		if templates[i].priority != templates[j].priority {
+			return templates[i].priority > templates[j].priority
+		}
+		if templates[i].Count != templates[j].Count {
+			return templates[i].Count > templates[j].Count
+		}
+		return templates[i].first < templates[j].first
+	})
+
+	return templates
+}
+
+// SplitLogWindows 按 token 上限将模板切分为多个窗口，单个模板超出上限时截断
+func SplitLogWindows(templates []*LogTemplate, windowTokens int) []string {
+	var (
+		windows []string
+		builder strings.Builder
+		used    int
+	)
+
+	for _, t := range templates {
+		line := fmt.Sprintf("[%d] %s\n", t.Count, t.Template)
+		tokens := estimateTokens(line)
+		if tokens > windowTokens {
+			line = truncateRunes(line, windowTokens*tokenApproximation-1) + "\n"
+			tokens = windowTokens
+		}
+
+		if used+tokens > windowTokens && builder.Len() > 0 {
+			windows = append(windows, builder.String())
+			builder.Reset()
+			used = 0
+		}
+
+		builder.WriteString(line)
+		used += tokens
+	}
+
+	if builder.Len() > 0 {
+		windows = append(windows, builder.String())
+	}
+
+	return windows
+}
+
+func normalizeLogLine(line string) string {
+	line = logTimeRegexp.ReplaceAllString(line, "<TIME>")
+	line = logUUIDRegexp.ReplaceAllString(line, "<UUID>")
+	line = logIPRegexp.ReplaceAllString(line, "<IP>")
+	line = logHexRegexp.ReplaceAllString(line, "<HEX>")
+	line = logNumRegexp.ReplaceAllString(line, "<NUM>")
+	return line
+}
+
+func logLinePriority(line string) int {
+	match := logLevelRegexp.FindString(line)
+	switch strings.ToLower(match) {
+	case "fatal", "panic", "critical":
+		return 3
+	case "error", "err", "exception":
+		return 2
+	case "warn", "warning":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// tokenApproximation 与 langchaingo 的近似算法保持一致，按 4 个字符折算 1 个 token，避免依赖在线分词表
+const tokenApproximation = 4
+
+func estimateTokens(text string) int {
+	return (len([]rune(text)) + tokenApproximation - 1) / tokenApproximation
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if n <= 0 || len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
+
+// parseLogAnalysisResult 从 LLM 输出中提取 JSON 结果，兼容 ```json 代码块包裹的情况
+func parseLogAnalysisResult(content string) (*LogAnalysisResult, error) {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end <= start {
+		return nil, fmt.Errorf("LLM 返回结果不是合法的 JSON: %s", content)
+	}
+
+	var res LogAnalysisResult
+	if err := json.Unmarshal([]byte(content[start:end+1]), &res); err != nil {
+		return nil, fmt.Errorf("解析 LLM 返回结果失败: %w", err)
+	}
+
+	res.Severity = normalizeSeverity(res.Severity)
+
+	return &res, nil
+}
+
+func normalizeSeverity(severity string) string {
+	severity = strings.ToLower(strings.TrimSpace(severity))
+	if _, ok := severityRank[severity]; ok {
+		return severity
+	}
+	return "medium"
+}
+
+func mergeResultsLocally(partials []*LogAnalysisResult) *LogAnalysisResult {
+	merged := &LogAnalysisResult{Severity: "low"}
+	causes := make([]string, 0, len(partials))
+	components := make(map[string]struct{})
+	steps := make(map[string]struct{})
+
+	for _, p := range partials {
+		if p.RootCause != "" {
+			causes = append(causes, p.RootCause)
+		}
+		if severityRank[p.Severity] > severityRank[merged.Severity] {
+			merged.Severity = p.Severity
+		}
+		for _, c := range p.AffectedComponents {
+			if _, ok := components[c]; !ok {
+				components[c] = struct{}{}
+				merged.AffectedComponents = append(merged.AffectedComponents, c)
+			}
+		}
+		for _, s := range p.RemediationSteps {
+			if _, ok := steps[s]; !ok {
+				steps[s] = struct{}{}
+				merged.RemediationSteps = append(merged.RemediationSteps, s)
+			}
+		}
+	}
+
+	merged.RootCause = strings.Join(causes, "; ")
+
+	return merged
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// fakeLLM 按顺序返回预设回答，并记录每次收到的消息
+type fakeLLM struct {
+	replies []string
+	calls   [][]llms.MessageContent
+}
+
+func (f *fakeLLM) GenerateContent(ctx context.Context, messages []llms.MessageContent, options ...llms.CallOption) (*llms.ContentResponse, error) {
+	f.calls = append(f.calls, messages)
+	if len(f.replies) == 0 {
+		return nil, fmt.Errorf("no reply")
+	}
+	reply := f.replies[0]
+	f.replies = f.replies[1:]
+	return &llms.ContentResponse{Choices: []*llms.ContentChoice{{Content: reply}}}, nil
+}
+
+func (f *fakeLLM) Call(ctx context.Context, prompt string, options ...llms.CallOption) (string, error) {
+	return llms.GenerateFromSinglePrompt(ctx, f, prompt, options...)
+}
+
+func TestClusterLogTemplates(t *testing.T) {
+	logs := strings.Join([]string{
+		"2024-12-01 10:00:01 INFO request 1001 from 10.0.0.1:8080 ok",
+		"2024-12-01 10:00:02 INFO request 1002 from 10.0.0.2:8080 ok",
+		"2024-12-01 10:00:03 ERROR payment-svc connect to 10.0.0.9:3306 timeout after 30s",
+		"2024-12-01 10:00:04 INFO request 1003 from 10.0.0.3:8080 ok",
+	}, "\n")
+
+	templates := ClusterLogTemplates(logs)
+	if len(templates) != 2 {
+		t.Fatalf("expected 2 templates, got %d", len(templates))
+	}
+	if !strings.Contains(templates[0].Template, "ERROR") {
+		t.Errorf("expected error template first, got %q", templates[0].Template)
+	}
+	if templates[1].Count != 3 {
+		t.Errorf("expected info template count 3, got %d", templates[1].Count)
+	}
+	if strings.Contains(templates[1].Template, "10.0.0.1") {
+		t.Errorf("expected ip to be masked, got %q", templates[1].Template)
+	}
+}
+
+func TestSplitLogWindows(t *testing.T) {
+	templates := make([]*LogTemplate, 0, 10)
+	for i := 0; i < 10; i++ {
+		templates = append(templates, &LogTemplate{Template: strings.Repeat(fmt.Sprintf("line-%c ", 'a'+i), 10), Count: 1})
+	}
+
+	windows := SplitLogWindows(templates, 50)
+	if len(windows) < 2 {
+		t.Fatalf("expected multiple windows, got %d", len(windows))
+	}
+	for _, w := range windows {
+		if estimateTokens(w) > 50 {
+			t.Errorf("window exceeds token budget: %d", estimateTokens(w))
+		}
+	}
+}
+
+func TestLogAnalysisDomain_AnalyzeLogs(t *testing.T) {
+	llm := &fakeLLM{replies: []string{
+		"```json\n{\"root_cause\": \"数据库连接超时\", \"affected_components\": [\"payment-svc\"], \"severity\": \"HIGH\", \"remediation_steps\": [\"检查 MySQL 连接池\"]}\n```",
+	}}
+	d := NewLogAnalysisDomain(llm)
+
+	res, err := d.AnalyzeLogs(context.Background(), "ERROR payment-svc connect to 10.0.0.9:3306 timeout", 0, builtinPrompts[PromptFeatureLogAnalysis])
+	if err != nil {
+		t.Fatalf("AnalyzeLogs failed: %v", err)
+	}
+	if res.RootCause != "数据库连接超时" || res.Severity != "high" || res.Windows != 1 {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	if len(llm.calls) != 1 {
+		t.Errorf("expected 1 llm call, got %d", len(llm.calls))
+	}
+}
+
+func TestLogAnalysisDomain_AnalyzeLogsMergeFallback(t *testing.T) {
+	llm := &fakeLLM{replies: []string{
+		`{"root_cause": "磁盘写满", "affected_components": ["node-1"], "severity": "critical", "remediation_steps": ["清理磁盘"]}`,
+		`{"root_cause": "日志轮转失败", "affected_components": ["node-1", "logrotate"], "severity": "medium", "remediation_steps": ["修复 logrotate"]}`,
+		"not json",
+	}}
+	d := NewLogAnalysisDomain(llm)
+
+	logs := strings.Repeat("disk full ", 20) + "\n" + strings.Repeat("logrotate failed ", 12)
+	res, err := d.AnalyzeLogs(context.Background(), logs, 60, builtinPrompts[PromptFeatureLogAnalysis])
+	if err != nil {
+		t.Fatalf("AnalyzeLogs failed: %v", err)
+	}
+	if res.Windows != 2 {
+		t.Fatalf("expected 2 windows, got %d", res.Windows)
+	}
+	if res.Severity != "critical" {
+		t.Errorf("expected merged severity critical, got %s", res.Severity)
+	}
+	if len(res.AffectedComponents) != 2 || len(res.RemediationSteps) != 2 {
+		t.Errorf("unexpected merged result: %+v", res)
+	}
+}
@@ -0,0 +1,366 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"gorm.io/gorm"
+
+	"github.com/tmc/langchaingo/schema"
+)
+
+// 提示词模板适用的功能
+const (
+	PromptFeatureChat        = "chat"
+	PromptFeatureLogAnalysis = "log_analysis"
+	PromptFeatureAlertRule   = "alert_rule"
+	PromptFeatureAutoFix     = "auto_fix"
+)
+
+var promptFeatures = []string{PromptFeatureChat, PromptFeatureLogAnalysis, PromptFeatureAlertRule, PromptFeatureAutoFix}
+
+var promptNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+
+const defaultChatSystemPrompt = `请你仔细思考，读取上下文内容给出高质量的回答`
+
+const defaultChatContextTemplate = `{{- if .Docs}}参考文档：
+{{range .Docs}}
+【{{.Title}}{{if .Section}} / {{.Section}}{{end}}】
+{{.Content}}
+{{end}}{{end}}
+{{- if .History}}
+历史对话：
+{{.History}}
+{{end}}`
+
+const defaultLogContextTemplate = `{{.Logs}}`
+
+const defaultAlertRuleContextTemplate = `告警需求：{{.Question}}`
+
+const defaultAutoFixContextTemplate = `修复任务：{{.Task}}
+目标 Pod：{{.PodID}}
+计划变更：
+{{.Diff}}`
+
+// builtinPrompts 未指定模板名称时使用的内置模板
+var builtinPrompts = map[string]*model.PromptTemplate{
+	PromptFeatureChat: {
+		Name:            "builtin-chat",
+		Feature:         PromptFeatureChat,
+		SystemPrompt:    defaultChatSystemPrompt,
+		ContextTemplate: defaultChatContextTemplate,
+	},
+	PromptFeatureLogAnalysis: {
+		Name:            "builtin-log-analysis",
+		Feature:         PromptFeatureLogAnalysis,
+		SystemPrompt:    logAnalysisSystemPrompt,
+		ContextTemplate: defaultLogContextTemplate,
+	},
+	PromptFeatureAlertRule: {
+		Name:            "builtin-alert-rule",
+		Feature:         PromptFeatureAlertRule,
+		SystemPrompt:    alertRuleSystemPrompt,
+		ContextTemplate: defaultAlertRuleContextTemplate,
+	},
+	PromptFeatureAutoFix: {
+		Name:            "builtin-auto-fix",
+		Feature:         PromptFeatureAutoFix,
+		SystemPrompt:    autoFixSystemPrompt,
+		ContextTemplate: defaultAutoFixContextTemplate,
+	},
+}
+
+// PromptDoc 模板中可用的检索文档
+type PromptDoc struct {
+	Title   string
+	Section string
+	Content string
+	Score   float32
+}
+
+// PromptData 模板变量，不同功能只填充各自用到的字段
+type PromptData struct {
+	User     int64       // 提问用户ID
+	Question string      // 用户问题或告警需求描述
+	Docs     []PromptDoc // 检索到的文档
+	History  string      // 历史对话
+	Logs     string      // 待分析的日志
+	Task     string      // 自动修复任务类型
+	PodID    string      // 自动修复目标 Pod
+	Diff     string      // 自动修复计划变更
+	Now      time.Time   // 当前时间
+}
+
+// RenderedPrompt 渲染后的提示词
+type RenderedPrompt struct {
+	System  string
+	Context string
+}
+
+// RenderPrompt 渲染系统提示词和上下文模板
+func RenderPrompt(tpl *model.PromptTemplate, data PromptData) (*RenderedPrompt, error) {
+	system, err := renderText(tpl.Name+".system", tpl.SystemPrompt, data)
+	if err != nil {
+		return nil, err
+	}
+	contextText, err := renderText(tpl.Name+".context", tpl.ContextTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RenderedPrompt{System: system, Context: contextText}, nil
+}
+
+func renderText(name, text string, data PromptData) (string, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("解析模板 %s 失败: %w", name, err)
+	}
+
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("渲染模板 %s 失败: %w", name, err)
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// PromptReferences 判断模板是否引用了指定的顶层变量，如 {{.User}}、{{$.Now.Year}}；模板无法解析时按引用处理
+func PromptReferences(tpl *model.PromptTemplate, fields ...string) bool {
+	for _, text := range []string{tpl.SystemPrompt, tpl.ContextTemplate} {
+		t, err := template.New(tpl.Name).Parse(text)
+		if err != nil {
+			return true
+		}
+		for _, associated := range t.Templates() {
+			if associated.Tree != nil && nodeReferences(associated.Tree.Root, fields) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func nodeReferences(node parse.Node, fields []string) bool {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return false
+		}
+		return slices.ContainsFunc(n.Nodes, func(child parse.Node) bool { return nodeReferences(child, fields) })
+	case *parse.ActionNode:
+		return nodeReferences(n.Pipe, fields)
+	case *parse.PipeNode:
+		if n == nil {
+			return false
+		}
+		return slices.ContainsFunc(n.Cmds, func(cmd *parse.CommandNode) bool { return nodeReferences(cmd, fields) })
+	case *parse.CommandNode:
+		return slices.ContainsFunc(n.Args, func(arg parse.Node) bool { return nodeReferences(arg, fields) })
+	case *parse.IfNode:
+		return branchReferences(&n.BranchNode, fields)
+	case *parse.RangeNode:
+		return branchReferences(&n.BranchNode, fields)
+	case *parse.WithNode:
+		return branchReferences(&n.BranchNode, fields)
+	case *parse.TemplateNode:
+		return nodeReferences(n.Pipe, fields)
+	case *parse.ChainNode:
+		return nodeReferences(n.Node, fields)
+	case *parse.FieldNode:
+		// range、with 内的 . 指向其他对象，同名字段也按引用处理
+		return slices.Contains(fields, n.Ident[0])
+	case *parse.VariableNode:
+		return len(n.Ident) > 1 && n.Ident[0] == "$" && slices.Contains(fields, n.Ident[1])
+	}
+	return false
+}
+
+func branchReferences(n *parse.BranchNode, fields []string) bool {
+	return nodeReferences(n.Pipe, fields) || nodeReferences(n.List, fields) || nodeReferences(n.ElseList, fields)
+}
+
+// NewPromptDocs 将检索结果转换为模板变量
+func NewPromptDocs(docs []schema.Document) []PromptDoc {
+	res := make([]PromptDoc, 0, len(docs))
+	for _, doc := range docs {
+		title, _ := doc.Metadata["title"].(string)
+		section, _ := doc.Metadata["section"].(string)
+		res = append(res, PromptDoc{Title: title, Section: section, Content: doc.PageContent, Score: doc.Score})
+	}
+	return res
+}
+
+type PromptDomain struct {
+	PromptTemplateRepo repo.PromptTemplateRepo
+	admins             []int64
+}
+
+func NewPromptDomain(db *gorm.DB, admins []int64) *PromptDomain {
+	return &PromptDomain{
+		PromptTemplateRepo: dao.NewPromptTemplateDAO(db),
+		admins:             admins,
+	}
+}
+
+// CheckSession 从上下文中获取用户ID
+func (d *PromptDomain) CheckSession(ctx context.Context) (int64, error) {
+	uid, _, err := checkSession(ctx)
+	return uid, err
+}
+
+// CheckAdmin 模板会影响所有用户的回答，只允许管理员修改
+func (d *PromptDomain) CheckAdmin(uid int64) error {
+	if !slices.Contains(d.admins, uid) {
+		return fmt.Errorf("只有管理员可以管理提示词模板")
+	}
+	return nil
+}
+
+// Resolve 按名称获取功能对应的模板，名称为空时使用内置模板
+func (d *PromptDomain) Resolve(ctx context.Context, feature, name string) (*model.PromptTemplate, error) {
+	if name == "" {
+		tpl, ok := builtinPrompts[feature]
+		if !ok {
+			return nil, fmt.Errorf("功能 %s 没有内置模板", feature)
+		}
+		return tpl, nil
+	}
+
+	tpl, err := d.PromptTemplateRepo.GetPromptTemplate(ctx, name, 0)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("提示词模板 %s 不存在", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取提示词模板失败: %w", err)
+	}
+	if tpl.Feature != feature {
+		return nil, fmt.Errorf("提示词模板 %s 适用于 %s，不能用于 %s", name, tpl.Feature, feature)
+	}
+
+	return tpl, nil
+}
+
+// CreatePromptTemplate 创建模板的第一个版本
+func (d *PromptDomain) CreatePromptTemplate(ctx context.Context, uid int64, tpl *model.PromptTemplate) (*model.PromptTemplate, error) {
+	if !promptNameRegexp.MatchString(tpl.Name) {
+		return nil, fmt.Errorf("模板名称只能包含字母、数字、下划线、点和短横线，长度不超过 64")
+	}
+	if !slices.Contains(promptFeatures, tpl.Feature) {
+		return nil, fmt.Errorf("不支持的功能: %s", tpl.Feature)
+	}
+
+	if _, err := d.PromptTemplateRepo.GetPromptTemplate(ctx, tpl.Name, 0); err == nil {
+		return nil, fmt.Errorf("提示词模板 %s 已存在", tpl.Name)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("获取提示词模板失败: %w", err)
+	}
+
+	return d.saveVersion(ctx, uid, tpl)
+}
+
+// UpdatePromptTemplate 基于最新版本生成新版本，旧版本保留可查
+func (d *PromptDomain) UpdatePromptTemplate(ctx context.Context, uid int64, name, description, systemPrompt, contextTemplate string) (*model.PromptTemplate, error) {
+	latest, err := d.PromptTemplateRepo.GetPromptTemplate(ctx, name, 0)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("提示词模板 %s 不存在", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取提示词模板失败: %w", err)
+	}
+
+	return d.saveVersion(ctx, uid, &model.PromptTemplate{
+		Name:            name,
+		Feature:         latest.Feature,
+		Description:     description,
+		SystemPrompt:    systemPrompt,
+		ContextTemplate: contextTemplate,
+	})
+}
+
+func (d *PromptDomain) saveVersion(ctx context.Context, uid int64, tpl *model.PromptTemplate) (*model.PromptTemplate, error) {
+	if strings.TrimSpace(tpl.SystemPrompt) == "" {
+		return nil, fmt.Errorf("系统提示词不能为空")
+	}
+	// 用示例数据试渲染，提前发现语法错误和不存在的变量
+	if _, err := RenderPrompt(tpl, samplePromptData()); err != nil {
+		return nil, err
+	}
+
+	version, err := d.PromptTemplateRepo.GetMaxPromptVersion(ctx, tpl.Name)
+	if err != nil {
+		return nil, fmt.Errorf("获取模板版本失败: %w", err)
+	}
+
+	tpl.Version = version + 1
+	tpl.CreatorID = uid
+	if err := d.PromptTemplateRepo.CreatePromptTemplate(ctx, tpl); err != nil {
+		return nil, fmt.Errorf("保存提示词模板失败: %w", err)
+	}
+
+	return tpl, nil
+}
+
+// GetPromptTemplate 获取模板，version 为 0 时返回最新版本
+func (d *PromptDomain) GetPromptTemplate(ctx context.Context, name string, version int) (*model.PromptTemplate, error) {
+	tpl, err := d.PromptTemplateRepo.GetPromptTemplate(ctx, name, version)
+	if err != nil {
+		return nil, fmt.Errorf("获取提示词模板失败: %w", err)
+	}
+	return tpl, nil
+}
+
+// ListPromptTemplates 获取各模板的最新版本
+func (d *PromptDomain) ListPromptTemplates(ctx context.Context, feature string, limit, offset int) ([]*model.PromptTemplate, int64, error) {
+	templates, total, err := d.PromptTemplateRepo.ListPromptTemplates(ctx, feature, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("获取提示词模板列表失败: %w", err)
+	}
+	return templates, total, nil
+}
+
+// DeletePromptTemplate 删除模板的所有版本
+func (d *PromptDomain) DeletePromptTemplate(ctx context.Context, name string) error {
+	if err := d.PromptTemplateRepo.DeletePromptTemplate(ctx, name); err != nil {
+		return fmt.Errorf("删除提示词模板失败: %w", err)
+	}
+	return nil
+}
+
+func (d *PromptDomain) BuildPromptTemplateRespModel(tpl *model.PromptTemplate) *types.PromptTemplate {
+	return &types.PromptTemplate{
+		Name:            tpl.Name,
+		Version:         int32(tpl.Version),
+		Feature:         tpl.Feature,
+		Description:     tpl.Description,
+		SystemPrompt:    tpl.SystemPrompt,
+		ContextTemplate: tpl.ContextTemplate,
+		CreatorId:       tpl.CreatorID,
+		CreateTime:      tpl.CreatedAt,
+	}
+}
+
+func samplePromptData() PromptData {
+	return PromptData{
+		User:     1,
+		Question: "示例问题",
+		Docs:     []PromptDoc{{Title: "示例文档", Section: "示例章节", Content: "示例内容", Score: 1}},
+		History:  "示例历史",
+		Logs:     "示例日志",
+		Task:     "restartCrashLoop",
+		PodID:    "default/example",
+		Diff:     "示例变更",
+		Now:      time.Now(),
+	}
+}
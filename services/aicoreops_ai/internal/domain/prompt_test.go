@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"gorm.io/gorm"
+)
+
+// fakePromptRepo 在内存中保存所有版本
+type fakePromptRepo struct {
+	templates []*model.PromptTemplate
+}
+
+func (f *fakePromptRepo) CreatePromptTemplate(ctx context.Context, tpl *model.PromptTemplate) error {
+	f.templates = append(f.templates, tpl)
+	return nil
+}
+
+func (f *fakePromptRepo) GetPromptTemplate(ctx context.Context, name string, version int) (*model.PromptTemplate, error) {
+	var found *model.PromptTemplate
+	for _, tpl := range f.templates {
+		if tpl.Name != name || tpl.DeletedAt != 0 {
+			continue
+		}
+		if version == tpl.Version || (version == 0 && (found == nil || tpl.Version > found.Version)) {
+			found = tpl
+		}
+	}
+	if found == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return found, nil
+}
+
+func (f *fakePromptRepo) GetMaxPromptVersion(ctx context.Context, name string) (int, error) {
+	version := 0
+	for _, tpl := range f.templates {
+		if tpl.Name == name {
+			version = max(version, tpl.Version)
+		}
+	}
+	return version, nil
+}
+
+func (f *fakePromptRepo) ListPromptTemplates(ctx context.Context, feature string, offset, limit int) ([]*model.PromptTemplate, int64, error) {
+	return nil, 0, nil
+}
+
+func (f *fakePromptRepo) DeletePromptTemplate(ctx context.Context, name string) error {
+	for _, tpl := range f.templates {
+		if tpl.Name == name {
+			tpl.DeletedAt = 1
+		}
+	}
+	return nil
+}
+
+func TestRenderBuiltinChatPrompt(t *testing.T) {
+	tpl := builtinPrompts[PromptFeatureChat]
+
+	empty, err := RenderPrompt(tpl, PromptData{Question: "q"})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if empty.System != defaultChatSystemPrompt || empty.Context != "" {
+		t.Errorf("empty = %+v", empty)
+	}
+
+	full, err := RenderPrompt(tpl, PromptData{
+		Docs:    []PromptDoc{{Title: "OOM 排查", Section: "内存", Content: "调大 limits"}},
+		History: "Human: 你好",
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	for _, want := range []string{"【OOM 排查 / 内存】", "调大 limits", "历史对话：\nHuman: 你好"} {
+		if !strings.Contains(full.Context, want) {
+			t.Errorf("context missing %q:\n%s", want, full.Context)
+		}
+	}
+}
+
+func TestPromptTemplateVersions(t *testing.T) {
+	repo := &fakePromptRepo{}
+	d := &PromptDomain{PromptTemplateRepo: repo, admins: []int64{1}}
+	ctx := context.Background()
+
+	if _, err := d.CreatePromptTemplate(ctx, 1, &model.PromptTemplate{
+		Name: "sre", Feature: PromptFeatureChat, SystemPrompt: "你是 SRE", ContextTemplate: "{{.Docs}}",
+	}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := d.CreatePromptTemplate(ctx, 1, &model.PromptTemplate{
+		Name: "sre", Feature: PromptFeatureChat, SystemPrompt: "x",
+	}); err == nil {
+		t.Error("duplicate name should be rejected")
+	}
+
+	updated, err := d.UpdatePromptTemplate(ctx, 1, "sre", "", "你是资深 SRE，当前用户 {{.User}}", "")
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.Version != 2 || updated.Feature != PromptFeatureChat {
+		t.Errorf("updated = %+v", updated)
+	}
+	if old, err := d.GetPromptTemplate(ctx, "sre", 1); err != nil || old.SystemPrompt != "你是 SRE" {
+		t.Errorf("version 1 = %+v, %v", old, err)
+	}
+
+	resolved, err := d.Resolve(ctx, PromptFeatureChat, "sre")
+	if err != nil || resolved.Version != 2 {
+		t.Fatalf("resolve = %+v, %v", resolved, err)
+	}
+	if _, err := d.Resolve(ctx, PromptFeatureLogAnalysis, "sre"); err == nil {
+		t.Error("feature mismatch should be rejected")
+	}
+	if tpl, err := d.Resolve(ctx, PromptFeatureLogAnalysis, ""); err != nil || tpl != builtinPrompts[PromptFeatureLogAnalysis] {
+		t.Errorf("builtin = %+v, %v", tpl, err)
+	}
+
+	// 删除后重建的版本号不会与旧版本冲突
+	if err := d.DeletePromptTemplate(ctx, "sre"); err != nil {
+		t.Fatal(err)
+	}
+	recreated, err := d.CreatePromptTemplate(ctx, 1, &model.PromptTemplate{Name: "sre", Feature: PromptFeatureChat, SystemPrompt: "x"})
+	if err != nil || recreated.Version != 3 {
+		t.Errorf("recreated = %+v, %v", recreated, err)
+	}
+}
+
+func TestPromptTemplateValidation(t *testing.T) {
+	d := &PromptDomain{PromptTemplateRepo: &fakePromptRepo{}, admins: []int64{1}}
+	ctx := context.Background()
+
+	cases := map[string]*model.PromptTemplate{
+		"unknown variable": {Name: "a", Feature: PromptFeatureChat, SystemPrompt: "{{.Unknown}}"},
+		"bad syntax":       {Name: "b", Feature: PromptFeatureChat, SystemPrompt: "ok", ContextTemplate: "{{if .Docs}}"},
+		"empty system":     {Name: "c", Feature: PromptFeatureChat, SystemPrompt: "  "},
+		"bad name":         {Name: "has space", Feature: PromptFeatureChat, SystemPrompt: "ok"},
+		"bad feature":      {Name: "d", Feature: "unknown", SystemPrompt: "ok"},
+	}
+	for name, tpl := range cases {
+		if _, err := d.CreatePromptTemplate(ctx, 1, tpl); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	if err := d.CheckAdmin(2); err == nil {
+		t.Error("uid 2 is not an admin")
+	}
+	if err := d.CheckAdmin(1); err != nil {
+		t.Errorf("uid 1: %v", err)
+	}
+}
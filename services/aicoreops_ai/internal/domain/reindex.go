@@ -0,0 +1,407 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"gorm.io/gorm"
+)
+
+// 重建索引任务状态
+const (
+	ReindexStatusPending   = "pending"
+	ReindexStatusRunning   = "running"
+	ReindexStatusSucceeded = "succeeded"
+	ReindexStatusFailed    = "failed"
+)
+
+const (
+	defaultReindexBatchSize       = 20
+	defaultReindexRefreshInterval = 30 * time.Second
+	// reindexStaleAfter 运行中的任务超过该时间没有进度，视为所在副本已退出，可由其他副本接管
+	reindexStaleAfter = 10 * time.Minute
+)
+
+type ReindexDomain struct {
+	CollectionRepo repo.VectorCollectionRepo
+	JobRepo        repo.ReindexJobRepo
+	DocumentRepo   repo.DocumentRepo
+	index          *pkg.VectorIndex
+	open           pkg.VectorCollectionOpener
+	redactor       *pkg.Redactor
+	// baseName 配置的集合名称，新集合以它为前缀
+	baseName        string
+	embeddingModel  string
+	admins          []int64
+	autoStart       bool
+	batchSize       int
+	refreshInterval time.Duration
+}
+
+// ReindexOption 重建索引配置选项
+type ReindexOption func(*ReindexDomain)
+
+// WithReindexAutoStart 启动时发现向量模型变更后自动创建重建任务
+func WithReindexAutoStart(auto bool) ReindexOption {
+	return func(d *ReindexDomain) {
+		d.autoStart = auto
+	}
+}
+
+// WithReindexBatchSize 设置每批读取的文档数
+func WithReindexBatchSize(size int) ReindexOption {
+	return func(d *ReindexDomain) {
+		if size > 0 {
+			d.batchSize = size
+		}
+	}
+}
+
+// WithReindexRefreshInterval 设置同步启用集合、接管中断任务的间隔，小于 0 时切换后不等待其他副本
+func WithReindexRefreshInterval(interval time.Duration) ReindexOption {
+	return func(d *ReindexDomain) {
+		if interval != 0 {
+			d.refreshInterval = interval
+		}
+	}
+}
+
+// NewReindexDomain 创建重建索引领域对象，embeddingModel 为当前配置的向量模型
+func NewReindexDomain(db *gorm.DB, index *pkg.VectorIndex, open pkg.VectorCollectionOpener, redactor *pkg.Redactor,
+	baseName, embeddingModel string, admins []int64, opts ...ReindexOption) *ReindexDomain {
+	d := &ReindexDomain{
+		CollectionRepo:  dao.NewVectorCollectionDAO(db),
+		JobRepo:         dao.NewReindexJobDAO(db),
+		DocumentRepo:    dao.NewDocumentDAO(db),
+		index:           index,
+		open:            open,
+		redactor:        redactor,
+		baseName:        baseName,
+		embeddingModel:  embeddingModel,
+		admins:          admins,
+		batchSize:       defaultReindexBatchSize,
+		refreshInterval: defaultReindexRefreshInterval,
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// CheckSession 从上下文中获取用户ID
+func (d *ReindexDomain) CheckSession(ctx context.Context) (int64, error) {
+	uid, _, err := checkSession(ctx)
+	return uid, err
+}
+
+// CheckAdmin 重建索引会重新向量化所有文档，只允许管理员发起
+func (d *ReindexDomain) CheckAdmin(uid int64) error {
+	if !slices.Contains(d.admins, uid) {
+		return fmt.Errorf("只有管理员可以重建索引")
+	}
+	return nil
+}
+
+// Bootstrap 启动时切换到 MySQL 中记录的启用集合，检测向量模型是否变更，并在后台接管未完成的任务。
+// 首次启动时没有记录，视为配置的集合由配置的向量模型写入；集合不存在时按向量模型的维度创建
+func (d *ReindexDomain) Bootstrap(ctx context.Context) error {
+	active, err := d.CollectionRepo.GetActiveCollection(ctx)
+	if err != nil {
+		return fmt.Errorf("获取启用的向量集合失败: %w", err)
+	}
+	if active == nil {
+		if err = d.CollectionRepo.ActivateCollection(ctx, d.baseName, d.embeddingModel); err != nil {
+			return fmt.Errorf("记录向量集合失败: %w", err)
+		}
+		active = &model.VectorCollection{Name: d.baseName, EmbeddingModel: d.embeddingModel}
+	}
+
+	col, err := d.open(ctx, active.Name, active.EmbeddingModel, true)
+	if err != nil {
+		return fmt.Errorf("打开向量集合 %s 失败: %w", active.Name, err)
+	}
+	d.index.Switch(col)
+
+	if err = d.Refresh(ctx); err != nil {
+		return err
+	}
+
+	if current := d.index.Current(); current.EmbeddingModel != d.embeddingModel {
+		logx.WithContext(ctx).Errorf("向量集合 %s 由向量模型 %s 写入，与配置的 %s 不一致，检索继续使用原模型，重建索引完成后切换",
+			current.Name, current.EmbeddingModel, d.embeddingModel)
+		if d.autoStart {
+			if _, err = d.StartReindex(ctx, 0, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.refreshInterval > 0 {
+		threading.GoSafe(d.refreshLoop)
+	}
+
+	return nil
+}
+
+// Refresh 同步其他副本切换的启用集合，并接管待执行或已中断的任务
+func (d *ReindexDomain) Refresh(ctx context.Context) error {
+	active, err := d.CollectionRepo.GetActiveCollection(ctx)
+	if err != nil {
+		return fmt.Errorf("获取启用的向量集合失败: %w", err)
+	}
+	if current := d.index.Current(); active != nil &&
+		(current == nil || current.Name != active.Name || current.EmbeddingModel != active.EmbeddingModel) {
+		col, err := d.open(ctx, active.Name, active.EmbeddingModel, false)
+		if err != nil {
+			return fmt.Errorf("打开向量集合 %s 失败: %w", active.Name, err)
+		}
+		d.index.Switch(col)
+		logx.WithContext(ctx).Infof("已切换到向量集合 %s，向量模型 %s", active.Name, active.EmbeddingModel)
+	}
+
+	jobs, err := d.JobRepo.ListReindexJobs(ctx, []string{ReindexStatusPending, ReindexStatusRunning})
+	if err != nil {
+		return fmt.Errorf("获取重建索引任务失败: %w", err)
+	}
+	for _, job := range jobs {
+		d.runAsync(job.ID)
+	}
+
+	return nil
+}
+
+func (d *ReindexDomain) refreshLoop() {
+	ticker := time.NewTicker(d.refreshInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := d.Refresh(context.Background()); err != nil {
+			logx.Errorf("同步向量集合失败: %v", err)
+		}
+	}
+}
+
+// StartReindex 创建重建索引任务并在后台执行。已有未完成的任务时直接返回该任务；
+// 上一次任务以相同向量模型失败时从中断处继续；force 为 false 时向量模型未变更不重建
+func (d *ReindexDomain) StartReindex(ctx context.Context, uid int64, force bool) (*model.ReindexJob, error) {
+	unfinished, err := d.JobRepo.ListReindexJobs(ctx, []string{ReindexStatusPending, ReindexStatusRunning})
+	if err != nil {
+		return nil, fmt.Errorf("获取重建索引任务失败: %w", err)
+	}
+	if len(unfinished) > 0 {
+		return unfinished[0], nil
+	}
+
+	active, err := d.CollectionRepo.GetActiveCollection(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取启用的向量集合失败: %w", err)
+	}
+	if active == nil {
+		return nil, fmt.Errorf("尚未记录启用的向量集合")
+	}
+	if !force && active.EmbeddingModel == d.embeddingModel {
+		return nil, fmt.Errorf("向量集合 %s 已使用向量模型 %s，无需重建", active.Name, d.embeddingModel)
+	}
+
+	latest, err := d.JobRepo.GetLatestReindexJob(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取重建索引任务失败: %w", err)
+	}
+	if latest != nil && latest.Status == ReindexStatusFailed &&
+		latest.Source == active.Name && latest.EmbeddingModel == d.embeddingModel {
+		if err = d.JobRepo.UpdateReindexJob(ctx, latest.ID, map[string]any{"status": ReindexStatusPending}); err != nil {
+			return nil, fmt.Errorf("恢复重建索引任务失败: %w", err)
+		}
+		d.runAsync(latest.ID)
+		return d.JobRepo.GetReindexJob(ctx, latest.ID)
+	}
+
+	total, err := d.DocumentRepo.CountAllDocuments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("统计文档失败: %w", err)
+	}
+	job := &model.ReindexJob{
+		Source:         active.Name,
+		Target:         fmt.Sprintf("%s_%d", d.baseName, time.Now().Unix()),
+		EmbeddingModel: d.embeddingModel,
+		Status:         ReindexStatusPending,
+		Total:          total,
+		CreatorID:      uid,
+	}
+	if err = d.JobRepo.CreateReindexJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("创建重建索引任务失败: %w", err)
+	}
+	d.runAsync(job.ID)
+
+	return job, nil
+}
+
+func (d *ReindexDomain) runAsync(id int64) {
+	threading.GoSafe(func() {
+		if err := d.RunJob(context.Background(), id); err != nil {
+			logx.Errorf("重建索引任务 %d 失败: %v", id, err)
+		}
+	})
+}
+
+// RunJob 执行任务，任务已被其他副本执行时直接返回
+func (d *ReindexDomain) RunJob(ctx context.Context, id int64) error {
+	ok, err := d.JobRepo.ClaimReindexJob(ctx, id, []string{ReindexStatusPending}, ReindexStatusRunning,
+		time.Now().Add(-reindexStaleAfter).Unix())
+	if err != nil {
+		return fmt.Errorf("抢占重建索引任务失败: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	job, err := d.JobRepo.GetReindexJob(ctx, id)
+	if err != nil {
+		return fmt.Errorf("获取重建索引任务失败: %w", err)
+	}
+
+	if err = d.runJob(ctx, job); err != nil {
+		if updateErr := d.JobRepo.UpdateReindexJob(ctx, id, map[string]any{
+			"status":      ReindexStatusFailed,
+			"message":     err.Error(),
+			"finished_at": time.Now().Unix(),
+		}); updateErr != nil {
+			logx.WithContext(ctx).Errorf("更新重建索引任务 %d 状态失败: %v", id, updateErr)
+		}
+		return err
+	}
+
+	return d.JobRepo.UpdateReindexJob(ctx, id, map[string]any{
+		"status":      ReindexStatusSucceeded,
+		"finished_at": time.Now().Unix(),
+	})
+}
+
+// runJob 按主键顺序将文档写入新集合，每个文档完成后记录游标以便中断后继续；
+// 全部写入后补齐执行期间变更的文档再切换，切换后等待其他副本同步，期间仍写入原集合的变更再补齐一次
+func (d *ReindexDomain) runJob(ctx context.Context, job *model.ReindexJob) error {
+	target, err := d.open(ctx, job.Target, job.EmbeddingModel, true)
+	if err != nil {
+		return fmt.Errorf("打开向量集合 %s 失败: %w", job.Target, err)
+	}
+	writer := &AIHelperDomain{Qdrant: dao.NewQdrantDAO(target.Store, target.Client), Redactor: d.redactor}
+
+	// 1. 分批重建
+	cursor, processed := job.Cursor, job.Processed
+	for {
+		docs, err := d.DocumentRepo.ListDocumentsAfter(ctx, cursor, d.batchSize)
+		if err != nil {
+			return fmt.Errorf("获取文档失败: %w", err)
+		}
+		if len(docs) == 0 {
+			break
+		}
+
+		for _, doc := range docs {
+			if err = writer.reindexDocument(ctx, doc); err != nil {
+				return fmt.Errorf("重建文档 %s 失败: %w", doc.DocID, err)
+			}
+			cursor, processed = doc.ID, processed+1
+			if err = d.JobRepo.UpdateReindexJob(ctx, job.ID, map[string]any{"cursor": cursor, "processed": processed}); err != nil {
+				return fmt.Errorf("更新任务进度失败: %w", err)
+			}
+		}
+	}
+
+	// 2. 补齐任务创建后上传、更新或删除的文档
+	since := time.Now().Unix()
+	if err = d.catchUp(ctx, writer, job.CreatedAt); err != nil {
+		return err
+	}
+
+	// 3. 切换启用集合
+	if err = d.CollectionRepo.ActivateCollection(ctx, job.Target, job.EmbeddingModel); err != nil {
+		return fmt.Errorf("切换向量集合失败: %w", err)
+	}
+	d.index.Switch(target)
+	logx.WithContext(ctx).Infof("重建索引任务 %d 完成，已从 %s 切换到 %s", job.ID, job.Source, job.Target)
+
+	// 4. 其他副本最长经过一个同步间隔后切换
+	if d.refreshInterval > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d.refreshInterval):
+		}
+	}
+
+	return d.catchUp(ctx, writer, since)
+}
+
+// catchUp 重建 since 之后变更的文档，已删除的文档只删除新集合中的向量
+func (d *ReindexDomain) catchUp(ctx context.Context, writer *AIHelperDomain, since int64) error {
+	docs, err := d.DocumentRepo.ListDocumentsUpdatedSince(ctx, since)
+	if err != nil {
+		return fmt.Errorf("获取变更文档失败: %w", err)
+	}
+
+	for _, doc := range docs {
+		if err = writer.reindexDocument(ctx, doc); err != nil {
+			return fmt.Errorf("重建文档 %s 失败: %w", doc.DocID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetStatus 获取启用集合与重建任务进度，id 为 0 时返回最近一次任务
+func (d *ReindexDomain) GetStatus(ctx context.Context, id int64) (*types.ReindexStatus, error) {
+	var (
+		job *model.ReindexJob
+		err error
+	)
+	if id > 0 {
+		job, err = d.JobRepo.GetReindexJob(ctx, id)
+	} else {
+		job, err = d.JobRepo.GetLatestReindexJob(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取重建索引任务失败: %w", err)
+	}
+
+	current := d.index.Current()
+	status := &types.ReindexStatus{
+		ActiveCollection:         current.Name,
+		ActiveEmbeddingModel:     current.EmbeddingModel,
+		ConfiguredEmbeddingModel: d.embeddingModel,
+		ModelMismatch:            current.EmbeddingModel != d.embeddingModel,
+	}
+	if job != nil {
+		status.Job = d.BuildReindexJobRespModel(job)
+	}
+
+	return status, nil
+}
+
+func (d *ReindexDomain) BuildReindexJobRespModel(job *model.ReindexJob) *types.ReindexJob {
+	return &types.ReindexJob{
+		Id:             job.ID,
+		Source:         job.Source,
+		Target:         job.Target,
+		EmbeddingModel: job.EmbeddingModel,
+		Status:         job.Status,
+		Total:          job.Total,
+		Processed:      job.Processed,
+		Message:        job.Message,
+		CreatorId:      job.CreatorID,
+		CreateTime:     job.CreatedAt,
+		UpdateTime:     job.UpdatedAt,
+		FinishTime:     job.FinishedAt,
+	}
+}
@@ -0,0 +1,248 @@
+package domain
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"testing"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"gorm.io/gorm"
+)
+
+// fakeCollectionRepo 在内存中保存集合记录
+type fakeCollectionRepo struct {
+	cols []*model.VectorCollection
+}
+
+func (f *fakeCollectionRepo) GetActiveCollection(ctx context.Context) (*model.VectorCollection, error) {
+	for _, col := range f.cols {
+		if col.Active {
+			return col, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeCollectionRepo) ActivateCollection(ctx context.Context, name, embeddingModel string) error {
+	for _, col := range f.cols {
+		col.Active = false
+	}
+	f.cols = append(f.cols, &model.VectorCollection{Name: name, EmbeddingModel: embeddingModel, Active: true})
+	return nil
+}
+
+// fakeReindexJobRepo 在内存中保存任务，不校验运行中任务是否超时
+type fakeReindexJobRepo struct {
+	jobs []*model.ReindexJob
+}
+
+func (f *fakeReindexJobRepo) CreateReindexJob(ctx context.Context, job *model.ReindexJob) error {
+	job.ID = int64(len(f.jobs) + 1)
+	f.jobs = append(f.jobs, job)
+	return nil
+}
+
+func (f *fakeReindexJobRepo) GetReindexJob(ctx context.Context, id int64) (*model.ReindexJob, error) {
+	if id < 1 || int(id) > len(f.jobs) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	job := *f.jobs[id-1]
+	return &job, nil
+}
+
+func (f *fakeReindexJobRepo) GetLatestReindexJob(ctx context.Context) (*model.ReindexJob, error) {
+	if len(f.jobs) == 0 {
+		return nil, nil
+	}
+	return f.GetReindexJob(ctx, int64(len(f.jobs)))
+}
+
+func (f *fakeReindexJobRepo) ListReindexJobs(ctx context.Context, statuses []string) ([]*model.ReindexJob, error) {
+	var res []*model.ReindexJob
+	for _, job := range f.jobs {
+		if slices.Contains(statuses, job.Status) {
+			res = append(res, job)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeReindexJobRepo) ClaimReindexJob(ctx context.Context, id int64, from []string, to string, staleBefore int64) (bool, error) {
+	job := f.jobs[id-1]
+	if !slices.Contains(from, job.Status) {
+		return false, nil
+	}
+	job.Status = to
+	return true, nil
+}
+
+func (f *fakeReindexJobRepo) UpdateReindexJob(ctx context.Context, id int64, fields map[string]any) error {
+	job := f.jobs[id-1]
+	for k, v := range fields {
+		switch k {
+		case "status":
+			job.Status = v.(string)
+		case "message":
+			job.Message = v.(string)
+		case "cursor":
+			job.Cursor = v.(int64)
+		case "processed":
+			job.Processed = v.(int64)
+		}
+	}
+	return nil
+}
+
+// fakeReindexDocs 只实现重建索引用到的文档查询
+type fakeReindexDocs struct {
+	repo.DocumentRepo
+	docs []*model.Document
+}
+
+func (f *fakeReindexDocs) ListDocumentsAfter(ctx context.Context, afterID int64, limit int) ([]*model.Document, error) {
+	var res []*model.Document
+	for _, doc := range f.docs {
+		if doc.ID > afterID && doc.DeletedAt == 0 && len(res) < limit {
+			res = append(res, doc)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeReindexDocs) ListDocumentsUpdatedSince(ctx context.Context, since int64) ([]*model.Document, error) {
+	var res []*model.Document
+	for _, doc := range f.docs {
+		if doc.UpdatedAt >= since {
+			res = append(res, doc)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeReindexDocs) CountAllDocuments(ctx context.Context) (int64, error) {
+	var total int64
+	for _, doc := range f.docs {
+		if doc.DeletedAt == 0 {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func newTestReindexDomain(docs []*model.Document) (*ReindexDomain, map[string]*dao.MemoryVectorStore) {
+	stores := map[string]*dao.MemoryVectorStore{}
+	open := func(ctx context.Context, name, embeddingModel string, create bool) (*pkg.VectorCollection, error) {
+		store, ok := stores[name]
+		if !ok {
+			store = dao.NewMemoryVectorStore(keywordEmbedder{"nginx", "mysql", "redis"})
+			stores[name] = store
+		}
+		return &pkg.VectorCollection{Name: name, EmbeddingModel: embeddingModel, Store: store, Client: store}, nil
+	}
+
+	index := &pkg.VectorIndex{}
+	index.Switch(&pkg.VectorCollection{Name: "aicoreops", EmbeddingModel: "new-embed"})
+	d := &ReindexDomain{
+		CollectionRepo:  &fakeCollectionRepo{cols: []*model.VectorCollection{{Name: "aicoreops", EmbeddingModel: "old-embed", Active: true}}},
+		JobRepo:         &fakeReindexJobRepo{},
+		DocumentRepo:    &fakeReindexDocs{docs: docs},
+		index:           index,
+		open:            open,
+		baseName:        "aicoreops",
+		embeddingModel:  "new-embed",
+		batchSize:       2,
+		refreshInterval: -1,
+	}
+
+	return d, stores
+}
+
+func testDocument(id int64, content string) *model.Document {
+	return &model.Document{
+		ID:      id,
+		DocID:   content,
+		Title:   content,
+		Format:  dao.FormatText,
+		Content: base64.StdEncoding.EncodeToString([]byte(content)),
+	}
+}
+
+func TestReindexBootstrapDetectsModelChange(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestReindexDomain(nil)
+
+	if err := d.Bootstrap(ctx); err != nil {
+		t.Fatalf("Bootstrap: %v", err)
+	}
+	// 集合由旧模型写入，检索必须继续使用旧模型
+	if got := d.index.Current().EmbeddingModel; got != "old-embed" {
+		t.Errorf("active embedding model = %s, want old-embed", got)
+	}
+
+	status, err := d.GetStatus(ctx, 0)
+	if err != nil {
+		t.Fatalf("GetStatus: %v", err)
+	}
+	if !status.ModelMismatch || status.ConfiguredEmbeddingModel != "new-embed" || status.Job != nil {
+		t.Errorf("status = %+v, want mismatch without job", status)
+	}
+}
+
+func TestReindexRunJobResumeAndSwitch(t *testing.T) {
+	ctx := context.Background()
+	deleted := testDocument(1, "redis")
+	broken := testDocument(3, "mysql")
+	broken.Content = "not base64"
+	docs := []*model.Document{deleted, testDocument(2, "nginx"), broken}
+	d, stores := newTestReindexDomain(docs)
+	jobs := d.JobRepo.(*fakeReindexJobRepo)
+
+	job := &model.ReindexJob{Source: "aicoreops", Target: "aicoreops_v2", EmbeddingModel: "new-embed", Status: ReindexStatusPending, Total: 3, CreatedAt: 100}
+	if err := jobs.CreateReindexJob(ctx, job); err != nil {
+		t.Fatal(err)
+	}
+
+	// 1. 第三个文档失败，任务记录已完成的游标
+	if err := d.RunJob(ctx, job.ID); err == nil {
+		t.Fatal("RunJob succeeded with a broken document")
+	}
+	if job.Status != ReindexStatusFailed || job.Cursor != 2 || job.Processed != 2 {
+		t.Fatalf("job = %+v, want failed at cursor 2", job)
+	}
+
+	// 2. 修复文档并在任务执行期间删除已重建的文档，续跑时从游标处继续并补齐删除
+	broken.Content = base64.StdEncoding.EncodeToString([]byte("mysql"))
+	deleted.DeletedAt, deleted.UpdatedAt = 200, 200
+	job.Status = ReindexStatusPending
+	if err := d.RunJob(ctx, job.ID); err != nil {
+		t.Fatalf("RunJob: %v", err)
+	}
+	if job.Status != ReindexStatusSucceeded || job.Processed != 3 {
+		t.Errorf("job = %+v, want succeeded with 3 processed", job)
+	}
+
+	points, err := stores["aicoreops_v2"].ScrollPoints(ctx, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range points {
+		got = append(got, p.Payload["doc_id"].(string))
+	}
+	slices.Sort(got)
+	if want := []string{"mysql", "nginx"}; !slices.Equal(got, want) {
+		t.Errorf("indexed docs = %v, want %v", got, want)
+	}
+
+	current := d.index.Current()
+	if current.Name != "aicoreops_v2" || current.EmbeddingModel != "new-embed" {
+		t.Errorf("current collection = %s (%s), want aicoreops_v2 (new-embed)", current.Name, current.EmbeddingModel)
+	}
+	if active, _ := d.CollectionRepo.GetActiveCollection(ctx); active.Name != "aicoreops_v2" {
+		t.Errorf("active collection = %s, want aicoreops_v2", active.Name)
+	}
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"github.com/zeromicro/go-zero/core/metric"
+
+	"github.com/tmc/langchaingo/embeddings"
+)
+
+// 语义缓存查找结果
+const (
+	cacheResultHit  = "hit"
+	cacheResultMiss = "miss"
+)
+
+// semanticCacheLookups 语义缓存查找次数，按 hit/miss 区分
+var semanticCacheLookups = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "aicoreops_ai",
+	Subsystem: "semantic_cache",
+	Name:      "lookups_total",
+	Help:      "semantic answer cache lookups by result.",
+	Labels:    []string{"result"},
+})
+
+// CacheLookup 语义缓存查找结果，未命中时生成回答后通过 Store 写入
+type CacheLookup struct {
+	Hit       bool
+	Answer    string
+	Citations []*types.Citation
+	key       pkg.SemanticCacheKey
+}
+
+type cachedAnswer struct {
+	answer    string
+	citations []*types.Citation
+}
+
+// SemanticCacheDomain 语义缓存，值为 nil 时表示未启用，所有方法均可安全调用
+type SemanticCacheDomain struct {
+	cache    *pkg.SemanticCache
+	embedder embeddings.EmbedderClient
+}
+
+func NewSemanticCacheDomain(cache *pkg.SemanticCache, embedder embeddings.EmbedderClient) *SemanticCacheDomain {
+	if cache == nil {
+		return nil
+	}
+
+	return &SemanticCacheDomain{cache: cache, embedder: embedder}
+}
+
+// uncacheablePromptFields 渲染结果随提问用户或当前时间变化的模板变量，引用它们的模板生成的回答不能复用
+var uncacheablePromptFields = []string{"User", "Now"}
+
+// Lookup 按问题向量查找缓存的回答；Agent 模式依赖实时状态、scope 为 nil 时无法确定检索范围、
+// 模板引用了提问用户或当前时间时回答因人因时而异，均不使用缓存并返回 nil
+func (d *SemanticCacheDomain) Lookup(ctx context.Context, req *types.AskQuestionRequest, tpl *model.PromptTemplate, scope *KnowledgeScope) (*CacheLookup, error) {
+	if d == nil || req.Agent || scope == nil || PromptReferences(tpl, uncacheablePromptFields...) {
+		return nil, nil
+	}
+
+	vectors, err := d.embedder.CreateEmbedding(ctx, []string{strings.TrimSpace(req.Question)})
+	if err != nil {
+		return nil, fmt.Errorf("问题向量化失败: %w", err)
+	}
+	if len(vectors) == 0 {
+		return nil, fmt.Errorf("问题向量化失败: 返回结果为空")
+	}
+
+	lookup := &CacheLookup{key: d.cache.Key(cachePartition(req, tpl), vectors[0], scope.IDs)}
+	value, ok := d.cache.Get(lookup.key)
+	if !ok {
+		semanticCacheLookups.Inc(cacheResultMiss)
+		return lookup, nil
+	}
+
+	semanticCacheLookups.Inc(cacheResultHit)
+	cached := value.(*cachedAnswer)
+	lookup.Hit = true
+	lookup.Answer = cached.answer
+	lookup.Citations = cached.citations
+	return lookup, nil
+}
+
+// cachePartition 提示词模板版本、检索参数和采样参数都会改变回答，只在取值完全相同的请求之间复用
+func cachePartition(req *types.AskQuestionRequest, tpl *model.PromptTemplate) string {
+	return fmt.Sprintf("%s@%d|%s|%d|%g|%s|%t|%g|%g",
+		tpl.Name, tpl.Version, req.Title,
+		req.TopK, req.ScoreThreshold, req.RetrievalMode, req.Rerank,
+		req.Temperature, req.TopP)
+}
+
+// Store 缓存未命中时生成的回答
+func (d *SemanticCacheDomain) Store(lookup *CacheLookup, answer string, citations []*types.Citation) {
+	if d == nil || lookup == nil || lookup.Hit || answer == "" {
+		return
+	}
+
+	d.cache.Set(lookup.key, &cachedAnswer{answer: answer, citations: citations})
+}
+
+// Invalidate 知识库中的文档上传、更新或删除后清除相关缓存；只清除本进程的缓存，
+// 多副本部署时其他副本最长经过 TTL 才会失效
+func (d *SemanticCacheDomain) Invalidate(kbID int64) {
+	if d == nil {
+		return
+	}
+
+	d.cache.Invalidate(kbID)
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// vectorEmbedder 按问题返回预设向量
+type vectorEmbedder map[string][]float32
+
+func (e vectorEmbedder) CreateEmbedding(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		vectors = append(vectors, e[text])
+	}
+	return vectors, nil
+}
+
+func TestSemanticCache(t *testing.T) {
+	ctx := context.Background()
+	embedder := vectorEmbedder{
+		"如何重启支付服务？":       {1, 0, 0},
+		"怎么重启 payment 服务": {0.99, 0.1, 0},
+		"如何扩容订单服务？":       {0, 1, 0},
+	}
+	cache := NewSemanticCacheDomain(pkg.NewSemanticCache(0.95, 10, time.Hour), embedder)
+	scope := &KnowledgeScope{IDs: []int64{3, 0}}
+	tpl := builtinPrompts[PromptFeatureChat]
+	ask := func(question string, scope *KnowledgeScope) *CacheLookup {
+		lookup, err := cache.Lookup(ctx, &types.AskQuestionRequest{Question: question}, tpl, scope)
+		if err != nil {
+			t.Fatalf("Lookup(%q): %v", question, err)
+		}
+		return lookup
+	}
+
+	lookup := ask("如何重启支付服务？", scope)
+	if lookup.Hit {
+		t.Fatalf("empty cache should miss")
+	}
+	citations := []*types.Citation{{Title: "支付服务运维手册"}}
+	cache.Store(lookup, "kubectl rollout restart deploy/payment", citations)
+
+	// 相似问题命中，检索范围顺序不影响匹配
+	lookup = ask("怎么重启 payment 服务", &KnowledgeScope{IDs: []int64{0, 3}})
+	if !lookup.Hit || lookup.Answer != "kubectl rollout restart deploy/payment" || len(lookup.Citations) != 1 {
+		t.Fatalf("similar question lookup = %+v", lookup)
+	}
+	if ask("如何扩容订单服务？", scope).Hit {
+		t.Errorf("unrelated question should miss")
+	}
+	if ask("如何重启支付服务？", &KnowledgeScope{IDs: []int64{0}}).Hit {
+		t.Errorf("different knowledge base scope should miss")
+	}
+
+	// 检索范围内的知识库变更后失效
+	cache.Invalidate(3)
+	if ask("如何重启支付服务？", scope).Hit {
+		t.Errorf("entry should be invalidated after knowledge base 3 changed")
+	}
+
+	// 生成回答期间知识库发生变更时不写入
+	lookup = ask("如何重启支付服务？", scope)
+	cache.Invalidate(0)
+	cache.Store(lookup, "过期的回答", nil)
+	if ask("如何重启支付服务？", scope).Hit {
+		t.Errorf("answer generated before invalidation should not be cached")
+	}
+
+	// Agent 模式不使用缓存
+	if lookup, _ := cache.Lookup(ctx, &types.AskQuestionRequest{Question: "如何重启支付服务？", Agent: true}, tpl, scope); lookup != nil {
+		t.Errorf("agent request should bypass cache")
+	}
+}
+
+func TestSemanticCachePartition(t *testing.T) {
+	ctx := context.Background()
+	embedder := vectorEmbedder{"如何重启支付服务？": {1, 0, 0}}
+	cache := NewSemanticCacheDomain(pkg.NewSemanticCache(0.95, 10, time.Hour), embedder)
+	scope := &KnowledgeScope{IDs: []int64{0}}
+	tpl := &model.PromptTemplate{Name: "sre", Version: 1, SystemPrompt: "你是 SRE"}
+	base := &types.AskQuestionRequest{Question: "如何重启支付服务？", TopK: 5, RetrievalMode: "hybrid", Temperature: 0.2}
+
+	lookup, err := cache.Lookup(ctx, base, tpl, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Store(lookup, "kubectl rollout restart deploy/payment", nil)
+	if lookup, _ = cache.Lookup(ctx, proto.Clone(base).(*types.AskQuestionRequest), tpl, scope); !lookup.Hit {
+		t.Fatalf("same parameters should hit")
+	}
+
+	variants := map[string]func(req *types.AskQuestionRequest, tpl *model.PromptTemplate){
+		"template version": func(req *types.AskQuestionRequest, tpl *model.PromptTemplate) { tpl.Version = 2 },
+		"top k":            func(req *types.AskQuestionRequest, tpl *model.PromptTemplate) { req.TopK = 3 },
+		"score threshold":  func(req *types.AskQuestionRequest, tpl *model.PromptTemplate) { req.ScoreThreshold = 0.5 },
+		"retrieval mode":   func(req *types.AskQuestionRequest, tpl *model.PromptTemplate) { req.RetrievalMode = "vector" },
+		"rerank":           func(req *types.AskQuestionRequest, tpl *model.PromptTemplate) { req.Rerank = true },
+		"temperature":      func(req *types.AskQuestionRequest, tpl *model.PromptTemplate) { req.Temperature = 0.9 },
+		"top p":            func(req *types.AskQuestionRequest, tpl *model.PromptTemplate) { req.TopP = 0.5 },
+	}
+	for name, change := range variants {
+		req, variant := proto.Clone(base).(*types.AskQuestionRequest), *tpl
+		change(req, &variant)
+		if lookup, _ := cache.Lookup(ctx, req, &variant, scope); lookup.Hit {
+			t.Errorf("%s: different value should miss", name)
+		}
+	}
+
+	// 引用提问用户或当前时间的模板不使用缓存
+	for _, text := range []string{"当前用户 {{.User}}", "{{range .Docs}}{{$.Now.Format \"2006-01-02\"}}{{end}}"} {
+		dynamic := &model.PromptTemplate{Name: "dynamic", SystemPrompt: text}
+		if lookup, _ := cache.Lookup(ctx, base, dynamic, scope); lookup != nil {
+			t.Errorf("template %q should bypass cache", text)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"gorm.io/gorm"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+const (
+	// CodeQuotaExceeded 配额用尽时 AskQuestion 返回的状态码
+	CodeQuotaExceeded = 429
+	// defaultUsageDays 未指定日期范围时统计最近的天数
+	defaultUsageDays = 30
+	// maxUsageDays 单次最多统计的天数
+	maxUsageDays = 366
+	// AllUsers 管理员查询所有用户用量时传入的用户ID
+	AllUsers = -1
+)
+
+// ErrQuotaExceeded token 配额已用完
+var ErrQuotaExceeded = errors.New("token 配额已用完")
+
+// TokenUsage 单次提问消耗的 token
+type TokenUsage struct {
+	Model            string
+	PromptTokens     int
+	CompletionTokens int
+}
+
+func (u TokenUsage) TotalTokens() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// Add 累加大模型返回的用量，Agent 模式下一次提问会多次调用大模型
+func (u *TokenUsage) Add(resp *llms.ContentResponse) {
+	if resp == nil || len(resp.Choices) == 0 {
+		return
+	}
+
+	info := resp.Choices[0].GenerationInfo
+	u.PromptTokens += intValue(info["PromptTokens"])
+	u.CompletionTokens += intValue(info["CompletionTokens"])
+}
+
+// Estimate 提供方未返回用量时（如 OpenAI 兼容服务的流式输出）按字符数估算
+func (u *TokenUsage) Estimate(messages []llms.MessageContent, answer string) {
+	if u.PromptTokens == 0 {
+		for _, m := range messages {
+			for _, p := range m.Parts {
+				if text, ok := p.(llms.TextContent); ok {
+					u.PromptTokens += estimateTokens(text.Text)
+				}
+			}
+		}
+	}
+	if u.CompletionTokens == 0 {
+		u.CompletionTokens = estimateTokens(answer)
+	}
+}
+
+func intValue(v any) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int32:
+		return int(n)
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	default:
+		return 0
+	}
+}
+
+type UsageDomain struct {
+	UsageRepo     repo.UsageRepo
+	dailyTokens   int64
+	monthlyTokens int64
+	admins        []int64
+}
+
+// NewUsageDomain 创建用量统计领域对象，配额为 0 表示不限制
+func NewUsageDomain(db *gorm.DB, dailyTokens, monthlyTokens int64, admins []int64) *UsageDomain {
+	return &UsageDomain{
+		UsageRepo:     dao.NewUsageDAO(db),
+		dailyTokens:   dailyTokens,
+		monthlyTokens: monthlyTokens,
+		admins:        admins,
+	}
+}
+
+// CheckQuota 调用大模型前检查用户当日和当月的 token 配额
+func (d *UsageDomain) CheckQuota(ctx context.Context, uid int64, now time.Time) error {
+	if d.dailyTokens > 0 {
+		used, err := d.UsageRepo.SumUserTokens(ctx, uid, startOfDay(now).Unix())
+		if err != nil {
+			return fmt.Errorf("统计 token 用量失败: %w", err)
+		}
+		if used >= d.dailyTokens {
+			return fmt.Errorf("%w: 今日已使用 %d，每日配额 %d", ErrQuotaExceeded, used, d.dailyTokens)
+		}
+	}
+
+	if d.monthlyTokens > 0 {
+		used, err := d.UsageRepo.SumUserTokens(ctx, uid, startOfMonth(now).Unix())
+		if err != nil {
+			return fmt.Errorf("统计 token 用量失败: %w", err)
+		}
+		if used >= d.monthlyTokens {
+			return fmt.Errorf("%w: 本月已使用 %d，每月配额 %d", ErrQuotaExceeded, used, d.monthlyTokens)
+		}
+	}
+
+	return nil
+}
+
+// GetUsageStats 按用户和日期统计用量，普通用户只能查询自己，管理员可查询任意用户
+func (d *UsageDomain) GetUsageStats(ctx context.Context, uid, target int64, startDate, endDate string, now time.Time) ([]*types.GetUsageStatsResponse_UsageStat, *types.GetUsageStatsResponse_UsageQuota, error) {
+	if target == 0 {
+		target = uid
+	}
+	if target != uid && !slices.Contains(d.admins, uid) {
+		return nil, nil, fmt.Errorf("没有查看其他用户用量的权限")
+	}
+
+	start, end, err := parseUsageRange(startDate, endDate, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userID := target
+	if target == AllUsers {
+		userID = 0
+	}
+	stats, err := d.UsageRepo.GetUsageStats(ctx, userID, start.Unix(), end.Unix())
+	if err != nil {
+		return nil, nil, fmt.Errorf("统计 token 用量失败: %w", err)
+	}
+
+	res := make([]*types.GetUsageStatsResponse_UsageStat, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, &types.GetUsageStatsResponse_UsageStat{
+			UserId:           s.UserID,
+			Date:             s.Date,
+			PromptTokens:     s.PromptTokens,
+			CompletionTokens: s.CompletionTokens,
+			TotalTokens:      s.TotalTokens,
+			Requests:         s.Requests,
+		})
+	}
+
+	if target == AllUsers {
+		return res, nil, nil
+	}
+
+	quota, err := d.getQuota(ctx, target, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, quota, nil
+}
+
+func (d *UsageDomain) getQuota(ctx context.Context, uid int64, now time.Time) (*types.GetUsageStatsResponse_UsageQuota, error) {
+	daily, err := d.UsageRepo.SumUserTokens(ctx, uid, startOfDay(now).Unix())
+	if err != nil {
+		return nil, fmt.Errorf("统计 token 用量失败: %w", err)
+	}
+	monthly, err := d.UsageRepo.SumUserTokens(ctx, uid, startOfMonth(now).Unix())
+	if err != nil {
+		return nil, fmt.Errorf("统计 token 用量失败: %w", err)
+	}
+
+	return &types.GetUsageStatsResponse_UsageQuota{
+		DailyLimit:   d.dailyTokens,
+		DailyUsed:    daily,
+		MonthlyLimit: d.monthlyTokens,
+		MonthlyUsed:  monthly,
+	}, nil
+}
+
+// parseUsageRange 解析闭区间日期，返回 [start, end) 时间范围
+func parseUsageRange(startDate, endDate string, now time.Time) (time.Time, time.Time, error) {
+	end := startOfDay(now)
+	if endDate != "" {
+		t, err := time.ParseInLocation(time.DateOnly, endDate, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("无效的结束日期: %s", endDate)
+		}
+		end = t
+	}
+
+	start := end.AddDate(0, 0, 1-defaultUsageDays)
+	if startDate != "" {
+		t, err := time.ParseInLocation(time.DateOnly, startDate, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("无效的开始日期: %s", startDate)
+		}
+		start = t
+	}
+
+	end = end.AddDate(0, 0, 1)
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("开始日期不能晚于结束日期")
+	}
+	if end.Sub(start) > maxUsageDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("统计范围不能超过 %d 天", maxUsageDays)
+	}
+
+	return start, end, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func startOfMonth(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+}
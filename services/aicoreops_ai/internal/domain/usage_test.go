@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+
+	"github.com/tmc/langchaingo/llms"
+)
+
+// fakeUsageRepo 按 since 返回预设的用量
+type fakeUsageRepo struct {
+	daily, monthly int64
+	day, month     int64
+	statsUser      int64
+}
+
+func (f *fakeUsageRepo) SumUserTokens(ctx context.Context, userId int64, since int64) (int64, error) {
+	if since == f.day {
+		return f.daily, nil
+	}
+	return f.monthly, nil
+}
+
+func (f *fakeUsageRepo) GetUsageStats(ctx context.Context, userId int64, start, end int64) ([]*model.UsageStat, error) {
+	f.statsUser = userId
+	return []*model.UsageStat{{UserID: userId, Date: "2024-12-01", TotalTokens: 100, Requests: 2}}, nil
+}
+
+func newTestUsageDomain(daily, monthly int64, now time.Time) (*UsageDomain, *fakeUsageRepo) {
+	repo := &fakeUsageRepo{day: startOfDay(now).Unix(), month: startOfMonth(now).Unix()}
+	d := &UsageDomain{UsageRepo: repo, dailyTokens: daily, monthlyTokens: monthly, admins: []int64{1}}
+	return d, repo
+}
+
+func TestCheckQuota(t *testing.T) {
+	now := time.Date(2024, 12, 15, 10, 0, 0, 0, time.Local)
+	d, repo := newTestUsageDomain(1000, 5000, now)
+
+	repo.daily, repo.monthly = 999, 4000
+	if err := d.CheckQuota(context.Background(), 2, now); err != nil {
+		t.Fatalf("under quota: %v", err)
+	}
+
+	repo.daily = 1000
+	if err := d.CheckQuota(context.Background(), 2, now); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("daily exceeded: err = %v", err)
+	}
+
+	repo.daily, repo.monthly = 10, 5000
+	if err := d.CheckQuota(context.Background(), 2, now); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("monthly exceeded: err = %v", err)
+	}
+
+	unlimited, repo := newTestUsageDomain(0, 0, now)
+	repo.daily, repo.monthly = 1<<40, 1<<40
+	if err := unlimited.CheckQuota(context.Background(), 2, now); err != nil {
+		t.Fatalf("unlimited: %v", err)
+	}
+}
+
+func TestGetUsageStatsPermission(t *testing.T) {
+	now := time.Date(2024, 12, 15, 10, 0, 0, 0, time.Local)
+	d, repo := newTestUsageDomain(1000, 0, now)
+
+	stats, quota, err := d.GetUsageStats(context.Background(), 2, 0, "", "", now)
+	if err != nil {
+		t.Fatalf("self: %v", err)
+	}
+	if repo.statsUser != 2 || len(stats) != 1 || quota == nil || quota.DailyLimit != 1000 {
+		t.Errorf("self stats = %v, quota = %v, user = %d", stats, quota, repo.statsUser)
+	}
+
+	if _, _, err := d.GetUsageStats(context.Background(), 2, 3, "", "", now); err == nil {
+		t.Error("non-admin should not query other users")
+	}
+
+	_, quota, err = d.GetUsageStats(context.Background(), 1, AllUsers, "", "", now)
+	if err != nil {
+		t.Fatalf("admin: %v", err)
+	}
+	if repo.statsUser != 0 || quota != nil {
+		t.Errorf("admin all users: user = %d, quota = %v", repo.statsUser, quota)
+	}
+}
+
+func TestParseUsageRange(t *testing.T) {
+	now := time.Date(2024, 12, 15, 10, 0, 0, 0, time.Local)
+
+	start, end, err := parseUsageRange("", "", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := end.Sub(start); got != defaultUsageDays*24*time.Hour {
+		t.Errorf("default range = %v", got)
+	}
+	if !end.Equal(time.Date(2024, 12, 16, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("end = %v, want start of tomorrow", end)
+	}
+
+	start, end, err = parseUsageRange("2024-12-01", "2024-12-01", now)
+	if err != nil || end.Sub(start) != 24*time.Hour {
+		t.Errorf("single day: %v %v %v", start, end, err)
+	}
+
+	for _, r := range [][2]string{{"2024-12-02", "2024-12-01"}, {"2023-01-01", "2024-12-01"}, {"bad", ""}} {
+		if _, _, err := parseUsageRange(r[0], r[1], now); err == nil {
+			t.Errorf("range %v should be rejected", r)
+		}
+	}
+}
+
+func TestTokenUsage(t *testing.T) {
+	usage := TokenUsage{}
+	usage.Add(&llms.ContentResponse{Choices: []*llms.ContentChoice{{
+		GenerationInfo: map[string]any{"PromptTokens": 12, "CompletionTokens": 5},
+	}}})
+	usage.Estimate(nil, "ignored")
+	if usage.PromptTokens != 12 || usage.CompletionTokens != 5 || usage.TotalTokens() != 17 {
+		t.Errorf("usage = %+v", usage)
+	}
+
+	estimated := TokenUsage{}
+	estimated.Estimate([]llms.MessageContent{llms.TextParts(llms.ChatMessageTypeHuman, "12345678")}, "abcd")
+	if estimated.PromptTokens != 2 || estimated.CompletionTokens != 1 {
+		t.Errorf("estimated = %+v", estimated)
+	}
+}
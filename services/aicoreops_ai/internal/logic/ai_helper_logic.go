@@ -2,13 +2,19 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/tmc/langchaingo/llms"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -19,14 +25,22 @@ type AIHelperLogic struct {
 	svcCtx *svc.ServiceContext
 	logx.Logger
 	domain *domain.AIHelperDomain
+	usage  *domain.UsageDomain
+	prompt *domain.PromptDomain
+	kb     *domain.KnowledgeBaseDomain
 }
 
 func NewAIHelperLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AIHelperLogic {
+	quota := svcCtx.Config.Quota
 	return &AIHelperLogic{
 		ctx:    ctx,
 		svcCtx: svcCtx,
 		Logger: logx.WithContext(ctx),
-		domain: domain.NewAIHelperDomain(svcCtx.DB, svcCtx.Qdrant),
+		domain: domain.NewAIHelperDomain(svcCtx.DB, svcCtx.VectorIndex.Current(), svcCtx.LLM,
+			domain.NewSemanticCacheDomain(svcCtx.SemanticCache, svcCtx.LLM), svcCtx.Redactor, svcCtx.Config.Qdrant.KeywordCandidateLimit),
+		usage:  domain.NewUsageDomain(svcCtx.DB, quota.DailyTokens, quota.MonthlyTokens, svcCtx.Config.Admins),
+		prompt: domain.NewPromptDomain(svcCtx.DB, svcCtx.Config.Admins),
+		kb:     domain.NewKnowledgeBaseDomain(svcCtx.DB, svcCtx.Config.Admins),
 	}
 }
 
@@ -54,17 +68,29 @@ func (a *AIHelperLogic) GetChatList(req *types.GetChatListRequest) (*types.GetCh
 	}, nil
 }
 
-// GetChatHistory 获取指定 sessionID 的历史聊天记录
+// GetChatHistory 获取指定 sessionID 的历史聊天记录，page_size 为 0 时返回全部
 func (a *AIHelperLogic) GetChatHistory(req *types.GetChatHistoryRequest) (*types.GetChatHistoryResponse, error) {
+	uid, _, err := a.domain.CheckSession(a.ctx)
+	if err != nil {
+		a.Logger.Errorf("获取历史记录失败: %v", err)
+		return nil, fmt.Errorf("获取历史记录失败: %v", err)
+	}
+
 	// 1. 获取历史记录
-	histories, err := a.domain.GetHistoryBySessionID(a.ctx, req.SessionId)
+	limit, offset := -1, 0
+	if req.PageSize > 0 {
+		limit = int(req.PageSize)
+		offset = int(max(req.Page-1, 0) * req.PageSize)
+	}
+
+	histories, total, err := a.domain.GetChatHistory(a.ctx, uid, req.SessionId, limit, offset)
 	if err != nil {
 		a.Logger.Errorf("获取历史记录失败: %v", err)
 		return nil, fmt.Errorf("获取历史记录失败: %v", err)
 	}
 
-	// 2. 异步加载记录到 memoryBuf
-	if err = a.domain.LoadHistoryToMemory(a.ctx, a.svcCtx, req.SessionId, histories); err != nil {
+	// 2. 预热会话记忆
+	if _, _, err = a.domain.GetMemoryBuf(a.ctx, req.SessionId, a.svcCtx.MemoryStore); err != nil {
 		a.Logger.Errorf("加载历史记录失败: %v", err)
 		return nil, fmt.Errorf("加载历史记录失败: %v", err)
 	}
@@ -72,23 +98,236 @@ func (a *AIHelperLogic) GetChatHistory(req *types.GetChatHistoryRequest) (*types
 	return &types.GetChatHistoryResponse{
 		Code:    0,
 		Message: "success",
-		Data:    &types.GetChatHistoryResponse_ChatHistoryData{Messages: histories, Total: int32(len(histories))},
+		Data:    &types.GetChatHistoryResponse_ChatHistoryData{Messages: histories, Total: int32(total)},
+	}, nil
+}
+
+// RenameChat 重命名会话
+func (a *AIHelperLogic) RenameChat(req *types.RenameChatRequest) (*types.RenameChatResponse, error) {
+	uid, _, err := a.domain.CheckSession(a.ctx)
+	if err != nil {
+		a.Logger.Errorf("重命名会话失败: %v", err)
+		return nil, fmt.Errorf("重命名会话失败: %v", err)
+	}
+
+	if err := a.domain.RenameChat(a.ctx, uid, req.SessionId, req.Title); err != nil {
+		a.Logger.Errorf("重命名会话失败: %v", err)
+		return nil, fmt.Errorf("重命名会话失败: %v", err)
+	}
+
+	return &types.RenameChatResponse{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// DeleteChat 删除会话
+func (a *AIHelperLogic) DeleteChat(req *types.DeleteChatRequest) (*types.DeleteChatResponse, error) {
+	uid, _, err := a.domain.CheckSession(a.ctx)
+	if err != nil {
+		a.Logger.Errorf("删除会话失败: %v", err)
+		return nil, fmt.Errorf("删除会话失败: %v", err)
+	}
+
+	if err := a.domain.DeleteChat(a.ctx, uid, req.SessionId, a.svcCtx.MemoryStore); err != nil {
+		a.Logger.Errorf("删除会话失败: %v", err)
+		return nil, fmt.Errorf("删除会话失败: %v", err)
+	}
+
+	return &types.DeleteChatResponse{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// SearchChats 搜索历史问答
+func (a *AIHelperLogic) SearchChats(req *types.SearchChatsRequest) (*types.SearchChatsResponse, error) {
+	uid, _, err := a.domain.CheckSession(a.ctx)
+	if err != nil {
+		a.Logger.Errorf("搜索历史问答失败: %v", err)
+		return nil, fmt.Errorf("搜索历史问答失败: %v", err)
+	}
+
+	limit := req.PageSize
+	offset := (req.Page - 1) * req.PageSize
+
+	hits, total, err := a.domain.SearchChats(a.ctx, uid, req.Keyword, int(limit), int(offset))
+	if err != nil {
+		a.Logger.Errorf("搜索历史问答失败: %v", err)
+		return nil, fmt.Errorf("搜索历史问答失败: %v", err)
+	}
+
+	return &types.SearchChatsResponse{
+		Code:    0,
+		Message: "success",
+		Data:    hits,
+		Total:   total,
+	}, nil
+}
+
+// ExportChat 导出会话
+func (a *AIHelperLogic) ExportChat(req *types.ExportChatRequest) (*types.ExportChatResponse, error) {
+	uid, _, err := a.domain.CheckSession(a.ctx)
+	if err != nil {
+		a.Logger.Errorf("导出会话失败: %v", err)
+		return nil, fmt.Errorf("导出会话失败: %v", err)
+	}
+
+	data, err := a.domain.ExportChat(a.ctx, uid, req.SessionId, req.Format)
+	if err != nil {
+		a.Logger.Errorf("导出会话失败: %v", err)
+		return nil, fmt.Errorf("导出会话失败: %v", err)
+	}
+
+	return &types.ExportChatResponse{
+		Code:    0,
+		Message: "success",
+		Data:    data,
 	}, nil
 }
 
-// UploadDocument 上传运维文档
+// UploadDocument 上传运维文档到有权写入的知识库
 func (a *AIHelperLogic) UploadDocument(req *types.UploadDocumentRequest) (*types.UploadDocumentResponse, error) {
-	if err := a.domain.UploadDocument(a.ctx, req.Title, req.Content); err != nil {
+	p, err := a.kb.CheckPrincipal(a.ctx)
+	if err != nil {
+		a.Logger.Errorf("上传文档失败: %v", err)
+		return nil, fmt.Errorf("上传文档失败: %v", err)
+	}
+
+	kbID, err := a.kb.WritableKnowledgeBase(a.ctx, p, req.KnowledgeBase)
+	if err != nil {
 		a.Logger.Errorf("上传文档失败: %v", err)
 		return nil, fmt.Errorf("上传文档失败: %v", err)
 	}
 
+	doc, duplicated, report, err := a.domain.UploadDocument(a.ctx, p.UID, kbID, req.Title, req.Format, req.Content)
+	if err != nil {
+		a.Logger.Errorf("上传文档失败: %v", err)
+		return nil, fmt.Errorf("上传文档失败: %v", err)
+	}
+	if len(report) > 0 {
+		a.Logger.Infof("文档 %s 分块已脱敏: %s", doc.DocID, report)
+	}
+
 	return &types.UploadDocumentResponse{
 		Code:    0,
 		Message: "success",
+		Data: &types.UploadDocumentResponse_DocData{
+			DocId:      doc.DocID,
+			Duplicated: duplicated,
+			Redactions: domain.BuildRedactions(report),
+		},
+	}, nil
+}
+
+// GetDocList 获取有权读取的知识库文档列表
+func (a *AIHelperLogic) GetDocList(req *types.GetDocListRequest) (*types.GetDocListResponse, error) {
+	var names []string
+	if req.KnowledgeBase != "" {
+		names = []string{req.KnowledgeBase}
+	}
+	scope, err := a.readableScope(names)
+	if err != nil {
+		a.Logger.Errorf("获取文档列表失败: %v", err)
+		return nil, fmt.Errorf("获取文档列表失败: %v", err)
+	}
+
+	limit := req.PageSize
+	offset := (req.Page - 1) * req.PageSize
+
+	docs, total, err := a.domain.GetDocumentList(a.ctx, scope, int(limit), int(offset))
+	if err != nil {
+		a.Logger.Errorf("获取文档列表失败: %v", err)
+		return nil, fmt.Errorf("获取文档列表失败: %v", err)
+	}
+
+	return &types.GetDocListResponse{
+		Code:    0,
+		Message: "success",
+		Data:    docs,
+		Total:   total,
+	}, nil
+}
+
+// GetDocument 获取文档详情
+func (a *AIHelperLogic) GetDocument(req *types.GetDocumentRequest) (*types.GetDocumentResponse, error) {
+	scope, err := a.readableScope(nil)
+	if err != nil {
+		a.Logger.Errorf("获取文档失败: %v", err)
+		return nil, fmt.Errorf("获取文档失败: %v", err)
+	}
+
+	doc, err := a.domain.GetDocument(a.ctx, scope, req.DocId)
+	if err != nil {
+		a.Logger.Errorf("获取文档失败: %v", err)
+		return nil, fmt.Errorf("获取文档失败: %v", err)
+	}
+
+	return &types.GetDocumentResponse{
+		Code:    0,
+		Message: "success",
+		Data:    doc,
+	}, nil
+}
+
+// UpdateDocument 更新文档
+func (a *AIHelperLogic) UpdateDocument(req *types.UpdateDocumentRequest) (*types.UpdateDocumentResponse, error) {
+	scope, err := a.writableScope()
+	if err != nil {
+		a.Logger.Errorf("更新文档失败: %v", err)
+		return nil, fmt.Errorf("更新文档失败: %v", err)
+	}
+
+	doc, err := a.domain.UpdateDocument(a.ctx, scope, req.DocId, req.Title, req.Format, req.Content)
+	if err != nil {
+		a.Logger.Errorf("更新文档失败: %v", err)
+		return nil, fmt.Errorf("更新文档失败: %v", err)
+	}
+
+	return &types.UpdateDocumentResponse{
+		Code:    0,
+		Message: "success",
+		Data:    doc,
+	}, nil
+}
+
+// DeleteDocument 删除文档
+func (a *AIHelperLogic) DeleteDocument(req *types.DeleteDocumentRequest) (*types.DeleteDocumentResponse, error) {
+	scope, err := a.writableScope()
+	if err != nil {
+		a.Logger.Errorf("删除文档失败: %v", err)
+		return nil, fmt.Errorf("删除文档失败: %v", err)
+	}
+
+	if err := a.domain.DeleteDocument(a.ctx, scope, req.DocId); err != nil {
+		a.Logger.Errorf("删除文档失败: %v", err)
+		return nil, fmt.Errorf("删除文档失败: %v", err)
+	}
+
+	return &types.DeleteDocumentResponse{
+		Code:    0,
+		Message: "success",
 	}, nil
 }
 
+// readableScope 获取当前用户可读的知识库范围
+func (a *AIHelperLogic) readableScope(names []string) (*domain.KnowledgeScope, error) {
+	p, err := a.kb.CheckPrincipal(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return a.kb.ReadableScope(a.ctx, p, names)
+}
+
+// writableScope 获取当前用户可写的知识库范围
+func (a *AIHelperLogic) writableScope() (*domain.KnowledgeScope, error) {
+	p, err := a.kb.CheckPrincipal(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return a.kb.WritableScope(a.ctx, p)
+}
+
 // CreateNewChat 创建新聊天
 func (a *AIHelperLogic) CreateNewChat(req *types.CreateNewChatRequest) (*types.CreateNewChatResponse, error) {
 	sessionID := uuid.New().String()
@@ -102,7 +341,8 @@ func (a *AIHelperLogic) CreateNewChat(req *types.CreateNewChatRequest) (*types.C
 	}, nil
 }
 
-// AskQuestion 实现 AI 助手的提问接口逻辑，使用双向流式 RPC
+// AskQuestion 实现 AI 助手的提问接口逻辑，使用双向流式 RPC；
+// 生成回答期间可发送 stop 指令中断，回答结束后可发送 regenerate 指令重新生成上一个问题的回答
 func (a *AIHelperLogic) AskQuestion(stream types.AIHelper_AskQuestionServer) error {
 	// 1. check session
 	uid, sessionID, err := a.domain.CheckSession(a.ctx)
@@ -110,9 +350,14 @@ func (a *AIHelperLogic) AskQuestion(stream types.AIHelper_AskQuestionServer) err
 		a.Logger.Errorf("检查会话失败: %v", err)
 		return fmt.Errorf("检查会话失败: %v", err)
 	}
+	// 会话记忆和重新生成时读取的历史问题都按会话ID获取，先确认会话属于当前用户
+	if err := a.domain.CheckSessionOwner(a.ctx, uid, sessionID); err != nil {
+		a.Logger.Errorf("检查会话失败: %v", err)
+		return fmt.Errorf("检查会话失败: %v", err)
+	}
 
 	// 2. get memoryBuf
-	buf, new, err := a.domain.GetMemoryBuf(a.ctx, sessionID, a.svcCtx.LLM, a.svcCtx.MemoryBuf, a.svcCtx.Mutex)
+	mem, exists, err := a.domain.GetMemoryBuf(a.ctx, sessionID, a.svcCtx.MemoryStore)
 	if err != nil {
 		a.Logger.Errorf("获取 MemoryBuf 失败: %v", err)
 		return fmt.Errorf("获取 MemoryBuf 失败: %v", err)
@@ -121,14 +366,20 @@ func (a *AIHelperLogic) AskQuestion(stream types.AIHelper_AskQuestionServer) err
 	session := &domain.ChatSession{
 		UserID:    uid,
 		SessionID: sessionID,
-		MemoryBuf: buf,
-		IsNew:     new,
+		Memory:    mem,
+		IsNew:     !exists,
 	}
 
 	// 3. Ask & Reply
+	in := newAskStream(stream)
+	var (
+		// last 本流中上一个问题及其问答记录ID，重新生成时沿用其检索参数
+		last   *types.AskQuestionRequest
+		lastID int64
+	)
 	for {
 		// 3.1从流中接收请求
-		req, err := stream.Recv()
+		req, err := in.Next()
 		if err != nil {
 			if err == io.EOF {
 				a.Logger.Info("释放流: %v", sessionID)
@@ -138,44 +389,344 @@ func (a *AIHelperLogic) AskQuestion(stream types.AIHelper_AskQuestionServer) err
 			return fmt.Errorf("流: %v, 接收请求失败: %v", sessionID, err)
 		}
 
+		var (
+			report   pkg.RedactionReport
+			parentID int64
+		)
+		switch req.Action {
+		case "":
+			// 提问在检索、生成和保存历史之前脱敏，日志中也只记录脱敏后的内容
+			req.Question, report = a.domain.Redactor.Redact(req.Question)
+			if len(report) > 0 {
+				a.Logger.Infof("提问已脱敏: %s", report)
+			}
+		case domain.AskActionStop:
+			a.Logger.Infof("会话 %v 没有生成中的回答，忽略停止指令", sessionID)
+			continue
+		case domain.AskActionRegenerate:
+			regen, id, err := a.regenerateRequest(sessionID, last, lastID, req)
+			if err != nil {
+				a.Logger.Errorf("重新生成回答失败: %v", err)
+				return fmt.Errorf("重新生成回答失败: %v", err)
+			}
+			if regen == nil {
+				if err := a.sendError(stream, sessionID, domain.CodeInvalidAction, "会话中没有可以重新生成的问题"); err != nil {
+					return err
+				}
+				continue
+			}
+			req, parentID = regen, id
+		default:
+			if err := a.sendError(stream, sessionID, domain.CodeInvalidAction, fmt.Sprintf("不支持的指令: %s", req.Action)); err != nil {
+				return err
+			}
+			continue
+		}
+
 		a.Logger.Infof("成功接收请求: %v", req)
 
-		// 3.2 构建上下文
-		content, err := a.domain.BuildContext(a.ctx, buf, req)
-		if err != nil {
-			a.Logger.Errorf("构建上下文失败: %v", err)
-			return fmt.Errorf("构建上下文失败: %v", err)
+		// 3.2 检查 token 配额，超出时返回错误帧，不中断流
+		if err := a.usage.CheckQuota(a.ctx, uid, time.Now()); err != nil {
+			if !errors.Is(err, domain.ErrQuotaExceeded) {
+				a.Logger.Errorf("检查 token 配额失败: %v", err)
+				return fmt.Errorf("检查 token 配额失败: %v", err)
+			}
+			if err := a.sendError(stream, sessionID, domain.CodeQuotaExceeded, err.Error()); err != nil {
+				return err
+			}
+			continue
 		}
 
-		// 3.3 生成回答 & 流式发送
-		completion, err := a.svcCtx.LLM.GenerateContent(a.ctx, content,
-			llms.WithStreamingFunc(func(ctx context.Context, chunk []byte) error {
-				if err := stream.Send(&types.AskQuestionResponse{
-					Code:    0,
-					Message: "success",
-					Data:    &types.AskQuestionResponse_AnswerData{Answer: string(chunk), SessionId: sessionID},
-				}); err != nil {
-					a.Logger.Errorf("发送响应失败: %v", err)
-					return fmt.Errorf("发送响应失败: %v", err)
-				}
+		// 3.3 构建上下文
+		tpl, err := a.prompt.Resolve(a.ctx, domain.PromptFeatureChat, req.PromptTemplate)
+		if err != nil {
+			a.Logger.Errorf("获取提示词模板失败: %v", err)
+			return fmt.Errorf("获取提示词模板失败: %v", err)
+		}
 
-				return nil
-			}))
+		scope, err := a.readableScope(req.KnowledgeBases)
 		if err != nil {
-			a.Logger.Errorf("生成回答失败: %v", err)
-			return fmt.Errorf("生成回答失败: %v", err)
+			a.Logger.Errorf("获取知识库失败: %v", err)
+			return fmt.Errorf("获取知识库失败: %v", err)
 		}
 
-		// 3.4 保存对话历史
-		if err := a.domain.SaveHistory(a.ctx,
-			req.Question,
-			completion.Choices[0].Content,
+		// 3.4 命中语义缓存时直接发送缓存的回答，否则检索文档、生成回答并流式发送；重新生成时不使用缓存
+		var (
+			answer    = domain.Answer{ParentID: parentID}
+			citations []*types.Citation
+			usage     domain.TokenUsage
+			lookup    *domain.CacheLookup
+		)
+		if parentID == 0 {
+			lookup = a.lookupCache(mem, req, tpl, scope)
+		}
+		if lookup != nil && lookup.Hit {
+			a.Logger.Infof("命中语义缓存: %v", sessionID)
+			answer.Content, citations = lookup.Answer, lookup.Citations
+			if err := stream.Send(&types.AskQuestionResponse{
+				Code:    0,
+				Message: "success",
+				Data:    &types.AskQuestionResponse_AnswerData{SessionId: sessionID, Answer: answer.Content},
+			}); err != nil {
+				a.Logger.Errorf("发送响应失败: %v", err)
+				return fmt.Errorf("发送响应失败: %v", err)
+			}
+		} else {
+			history, err := a.memoryHistory(mem, req.Question, parentID)
+			if err != nil {
+				a.Logger.Errorf("获取历史记录失败: %v", err)
+				return fmt.Errorf("获取历史记录失败: %v", err)
+			}
+
+			content, docs, err := a.domain.BuildContext(a.ctx, uid, history, req, tpl, scope)
+			if err != nil {
+				a.Logger.Errorf("构建上下文失败: %v", err)
+				return fmt.Errorf("构建上下文失败: %v", err)
+			}
+
+			// 生成期间收到 stop 指令时取消生成，保存已生成的部分
+			genCtx, done := in.WatchStop(a.ctx)
+			answer.Content, usage, err = a.generate(genCtx, stream, uid, sessionID, req, content)
+			stopped := done()
+			if err != nil {
+				if !stopped {
+					a.Logger.Errorf("生成回答失败: %v", err)
+					return fmt.Errorf("生成回答失败: %v", err)
+				}
+				a.Logger.Infof("会话 %v 的回答已停止", sessionID)
+				answer.Truncated = true
+			}
+			citations = a.domain.BuildCitations(docs)
+			if !answer.Truncated {
+				a.domain.Cache.Store(lookup, answer.Content, citations)
+			}
+		}
+
+		// 3.5 保存对话历史及 token 用量
+		historyID, err := a.domain.SaveHistory(a.ctx,
+			req,
+			answer,
+			usage,
 			session,
-		); err != nil {
+		)
+		if err != nil {
 			a.Logger.Errorf("保存对话历史失败: %v", err)
 			return fmt.Errorf("保存对话历史失败: %v", err)
 		}
+		last, lastID = req, historyID
+		if parentID > 0 {
+			lastID = parentID
+		}
+
+		// 3.6 发送最后一帧，附带引用来源和问答记录ID
+		if err := stream.Send(&types.AskQuestionResponse{
+			Code:    0,
+			Message: "success",
+			Data: &types.AskQuestionResponse_AnswerData{
+				SessionId:  sessionID,
+				Finished:   true,
+				Citations:  citations,
+				HistoryId:  historyID,
+				Redactions: domain.BuildRedactions(report),
+				Truncated:  answer.Truncated,
+				ParentId:   parentID,
+			},
+		}); err != nil {
+			a.Logger.Errorf("发送引用来源失败: %v", err)
+			return fmt.Errorf("发送引用来源失败: %v", err)
+		}
 
 		a.Logger.Infof("成功生成对话: %v", sessionID)
 	}
 }
+
+// sendError 发送错误帧，不中断流
+func (a *AIHelperLogic) sendError(stream types.AIHelper_AskQuestionServer, sessionID string, code int32, message string) error {
+	if err := stream.Send(&types.AskQuestionResponse{
+		Code:    code,
+		Message: message,
+		Data:    &types.AskQuestionResponse_AnswerData{SessionId: sessionID, Finished: true},
+	}); err != nil {
+		a.Logger.Errorf("发送响应失败: %v", err)
+		return fmt.Errorf("发送响应失败: %v", err)
+	}
+
+	return nil
+}
+
+// regenerateRequest 重新生成沿用上一个问题及其检索参数，只替换采样参数，返回的ID为原问答记录ID；
+// 本流中还没有提问时（如重连后）取会话的最后一个问题，历史记录中没有保存的知识库、模板等参数取自本次请求。
+// 会话中没有问题时返回 nil
+func (a *AIHelperLogic) regenerateRequest(sessionID string, last *types.AskQuestionRequest, lastID int64, req *types.AskQuestionRequest) (*types.AskQuestionRequest, int64, error) {
+	if last != nil {
+		regen := proto.Clone(last).(*types.AskQuestionRequest)
+		regen.Temperature, regen.TopP = req.Temperature, req.TopP
+		return regen, lastID, nil
+	}
+
+	h, err := a.domain.HistoryRepo.GetLastQuestion(a.ctx, sessionID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("获取上一个问题失败: %w", err)
+	}
+	if h == nil {
+		return nil, 0, nil
+	}
+
+	return &types.AskQuestionRequest{
+		Title:          req.Title,
+		Question:       h.Question,
+		SessionId:      sessionID,
+		ScoreThreshold: h.ScoreThreshold,
+		TopK:           int32(h.TopK),
+		RetrievalMode:  h.RetrievalMode,
+		Rerank:         h.Rerank,
+		Agent:          req.Agent,
+		PromptTemplate: req.PromptTemplate,
+		KnowledgeBases: req.KnowledgeBases,
+		Temperature:    req.Temperature,
+		TopP:           req.TopP,
+	}, h.ID, nil
+}
+
+// memoryHistory 获取会话记忆，重新生成时去掉被替换的那一轮，避免大模型参考原回答
+func (a *AIHelperLogic) memoryHistory(mem *pkg.SessionMemory, question string, parentID int64) (string, error) {
+	if parentID > 0 {
+		return mem.HistoryBefore(a.ctx, question)
+	}
+	return mem.History(a.ctx)
+}
+
+// lookupCache 查找语义缓存，只对会话的首个提问生效：追问依赖上下文，不能复用其他会话的回答；
+// 查找失败时按未命中处理，不影响提问
+func (a *AIHelperLogic) lookupCache(mem *pkg.SessionMemory, req *types.AskQuestionRequest, tpl *model.PromptTemplate, scope *domain.KnowledgeScope) *domain.CacheLookup {
+	history, err := mem.History(a.ctx)
+	if err != nil || history != "" {
+		return nil
+	}
+
+	lookup, err := a.domain.Cache.Lookup(a.ctx, req, tpl, scope)
+	if err != nil {
+		a.Logger.Errorf("查找语义缓存失败: %v", err)
+		return nil
+	}
+
+	return lookup
+}
+
+// generate 生成回答并流式发送；Agent 模式下先推送工具调用与结果帧，最终回答作为一帧发送。
+// ctx 被取消时返回已生成的部分及错误
+func (a *AIHelperLogic) generate(ctx context.Context, stream types.AIHelper_AskQuestionServer, uid int64, sessionID string, req *types.AskQuestionRequest, content []llms.MessageContent) (string, domain.TokenUsage, error) {
+	usage := domain.TokenUsage{Model: a.svcCtx.Config.LLM.Model}
+
+	send := func(data *types.AskQuestionResponse_AnswerData) error {
+		data.SessionId = sessionID
+		if err := stream.Send(&types.AskQuestionResponse{
+			Code:    0,
+			Message: "success",
+			Data:    data,
+		}); err != nil {
+			a.Logger.Errorf("发送响应失败: %v", err)
+			return fmt.Errorf("发送响应失败: %v", err)
+		}
+
+		return nil
+	}
+
+	if req.Agent {
+		answer, agentUsage, err := a.newAgentDomain().Run(ctx, uid, content, func(e domain.AgentEvent) error {
+			return send(&types.AskQuestionResponse_AnswerData{ToolCall: e.ToolCall, ToolResult: e.ToolResult})
+		})
+		if err != nil {
+			usage.Estimate(content, "")
+			return "", usage, err
+		}
+		usage.PromptTokens, usage.CompletionTokens = agentUsage.PromptTokens, agentUsage.CompletionTokens
+		usage.Estimate(content, answer)
+
+		return answer, usage, send(&types.AskQuestionResponse_AnswerData{Answer: answer})
+	}
+
+	var partial strings.Builder
+	opts := []llms.CallOption{
+		llms.WithStreamingFunc(func(ctx context.Context, chunk []byte) error {
+			partial.Write(chunk)
+			return send(&types.AskQuestionResponse_AnswerData{Answer: string(chunk)})
+		}),
+	}
+	if req.Temperature > 0 {
+		opts = append(opts, llms.WithTemperature(float64(req.Temperature)))
+	}
+	if req.TopP > 0 {
+		opts = append(opts, llms.WithTopP(float64(req.TopP)))
+	}
+
+	completion, err := a.svcCtx.LLM.GenerateContent(ctx, content, opts...)
+	if err != nil {
+		usage.Estimate(content, partial.String())
+		return partial.String(), usage, err
+	}
+
+	answer := completion.Choices[0].Content
+	usage.Add(completion)
+	usage.Estimate(content, answer)
+
+	return answer, usage, nil
+}
+
+// SubmitFeedback 对回答提交反馈
+func (a *AIHelperLogic) SubmitFeedback(req *types.SubmitFeedbackRequest) (*types.SubmitFeedbackResponse, error) {
+	uid, _, err := a.domain.CheckSession(a.ctx)
+	if err != nil {
+		a.Logger.Errorf("提交反馈失败: %v", err)
+		return nil, fmt.Errorf("提交反馈失败: %v", err)
+	}
+
+	if err := a.domain.SubmitFeedback(a.ctx, uid, req.HistoryId, int(req.Rating), req.Comment); err != nil {
+		a.Logger.Errorf("提交反馈失败: %v", err)
+		return nil, fmt.Errorf("提交反馈失败: %v", err)
+	}
+
+	return &types.SubmitFeedbackResponse{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+// GetUsageStats 查询 token 用量
+func (a *AIHelperLogic) GetUsageStats(req *types.GetUsageStatsRequest) (*types.GetUsageStatsResponse, error) {
+	uid, _, err := a.domain.CheckSession(a.ctx)
+	if err != nil {
+		a.Logger.Errorf("查询 token 用量失败: %v", err)
+		return nil, fmt.Errorf("查询 token 用量失败: %v", err)
+	}
+
+	stats, quota, err := a.usage.GetUsageStats(a.ctx, uid, req.UserId, req.StartDate, req.EndDate, time.Now())
+	if err != nil {
+		a.Logger.Errorf("查询 token 用量失败: %v", err)
+		return nil, fmt.Errorf("查询 token 用量失败: %v", err)
+	}
+
+	return &types.GetUsageStatsResponse{
+		Code:    0,
+		Message: "success",
+		Data:    stats,
+		Quota:   quota,
+	}, nil
+}
+
+// newAgentDomain 注册已配置下游服务对应的工具
+func (a *AIHelperLogic) newAgentDomain() *domain.AgentDomain {
+	tools := domain.NewChatHistoryTools(a.domain.HistorySessionRepo, a.domain.HistoryRepo)
+	if a.svcCtx.PrometheusRpc != nil {
+		tools = append(tools, domain.NewAlertRuleTool(a.svcCtx.PrometheusRpc))
+	}
+	if a.svcCtx.TreeRpc != nil {
+		tools = append(tools, domain.NewTreeNodeTool(a.svcCtx.TreeRpc))
+	}
+	if a.svcCtx.EcsRpc != nil {
+		tools = append(tools, domain.NewNodeInstanceTool(a.svcCtx.EcsRpc))
+	}
+
+	c := a.svcCtx.Config.Agent
+	return domain.NewAgentDomain(a.svcCtx.LLM, tools, c.ToolACL, c.MaxSteps)
+}
@@ -0,0 +1,90 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AlertRuleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	domain *domain.AlertRuleDomain
+	prompt *domain.PromptDomain
+}
+
+func NewAlertRuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlertRuleLogic {
+	var check domain.PromQLChecker
+	if svcCtx.PrometheusRpc != nil {
+		check = domain.NewPromQLRpcChecker(svcCtx.PrometheusRpc)
+	}
+
+	return &AlertRuleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		domain: domain.NewAlertRuleDomain(svcCtx.LLM, check, svcCtx.Config.AlertRule.MaxAttempts),
+		prompt: domain.NewPromptDomain(svcCtx.DB, svcCtx.Config.Admins),
+	}
+}
+
+// GenerateAlertRule 根据自然语言描述生成告警规则，返回的规则已通过 PromQL 校验，可直接提交创建
+func (l *AlertRuleLogic) GenerateAlertRule(req *types.GenerateAlertRuleRequest) (*types.GenerateAlertRuleResponse, error) {
+	uid, err := l.domain.CheckSession(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("生成告警规则失败: %v", err)
+		return nil, fmt.Errorf("生成告警规则失败: %v", err)
+	}
+
+	// 生成的表达式必须经过 prometheus 服务校验，未配置时不提供该功能
+	if l.svcCtx.PrometheusRpc == nil {
+		return nil, fmt.Errorf("未配置 PrometheusRpc，无法校验 PromQL")
+	}
+
+	tpl, err := l.prompt.Resolve(l.ctx, domain.PromptFeatureAlertRule, req.PromptTemplate)
+	if err != nil {
+		l.Logger.Errorf("获取提示词模板失败: %v", err)
+		return nil, fmt.Errorf("获取提示词模板失败: %v", err)
+	}
+
+	result, err := l.domain.GenerateAlertRule(l.ctx, uid, &domain.GenerateAlertRuleParams{
+		Description: req.Description,
+		Name:        req.Name,
+		PoolID:      req.PoolId,
+		SendGroupID: req.SendGroupId,
+		TreeNodeID:  req.TreeNodeId,
+	}, tpl)
+	if err != nil {
+		l.Logger.Errorf("生成告警规则失败: %v", err)
+		return nil, fmt.Errorf("生成告警规则失败: %v", err)
+	}
+
+	rule := result.Rule
+	return &types.GenerateAlertRuleResponse{
+		Code:    0,
+		Message: "success",
+		Data: &types.GenerateAlertRuleResponse_GeneratedData{
+			Rule: &types.AlertRuleDraft{
+				Name:        rule.Name,
+				UserId:      rule.UserId,
+				PoolId:      rule.PoolId,
+				SendGroupId: rule.SendGroupId,
+				TreeNodeId:  rule.TreeNodeId,
+				Enable:      rule.Enable,
+				Expr:        rule.Expr,
+				Severity:    rule.Severity,
+				ForDuration: rule.ForDuration,
+				Labels:      rule.Labels,
+				Annotations: rule.Annotations,
+			},
+			Explanation: result.Explanation,
+			Attempts:    int32(result.Attempts),
+		},
+	}, nil
+}
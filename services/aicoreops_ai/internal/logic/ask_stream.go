@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// askStream 在后台接收提问流中的请求，生成回答期间也能及时收到 stop 指令
+type askStream struct {
+	types.AIHelper_AskQuestionServer
+	reqs chan *types.AskQuestionRequest
+	// err 流结束的原因，reqs 关闭前写入
+	err error
+	// pending 生成回答期间收到的其他请求，生成结束后依次处理
+	pending []*types.AskQuestionRequest
+}
+
+func newAskStream(stream types.AIHelper_AskQuestionServer) *askStream {
+	s := &askStream{
+		AIHelper_AskQuestionServer: stream,
+		reqs:                       make(chan *types.AskQuestionRequest),
+	}
+	threading.GoSafe(s.receive)
+
+	return s
+}
+
+func (s *askStream) receive() {
+	defer close(s.reqs)
+
+	for {
+		req, err := s.Recv()
+		if err != nil {
+			s.err = err
+			return
+		}
+
+		select {
+		case s.reqs <- req:
+		case <-s.Context().Done():
+			s.err = s.Context().Err()
+			return
+		}
+	}
+}
+
+// Next 获取下一个请求，流结束时返回 Recv 的错误，客户端正常关闭时为 io.EOF
+func (s *askStream) Next() (*types.AskQuestionRequest, error) {
+	if len(s.pending) > 0 {
+		req := s.pending[0]
+		s.pending = s.pending[1:]
+		return req, nil
+	}
+
+	req, ok := <-s.reqs
+	if !ok {
+		return nil, s.err
+	}
+	return req, nil
+}
+
+// WatchStop 返回生成回答使用的 ctx，收到 stop 指令时取消。调用返回的 done 结束监听，结果表示是否收到过 stop 指令
+func (s *askStream) WatchStop(ctx context.Context) (context.Context, func() bool) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	var stopped bool
+	finished, exited := make(chan struct{}), make(chan struct{})
+	threading.GoSafe(func() {
+		defer close(exited)
+
+		for {
+			select {
+			case req, ok := <-s.reqs:
+				if !ok {
+					// 客户端关闭发送方向后仍可接收本轮回答
+					return
+				}
+				if req.Action == domain.AskActionStop {
+					stopped = true
+					cancel()
+					continue
+				}
+				s.pending = append(s.pending, req)
+			case <-finished:
+				return
+			}
+		}
+	})
+
+	return ctx, func() bool {
+		close(finished)
+		<-exited
+		cancel()
+		return stopped
+	}
+}
@@ -1 +1,119 @@
 package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AutoFixLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	domain *domain.AutoFixDomain
+	prompt *domain.PromptDomain
+}
+
+func NewAutoFixLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AutoFixLogic {
+	// 避免将 nil 指针包装成非 nil 接口
+	var (
+		client   kubernetes.Interface
+		executor domain.PodExecutor
+	)
+	if svcCtx.K8s != nil {
+		client, executor = svcCtx.K8s, svcCtx.K8s
+	}
+
+	return &AutoFixLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		domain: domain.NewAutoFixDomain(svcCtx.DB, client, executor,
+			domain.WithApprovers(svcCtx.Config.AutoFix.Approvers),
+			domain.WithApprovers(svcCtx.Config.Admins),
+			domain.WithSelfApprove(svcCtx.Config.AutoFix.SelfApprove),
+			domain.WithExecuteTimeout(svcCtx.Config.AutoFix.ExecuteTimeout),
+			domain.WithExplainer(svcCtx.LLM),
+		),
+		prompt: domain.NewPromptDomain(svcCtx.DB, svcCtx.Config.Admins),
+	}
+}
+
+// FixTask 提交修复任务，dry run 时仅返回计划变更
+func (l *AutoFixLogic) FixTask(req *types.FixTaskRequest) (*types.FixTaskResponse, error) {
+	uid, err := l.domain.CheckSession(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("提交修复任务失败: %v", err)
+		return nil, fmt.Errorf("提交修复任务失败: %v", err)
+	}
+	if strings.TrimSpace(req.PodId) == "" {
+		return nil, fmt.Errorf("Pod ID 不能为空")
+	}
+
+	tpl, err := l.prompt.Resolve(l.ctx, domain.PromptFeatureAutoFix, req.PromptTemplate)
+	if err != nil {
+		l.Logger.Errorf("获取提示词模板失败: %v", err)
+		return nil, fmt.Errorf("获取提示词模板失败: %v", err)
+	}
+
+	run, err := l.domain.SubmitFixTask(l.ctx, uid, req.Task, req.PodId, req.Params, req.DryRun, tpl)
+	if err != nil {
+		l.Logger.Errorf("提交修复任务失败: %v", err)
+		return nil, fmt.Errorf("提交修复任务失败: %v", err)
+	}
+
+	return &types.FixTaskResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildFixRunRespModel(run),
+	}, nil
+}
+
+// GetFixRun 查询修复任务执行状态
+func (l *AutoFixLogic) GetFixRun(req *types.GetFixRunRequest) (*types.GetFixRunResponse, error) {
+	uid, err := l.domain.CheckSession(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("获取修复任务失败: %v", err)
+		return nil, fmt.Errorf("获取修复任务失败: %v", err)
+	}
+
+	run, err := l.domain.GetFixRun(l.ctx, uid, req.RunId)
+	if err != nil {
+		l.Logger.Errorf("获取修复任务失败: %v", err)
+		return nil, fmt.Errorf("获取修复任务失败: %v", err)
+	}
+
+	return &types.GetFixRunResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildFixRunRespModel(run),
+	}, nil
+}
+
+// ApproveFixRun 审批修复任务
+func (l *AutoFixLogic) ApproveFixRun(req *types.ApproveFixRunRequest) (*types.ApproveFixRunResponse, error) {
+	uid, err := l.domain.CheckSession(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("审批修复任务失败: %v", err)
+		return nil, fmt.Errorf("审批修复任务失败: %v", err)
+	}
+
+	run, err := l.domain.ApproveFixRun(l.ctx, uid, req.RunId, req.Approved, req.Comment)
+	if err != nil {
+		l.Logger.Errorf("审批修复任务失败: %v", err)
+		return nil, fmt.Errorf("审批修复任务失败: %v", err)
+	}
+
+	return &types.ApproveFixRunResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildFixRunRespModel(run),
+	}, nil
+}
@@ -0,0 +1,123 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type KnowledgeBaseLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	domain *domain.KnowledgeBaseDomain
+}
+
+func NewKnowledgeBaseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *KnowledgeBaseLogic {
+	return &KnowledgeBaseLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		domain: domain.NewKnowledgeBaseDomain(svcCtx.DB, svcCtx.Config.Admins),
+	}
+}
+
+// CreateKnowledgeBase 创建知识库
+func (l *KnowledgeBaseLogic) CreateKnowledgeBase(req *types.CreateKnowledgeBaseRequest) (*types.CreateKnowledgeBaseResponse, error) {
+	p, err := l.domain.CheckPrincipal(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("创建知识库失败: %v", err)
+		return nil, fmt.Errorf("创建知识库失败: %v", err)
+	}
+
+	kb, err := l.domain.CreateKnowledgeBase(l.ctx, p, &model.KnowledgeBase{
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerTeam:   req.OwnerTeam,
+		TreeNodeID:  req.TreeNodeId,
+		Public:      req.Public,
+	}, req.Readers, req.Writers)
+	if err != nil {
+		l.Logger.Errorf("创建知识库失败: %v", err)
+		return nil, fmt.Errorf("创建知识库失败: %v", err)
+	}
+
+	return &types.CreateKnowledgeBaseResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildKnowledgeBaseRespModel(p, kb),
+	}, nil
+}
+
+// UpdateKnowledgeBase 更新知识库
+func (l *KnowledgeBaseLogic) UpdateKnowledgeBase(req *types.UpdateKnowledgeBaseRequest) (*types.UpdateKnowledgeBaseResponse, error) {
+	p, err := l.domain.CheckPrincipal(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("更新知识库失败: %v", err)
+		return nil, fmt.Errorf("更新知识库失败: %v", err)
+	}
+
+	kb, err := l.domain.UpdateKnowledgeBase(l.ctx, p, &model.KnowledgeBase{
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerTeam:   req.OwnerTeam,
+		TreeNodeID:  req.TreeNodeId,
+		Public:      req.Public,
+	}, req.Readers, req.Writers)
+	if err != nil {
+		l.Logger.Errorf("更新知识库失败: %v", err)
+		return nil, fmt.Errorf("更新知识库失败: %v", err)
+	}
+
+	return &types.UpdateKnowledgeBaseResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildKnowledgeBaseRespModel(p, kb),
+	}, nil
+}
+
+// ListKnowledgeBases 获取有权读取的知识库列表
+func (l *KnowledgeBaseLogic) ListKnowledgeBases(req *types.ListKnowledgeBasesRequest) (*types.ListKnowledgeBasesResponse, error) {
+	p, err := l.domain.CheckPrincipal(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("获取知识库列表失败: %v", err)
+		return nil, fmt.Errorf("获取知识库列表失败: %v", err)
+	}
+
+	kbs, err := l.domain.ListKnowledgeBases(l.ctx, p, req.TreeNodeId)
+	if err != nil {
+		l.Logger.Errorf("获取知识库列表失败: %v", err)
+		return nil, fmt.Errorf("获取知识库列表失败: %v", err)
+	}
+
+	return &types.ListKnowledgeBasesResponse{
+		Code:    0,
+		Message: "success",
+		Data:    kbs,
+	}, nil
+}
+
+// DeleteKnowledgeBase 删除知识库
+func (l *KnowledgeBaseLogic) DeleteKnowledgeBase(req *types.DeleteKnowledgeBaseRequest) (*types.DeleteKnowledgeBaseResponse, error) {
+	p, err := l.domain.CheckPrincipal(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("删除知识库失败: %v", err)
+		return nil, fmt.Errorf("删除知识库失败: %v", err)
+	}
+
+	if err := l.domain.DeleteKnowledgeBase(l.ctx, p, req.Name); err != nil {
+		l.Logger.Errorf("删除知识库失败: %v", err)
+		return nil, fmt.Errorf("删除知识库失败: %v", err)
+	}
+
+	return &types.DeleteKnowledgeBaseResponse{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
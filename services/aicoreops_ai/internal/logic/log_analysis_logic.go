@@ -1 +1,79 @@
 package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogAnalysisLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	domain *domain.LogAnalysisDomain
+	prompt *domain.PromptDomain
+}
+
+func NewLogAnalysisLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogAnalysisLogic {
+	return &LogAnalysisLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		domain: domain.NewLogAnalysisDomain(svcCtx.LLM),
+		prompt: domain.NewPromptDomain(svcCtx.DB, svcCtx.Config.Admins),
+	}
+}
+
+// AnalyzeLogs 分析日志，返回结构化的诊断结果
+func (l *LogAnalysisLogic) AnalyzeLogs(req *types.AnalyzeLogsRequest) (*types.AnalyzeLogsResponse, error) {
+	if strings.TrimSpace(req.LogData) == "" {
+		return nil, fmt.Errorf("日志内容不能为空")
+	}
+
+	tpl, err := l.prompt.Resolve(l.ctx, domain.PromptFeatureLogAnalysis, req.PromptTemplate)
+	if err != nil {
+		l.Logger.Errorf("获取提示词模板失败: %v", err)
+		return nil, fmt.Errorf("获取提示词模板失败: %v", err)
+	}
+
+	// 日志在聚类和发送给大模型之前脱敏，返回的模板和样例也不包含原文
+	logData, report := l.svcCtx.Redactor.Redact(req.LogData)
+	if len(report) > 0 {
+		l.Logger.Infof("日志已脱敏: %s", report)
+	}
+
+	result, err := l.domain.AnalyzeLogs(l.ctx, logData, int(req.WindowTokens), tpl)
+	if err != nil {
+		l.Logger.Errorf("分析日志失败: %v", err)
+		return nil, fmt.Errorf("分析日志失败: %v", err)
+	}
+
+	templates := make([]*types.AnalyzeLogsResponse_LogTemplate, 0, len(result.Templates))
+	for _, t := range result.Templates {
+		templates = append(templates, &types.AnalyzeLogsResponse_LogTemplate{
+			Template: t.Template,
+			Count:    int32(t.Count),
+			Sample:   t.Sample,
+		})
+	}
+
+	return &types.AnalyzeLogsResponse{
+		Code:    0,
+		Message: "success",
+		Data: &types.AnalyzeLogsResponse_SuggestionData{
+			RootCause:          result.RootCause,
+			AffectedComponents: result.AffectedComponents,
+			Severity:           result.Severity,
+			RemediationSteps:   result.RemediationSteps,
+			Templates:          templates,
+			Windows:            int32(result.Windows),
+			Redactions:         domain.BuildRedactions(report),
+		},
+	}, nil
+}
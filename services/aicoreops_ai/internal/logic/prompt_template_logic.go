@@ -0,0 +1,142 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PromptTemplateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	domain *domain.PromptDomain
+}
+
+func NewPromptTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PromptTemplateLogic {
+	return &PromptTemplateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		domain: domain.NewPromptDomain(svcCtx.DB, svcCtx.Config.Admins),
+	}
+}
+
+// CreatePromptTemplate 创建提示词模板
+func (l *PromptTemplateLogic) CreatePromptTemplate(req *types.CreatePromptTemplateRequest) (*types.CreatePromptTemplateResponse, error) {
+	uid, err := l.checkAdmin()
+	if err != nil {
+		l.Logger.Errorf("创建提示词模板失败: %v", err)
+		return nil, fmt.Errorf("创建提示词模板失败: %v", err)
+	}
+
+	tpl, err := l.domain.CreatePromptTemplate(l.ctx, uid, &model.PromptTemplate{
+		Name:            req.Name,
+		Feature:         req.Feature,
+		Description:     req.Description,
+		SystemPrompt:    req.SystemPrompt,
+		ContextTemplate: req.ContextTemplate,
+	})
+	if err != nil {
+		l.Logger.Errorf("创建提示词模板失败: %v", err)
+		return nil, fmt.Errorf("创建提示词模板失败: %v", err)
+	}
+
+	return &types.CreatePromptTemplateResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildPromptTemplateRespModel(tpl),
+	}, nil
+}
+
+// UpdatePromptTemplate 更新提示词模板，生成新版本
+func (l *PromptTemplateLogic) UpdatePromptTemplate(req *types.UpdatePromptTemplateRequest) (*types.UpdatePromptTemplateResponse, error) {
+	uid, err := l.checkAdmin()
+	if err != nil {
+		l.Logger.Errorf("更新提示词模板失败: %v", err)
+		return nil, fmt.Errorf("更新提示词模板失败: %v", err)
+	}
+
+	tpl, err := l.domain.UpdatePromptTemplate(l.ctx, uid, req.Name, req.Description, req.SystemPrompt, req.ContextTemplate)
+	if err != nil {
+		l.Logger.Errorf("更新提示词模板失败: %v", err)
+		return nil, fmt.Errorf("更新提示词模板失败: %v", err)
+	}
+
+	return &types.UpdatePromptTemplateResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildPromptTemplateRespModel(tpl),
+	}, nil
+}
+
+// GetPromptTemplate 获取提示词模板
+func (l *PromptTemplateLogic) GetPromptTemplate(req *types.GetPromptTemplateRequest) (*types.GetPromptTemplateResponse, error) {
+	tpl, err := l.domain.GetPromptTemplate(l.ctx, req.Name, int(req.Version))
+	if err != nil {
+		l.Logger.Errorf("获取提示词模板失败: %v", err)
+		return nil, fmt.Errorf("获取提示词模板失败: %v", err)
+	}
+
+	return &types.GetPromptTemplateResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildPromptTemplateRespModel(tpl),
+	}, nil
+}
+
+// ListPromptTemplates 获取提示词模板列表
+func (l *PromptTemplateLogic) ListPromptTemplates(req *types.ListPromptTemplatesRequest) (*types.ListPromptTemplatesResponse, error) {
+	limit := req.PageSize
+	offset := (req.Page - 1) * req.PageSize
+
+	templates, total, err := l.domain.ListPromptTemplates(l.ctx, req.Feature, int(limit), int(offset))
+	if err != nil {
+		l.Logger.Errorf("获取提示词模板列表失败: %v", err)
+		return nil, fmt.Errorf("获取提示词模板列表失败: %v", err)
+	}
+
+	data := make([]*types.PromptTemplate, 0, len(templates))
+	for _, tpl := range templates {
+		data = append(data, l.domain.BuildPromptTemplateRespModel(tpl))
+	}
+
+	return &types.ListPromptTemplatesResponse{
+		Code:    0,
+		Message: "success",
+		Data:    data,
+		Total:   total,
+	}, nil
+}
+
+// DeletePromptTemplate 删除提示词模板
+func (l *PromptTemplateLogic) DeletePromptTemplate(req *types.DeletePromptTemplateRequest) (*types.DeletePromptTemplateResponse, error) {
+	if _, err := l.checkAdmin(); err != nil {
+		l.Logger.Errorf("删除提示词模板失败: %v", err)
+		return nil, fmt.Errorf("删除提示词模板失败: %v", err)
+	}
+
+	if err := l.domain.DeletePromptTemplate(l.ctx, req.Name); err != nil {
+		l.Logger.Errorf("删除提示词模板失败: %v", err)
+		return nil, fmt.Errorf("删除提示词模板失败: %v", err)
+	}
+
+	return &types.DeletePromptTemplateResponse{
+		Code:    0,
+		Message: "success",
+	}, nil
+}
+
+func (l *PromptTemplateLogic) checkAdmin() (int64, error) {
+	uid, err := l.domain.CheckSession(l.ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uid, l.domain.CheckAdmin(uid)
+}
@@ -0,0 +1,90 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReindexLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+	domain *domain.ReindexDomain
+}
+
+func NewReindexLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReindexLogic {
+	c := svcCtx.Config
+	return &ReindexLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+		domain: domain.NewReindexDomain(svcCtx.DB, svcCtx.VectorIndex, pkg.NewQdrantCollectionOpener(c), svcCtx.Redactor,
+			c.Qdrant.CollectionName, pkg.EmbeddingModelName(c.LLM), c.Admins,
+			domain.WithReindexAutoStart(c.Reindex.AutoStart),
+			domain.WithReindexBatchSize(c.Reindex.BatchSize),
+			domain.WithReindexRefreshInterval(c.Reindex.RefreshInterval),
+		),
+	}
+}
+
+// Bootstrap 服务启动时切换到记录的启用集合，并检测向量模型是否变更
+func (l *ReindexLogic) Bootstrap() error {
+	if err := l.domain.Bootstrap(l.ctx); err != nil {
+		l.Logger.Errorf("初始化向量索引失败: %v", err)
+		return fmt.Errorf("初始化向量索引失败: %v", err)
+	}
+
+	return nil
+}
+
+// StartReindex 发起重建索引
+func (l *ReindexLogic) StartReindex(req *types.StartReindexRequest) (*types.StartReindexResponse, error) {
+	uid, err := l.domain.CheckSession(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("发起重建索引失败: %v", err)
+		return nil, fmt.Errorf("发起重建索引失败: %v", err)
+	}
+	if err = l.domain.CheckAdmin(uid); err != nil {
+		l.Logger.Errorf("发起重建索引失败: %v", err)
+		return nil, fmt.Errorf("发起重建索引失败: %v", err)
+	}
+
+	job, err := l.domain.StartReindex(l.ctx, uid, req.Force)
+	if err != nil {
+		l.Logger.Errorf("发起重建索引失败: %v", err)
+		return nil, fmt.Errorf("发起重建索引失败: %v", err)
+	}
+
+	return &types.StartReindexResponse{
+		Code:    0,
+		Message: "success",
+		Data:    l.domain.BuildReindexJobRespModel(job),
+	}, nil
+}
+
+// GetReindexStatus 查询启用集合与重建进度
+func (l *ReindexLogic) GetReindexStatus(req *types.GetReindexStatusRequest) (*types.GetReindexStatusResponse, error) {
+	if _, err := l.domain.CheckSession(l.ctx); err != nil {
+		l.Logger.Errorf("查询重建索引进度失败: %v", err)
+		return nil, fmt.Errorf("查询重建索引进度失败: %v", err)
+	}
+
+	status, err := l.domain.GetStatus(l.ctx, req.JobId)
+	if err != nil {
+		l.Logger.Errorf("查询重建索引进度失败: %v", err)
+		return nil, fmt.Errorf("查询重建索引进度失败: %v", err)
+	}
+
+	return &types.GetReindexStatusResponse{
+		Code:    0,
+		Message: "success",
+		Data:    status,
+	}, nil
+}
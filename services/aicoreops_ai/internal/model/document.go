@@ -0,0 +1,21 @@
+package model
+
+type Document struct {
+	ID              int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:主键ID"`
+	DocID           string `json:"doc_id" gorm:"size:64;uniqueIndex;comment:文档ID"`
+	Title           string `json:"title" gorm:"size:255;comment:文档标题"`
+	OwnerID         int64  `json:"owner_id" gorm:"index;comment:上传者ID"`
+	KnowledgeBaseID int64  `json:"knowledge_base_id" gorm:"index;uniqueIndex:uk_document_kb_hash,priority:1;default:0;comment:所属知识库ID，0 为公共知识库"`
+	ContentHash     string `json:"content_hash" gorm:"size:64;uniqueIndex:uk_document_kb_hash,priority:2;comment:内容哈希，同一知识库中未删除的文档唯一"`
+	Format          string `json:"format" gorm:"size:32;comment:文档格式"`
+	ChunkCount      int    `json:"chunk_count" gorm:"comment:分块数量"`
+	Content         string `json:"content" gorm:"type:longtext;comment:文档原文"`
+
+	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
+	DeletedAt int64 `json:"deleted_at" gorm:"uniqueIndex:uk_document_kb_hash,priority:3;default:0;comment:删除时间（毫秒），0 表示未删除"`
+}
+
+func (m *Document) TableName() string {
+	return "document"
+}
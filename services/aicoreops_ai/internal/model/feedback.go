@@ -0,0 +1,17 @@
+package model
+
+// Feedback 用户对回答的评价，同一用户对同一条回答只保留最新一次
+type Feedback struct {
+	ID        int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:主键ID"`
+	HistoryID int64  `json:"history_id" gorm:"uniqueIndex:idx_feedback_history_user;comment:问答记录ID"`
+	UserID    int64  `json:"user_id" gorm:"uniqueIndex:idx_feedback_history_user;comment:用户ID"`
+	Rating    int    `json:"rating" gorm:"comment:评分"`
+	Comment   string `json:"comment" gorm:"size:2000;comment:补充说明"`
+
+	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (m *Feedback) TableName() string {
+	return "feedback"
+}
@@ -0,0 +1,27 @@
+package model
+
+type FixRun struct {
+	ID          int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:主键ID"`
+	RunID       string `json:"run_id" gorm:"size:64;uniqueIndex;comment:执行记录ID"`
+	Task        string `json:"task" gorm:"size:64;comment:修复任务类型"`
+	Namespace   string `json:"namespace" gorm:"size:253;comment:命名空间"`
+	PodName     string `json:"pod_name" gorm:"size:253;comment:Pod名称"`
+	Params      string `json:"params" gorm:"type:text;comment:修复参数(JSON)"`
+	DryRun      bool   `json:"dry_run" gorm:"comment:是否仅生成计划"`
+	Plan        string `json:"plan" gorm:"type:text;comment:计划变更(JSON)"`
+	Diff        string `json:"diff" gorm:"type:text;comment:计划变更文本"`
+	Explanation string `json:"explanation" gorm:"type:text;comment:计划说明"`
+	Status      string `json:"status" gorm:"size:32;index;comment:状态"`
+	Message     string `json:"message" gorm:"type:text;comment:审批意见或执行结果"`
+	CreatorID   int64  `json:"creator_id" gorm:"index;comment:发起人ID"`
+	ApproverID  int64  `json:"approver_id" gorm:"comment:审批人ID"`
+	ApprovedAt  int64  `json:"approved_at" gorm:"comment:审批时间"`
+	FinishedAt  int64  `json:"finished_at" gorm:"comment:执行结束时间"`
+
+	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (m *FixRun) TableName() string {
+	return "fix_run"
+}
@@ -2,15 +2,39 @@ package model
 
 type History struct {
 	ID        int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:历史ID"`
-	SessionID string `json:"session_id" gorm:"comment:会话ID"`
+	SessionID string `json:"session_id" gorm:"size:64;index;comment:会话ID"`
 	Question  string `json:"question" gorm:"comment:问题"`
 	Answer    string `json:"answer" gorm:"comment:答案"`
+	// ParentID 重新生成的备选回答指向原问答记录，0 表示原回答
+	ParentID  int64 `json:"parent_id" gorm:"index;default:0;comment:原问答记录ID"`
+	Truncated bool  `json:"truncated" gorm:"default:false;comment:回答是否被中断"`
 
-	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
+	// token 用量，用于按用户统计和配额控制
+	UserID           int64  `json:"user_id" gorm:"index:idx_history_user_created;comment:用户ID"`
+	Model            string `json:"model" gorm:"size:128;comment:模型"`
+	PromptTokens     int    `json:"prompt_tokens" gorm:"default:0;comment:输入token数"`
+	CompletionTokens int    `json:"completion_tokens" gorm:"default:0;comment:输出token数"`
+	TotalTokens      int    `json:"total_tokens" gorm:"default:0;comment:总token数"`
+
+	// 检索参数，结合用户反馈评估不同参数的效果
+	ScoreThreshold float32 `json:"score_threshold" gorm:"default:0;comment:相似度阈值"`
+	TopK           int     `json:"top_k" gorm:"default:0;comment:召回数量"`
+	RetrievalMode  string  `json:"retrieval_mode" gorm:"size:16;comment:检索模式"`
+	Rerank         bool    `json:"rerank" gorm:"default:false;comment:是否重排序"`
+	Temperature    float32 `json:"temperature" gorm:"default:0;comment:采样温度，0 为模型默认值"`
+	TopP           float32 `json:"top_p" gorm:"default:0;comment:核采样概率，0 为模型默认值"`
+
+	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;index:idx_history_user_created;comment:创建时间"`
 	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
-	DeletedAt int64 `json:"deleted_at" gorm:"comment:删除时间"`
+	DeletedAt int64 `json:"deleted_at" gorm:"default:0;comment:删除时间"`
 }
 
 func (m *History) TableName() string {
 	return "history"
 }
+
+// HistoryHit 搜索命中的问答记录及所属会话标题
+type HistoryHit struct {
+	History
+	Title string `json:"title"`
+}
@@ -2,13 +2,16 @@ package model
 
 type HistorySession struct {
 	ID        int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:历史ID"`
-	SessionID string `json:"session_id" gorm:"comment:会话ID"`
-	UserID    int64  `json:"user_id" gorm:"comment:用户ID"`
+	SessionID string `json:"session_id" gorm:"size:64;index;comment:会话ID"`
+	UserID    int64  `json:"user_id" gorm:"index;comment:用户ID"`
 	Title     string `json:"title" gorm:"comment:标题"`
+	// Summary 会话记忆接近 token 上限时由大模型压缩较早问答得到的摘要
+	Summary         string `json:"summary" gorm:"type:text;comment:会话摘要"`
+	SummarizedTurns int    `json:"summarized_turns" gorm:"default:0;comment:摘要覆盖的最早问答轮数"`
 
 	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
 	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
-	DeletedAt int64 `json:"deleted_at" gorm:"comment:删除时间"`
+	DeletedAt int64 `json:"deleted_at" gorm:"default:0;comment:删除时间"`
 }
 
 func (m *HistorySession) TableName() string {
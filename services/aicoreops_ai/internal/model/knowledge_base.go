@@ -0,0 +1,22 @@
+package model
+
+// KnowledgeBase 知识库，文档按知识库隔离读写权限
+type KnowledgeBase struct {
+	ID          int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:主键ID"`
+	Name        string `json:"name" gorm:"size:64;uniqueIndex;comment:知识库名称"`
+	Description string `json:"description" gorm:"size:255;comment:描述"`
+	OwnerTeam   string `json:"owner_team" gorm:"size:64;index;comment:所属团队(角色名)"`
+	TreeNodeID  int64  `json:"tree_node_id" gorm:"index;comment:所属服务树节点ID"`
+	Public      bool   `json:"public" gorm:"comment:是否所有用户可读"`
+	Readers     string `json:"readers" gorm:"type:text;comment:可读成员(JSON)"`
+	Writers     string `json:"writers" gorm:"type:text;comment:可写成员(JSON)"`
+	CreatorID   int64  `json:"creator_id" gorm:"index;comment:创建人ID"`
+
+	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
+	DeletedAt int64 `json:"deleted_at" gorm:"default:0;comment:删除时间"`
+}
+
+func (m *KnowledgeBase) TableName() string {
+	return "knowledge_base"
+}
@@ -0,0 +1,21 @@
+package model
+
+// PromptTemplate 提示词模板，每次修改生成一个新版本
+type PromptTemplate struct {
+	ID              int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:主键ID"`
+	Name            string `json:"name" gorm:"size:64;uniqueIndex:idx_prompt_name_version;comment:模板名称"`
+	Version         int    `json:"version" gorm:"uniqueIndex:idx_prompt_name_version;comment:版本号"`
+	Feature         string `json:"feature" gorm:"size:32;index;comment:适用功能"`
+	Description     string `json:"description" gorm:"size:255;comment:描述"`
+	SystemPrompt    string `json:"system_prompt" gorm:"type:text;comment:系统提示词模板"`
+	ContextTemplate string `json:"context_template" gorm:"type:text;comment:上下文模板"`
+	CreatorID       int64  `json:"creator_id" gorm:"comment:创建人ID"`
+
+	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
+	DeletedAt int64 `json:"deleted_at" gorm:"default:0;comment:删除时间"`
+}
+
+func (m *PromptTemplate) TableName() string {
+	return "prompt_template"
+}
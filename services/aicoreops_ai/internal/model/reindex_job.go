@@ -0,0 +1,22 @@
+package model
+
+type ReindexJob struct {
+	ID             int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:主键ID"`
+	Source         string `json:"source" gorm:"size:255;comment:原集合名称"`
+	Target         string `json:"target" gorm:"size:255;comment:新集合名称"`
+	EmbeddingModel string `json:"embedding_model" gorm:"size:255;comment:新集合使用的向量模型"`
+	Status         string `json:"status" gorm:"size:32;index;comment:状态"`
+	Total          int64  `json:"total" gorm:"comment:待重建的文档数"`
+	Processed      int64  `json:"processed" gorm:"comment:已重建的文档数"`
+	Cursor         int64  `json:"cursor" gorm:"comment:最后一个已重建文档的主键ID，用于中断后续跑"`
+	Message        string `json:"message" gorm:"type:text;comment:失败原因"`
+	CreatorID      int64  `json:"creator_id" gorm:"comment:发起人ID，0 表示启动时自动创建"`
+	FinishedAt     int64  `json:"finished_at" gorm:"comment:结束时间"`
+
+	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (m *ReindexJob) TableName() string {
+	return "reindex_job"
+}
@@ -0,0 +1,11 @@
+package model
+
+// UsageStat 按用户和日期聚合的 token 用量
+type UsageStat struct {
+	UserID           int64  `json:"user_id"`
+	Date             string `json:"date"`
+	PromptTokens     int64  `json:"prompt_tokens"`
+	CompletionTokens int64  `json:"completion_tokens"`
+	TotalTokens      int64  `json:"total_tokens"`
+	Requests         int64  `json:"requests"`
+}
@@ -0,0 +1,16 @@
+package model
+
+// VectorCollection 向量集合及写入时使用的向量模型，同一时刻只有一个集合启用
+type VectorCollection struct {
+	ID             int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:主键ID"`
+	Name           string `json:"name" gorm:"size:255;uniqueIndex;comment:Qdrant集合名称"`
+	EmbeddingModel string `json:"embedding_model" gorm:"size:255;comment:向量模型"`
+	Active         bool   `json:"active" gorm:"index;comment:是否为当前检索使用的集合"`
+
+	CreatedAt int64 `json:"created_at" gorm:"autoCreateTime;comment:创建时间"`
+	UpdatedAt int64 `json:"updated_at" gorm:"autoUpdateTime;comment:更新时间"`
+}
+
+func (m *VectorCollection) TableName() string {
+	return "vector_collection"
+}
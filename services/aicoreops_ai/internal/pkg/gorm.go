@@ -1,6 +1,7 @@
 package pkg
 
 import (
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -24,5 +25,15 @@ func InitDB(addr string) *gorm.DB {
 }
 
 func InitTables(db *gorm.DB) error {
-	return db.AutoMigrate()
+	return db.AutoMigrate(
+		&model.History{},
+		&model.HistorySession{},
+		&model.Document{},
+		&model.FixRun{},
+		&model.PromptTemplate{},
+		&model.Feedback{},
+		&model.KnowledgeBase{},
+		&model.VectorCollection{},
+		&model.ReindexJob{},
+	)
 }
@@ -0,0 +1,79 @@
+package pkg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
+	"github.com/zeromicro/go-zero/core/logx"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// K8sClient Kubernetes 客户端，同时支持在 Pod 内执行命令
+type K8sClient struct {
+	kubernetes.Interface
+	config *rest.Config
+}
+
+// InitK8sClient 初始化 Kubernetes 客户端，未配置且不在集群内运行时返回 nil，自动修复功能不可用
+func InitK8sClient(c config.K8sConfig) *K8sClient {
+	var (
+		restConfig *rest.Config
+		err        error
+	)
+	if c.Kubeconfig != "" {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", c.Kubeconfig)
+		if err != nil {
+			panic(fmt.Errorf("加载 kubeconfig 失败: %w", err))
+		}
+	} else if restConfig, err = rest.InClusterConfig(); err != nil {
+		logx.Infof("未配置 Kubernetes，自动修复功能不可用: %v", err)
+		return nil
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		panic(fmt.Errorf("创建 Kubernetes 客户端失败: %w", err))
+	}
+
+	return &K8sClient{
+		Interface: clientset,
+		config:    restConfig,
+	}
+}
+
+// Exec 在 Pod 容器内执行命令，返回标准输出
+func (k *K8sClient) Exec(ctx context.Context, namespace, pod, container string, command []string) (string, error) {
+	req := k.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(k.config, "POST", req.URL())
+	if err != nil {
+		return "", fmt.Errorf("创建执行器失败: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}); err != nil {
+		return "", fmt.Errorf("执行命令失败: %w, stderr: %s", err, stderr.String())
+	}
+
+	return stdout.String(), nil
+}
@@ -1,20 +1,117 @@
 package pkg
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
 
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/tmc/langchaingo/llms"
 	"github.com/tmc/langchaingo/llms/ollama"
+	"github.com/tmc/langchaingo/llms/openai"
 )
 
-// InitLLM 初始化 LLM 模型
-func InitLLM(c config.LLMConfig) *ollama.LLM {
-	client, err := ollama.New(
+const (
+	ProviderOllama = "ollama"
+	ProviderOpenAI = "openai"
+
+	// openAIPlaceholderKey vLLM、LocalAI 等 OpenAI 兼容服务通常不校验密钥，但 SDK 要求非空
+	openAIPlaceholderKey = "EMPTY"
+)
+
+// LLMProvider 大模型提供方，对话、记忆缓冲区与向量化均通过该接口完成
+type LLMProvider interface {
+	llms.Model
+	embeddings.EmbedderClient
+}
+
+// InitLLM 根据配置初始化 LLM 提供方
+func InitLLM(c config.LLMConfig) LLMProvider {
+	provider, err := NewLLMProvider(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return provider
+}
+
+// EmbeddingModelName 实际使用的向量模型，未单独配置时与对话模型相同
+func EmbeddingModelName(c config.LLMConfig) string {
+	if c.EmbeddingModel != "" {
+		return c.EmbeddingModel
+	}
+	return c.Model
+}
+
+// NewLLMProvider 按 Provider 类型创建对应的 LLM 提供方
+func NewLLMProvider(c config.LLMConfig) (LLMProvider, error) {
+	switch c.Provider {
+	case "", ProviderOllama:
+		return newOllamaProvider(c)
+	case ProviderOpenAI:
+		return newOpenAIProvider(c)
+	default:
+		return nil, fmt.Errorf("不支持的 LLM 提供方: %s", c.Provider)
+	}
+}
+
+// ollamaProvider Ollama 的对话模型与向量模型需要分别创建客户端
+type ollamaProvider struct {
+	*ollama.LLM
+	embedder *ollama.LLM
+}
+
+func newOllamaProvider(c config.LLMConfig) (*ollamaProvider, error) {
+	chat, err := ollama.New(
 		ollama.WithServerURL(c.Url),
 		ollama.WithModel(c.Model),
 	)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("创建 Ollama 对话模型失败: %w", err)
+	}
+
+	embedder := chat
+	if c.EmbeddingModel != "" && c.EmbeddingModel != c.Model {
+		embedder, err = ollama.New(
+			ollama.WithServerURL(c.Url),
+			ollama.WithModel(c.EmbeddingModel),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("创建 Ollama 向量模型失败: %w", err)
+		}
+	}
+
+	return &ollamaProvider{
+		LLM:      chat,
+		embedder: embedder,
+	}, nil
+}
+
+// CreateEmbedding 使用向量模型生成嵌入
+func (p *ollamaProvider) CreateEmbedding(ctx context.Context, texts []string) ([][]float32, error) {
+	return p.embedder.CreateEmbedding(ctx, texts)
+}
+
+func newOpenAIProvider(c config.LLMConfig) (*openai.LLM, error) {
+	apiKey := c.ApiKey
+	if apiKey == "" {
+		apiKey = openAIPlaceholderKey
+	}
+
+	opts := []openai.Option{
+		openai.WithBaseURL(c.Url),
+		openai.WithToken(apiKey),
+		openai.WithModel(c.Model),
+	}
+	if c.EmbeddingModel != "" {
+		opts = append(opts, openai.WithEmbeddingModel(c.EmbeddingModel))
+	}
+
+	client, err := openai.New(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("创建 OpenAI 兼容模型失败: %w", err)
 	}
 
-	return client
+	return client, nil
 }
@@ -0,0 +1,546 @@
+package pkg
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tmc/langchaingo/llms"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// defaultMemoryTokenLimit 未配置时单个会话记忆的 token 上限
+	defaultMemoryTokenLimit = 10000
+	// summaryTriggerPercent 记忆达到上限的该百分比时开始压缩较早的问答
+	summaryTriggerPercent = 80
+	// memoryKeyPrefix Redis 中会话记忆的 key 前缀
+	memoryKeyPrefix = "aicoreops:ai:memory:"
+	// memorySummarySuffix Redis 中会话摘要 key 的后缀
+	memorySummarySuffix = ":summary"
+)
+
+const memorySummaryPrompt = `请把下面的运维对话压缩为一段简洁的摘要，供后续对话参考。
+必须保留涉及的服务、主机、告警、错误信息、已执行的操作和得出的结论，省略寒暄和重复内容。
+已有摘要:
+%s
+
+新增对话:
+%s
+
+只输出合并后的摘要。`
+
+// MemoryTurn 一轮问答
+type MemoryTurn struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
+// MemorySummary 会话摘要，Turns 为摘要已覆盖的最早问答轮数
+type MemorySummary struct {
+	Content string `json:"content"`
+	Turns   int    `json:"turns"`
+}
+
+// MemorySource 会话记忆的持久化来源
+type MemorySource interface {
+	// LoadMemory 会话不在缓存中时重建摘要及摘要之后的问答
+	LoadMemory(ctx context.Context, sessionID string) (MemorySummary, []MemoryTurn, error)
+	// SaveSummary 较早的问答被压缩为摘要后持久化
+	SaveSummary(ctx context.Context, sessionID string, summary MemorySummary) error
+}
+
+// SessionMemoryStore 会话记忆存储
+type SessionMemoryStore interface {
+	// Get 获取会话记忆，缓存未命中时通过 source 重建；exists 表示该会话此前是否已有历史
+	Get(ctx context.Context, sessionID string, source MemorySource) (mem *SessionMemory, exists bool, err error)
+	// Remove 移除会话记忆
+	Remove(ctx context.Context, sessionID string) error
+}
+
+// SessionMemory 并发安全的会话记忆，token 数接近上限时把较早的问答压缩为摘要，而不是直接丢弃
+type SessionMemory struct {
+	mu        sync.Mutex
+	sessionID string
+	llm       LLMProvider
+	limit     int
+	summary   MemorySummary
+	turns     []MemoryTurn
+	source    MemorySource
+	persist   func(ctx context.Context, turn MemoryTurn) error
+	// revise 替换最后一轮问答后同步缓存
+	revise func(ctx context.Context, turn MemoryTurn) error
+	// compact 摘要更新后同步缓存，folded 为本次压缩的问答轮数
+	compact func(ctx context.Context, summary MemorySummary, folded int) error
+}
+
+func newSessionMemory(sessionID string, llm LLMProvider, limit int, summary MemorySummary, turns []MemoryTurn, source MemorySource) *SessionMemory {
+	if limit <= 0 {
+		limit = defaultMemoryTokenLimit
+	}
+
+	return &SessionMemory{
+		sessionID: sessionID,
+		llm:       llm,
+		limit:     limit,
+		summary:   summary,
+		turns:     turns,
+		source:    source,
+	}
+}
+
+// History 获取会话历史文本，存在摘要时置于最前
+func (m *SessionMemory) History(ctx context.Context) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.buffer(), nil
+}
+
+// Save 保存一轮问答
+func (m *SessionMemory) Save(ctx context.Context, question, answer string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	turn := MemoryTurn{Question: question, Answer: answer}
+	if m.persist != nil {
+		if err := m.persist(ctx, turn); err != nil {
+			return err
+		}
+	}
+
+	m.turns = append(m.turns, turn)
+	return m.fit(ctx)
+}
+
+// HistoryBefore 获取不含最后一轮问答的会话历史，最后一轮的问题为 question 时才排除，用于重新生成回答；
+// 最后一轮已被压缩进摘要时返回完整历史
+func (m *SessionMemory) HistoryBefore(ctx context.Context, question string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if n := len(m.turns); n > 0 && m.turns[n-1].Question == question {
+		return m.format(m.turns[:n-1]), nil
+	}
+	return m.buffer(), nil
+}
+
+// ReviseLast 用重新生成的回答替换最后一轮问答，后续追问基于新的回答；
+// 最后一轮不是该问题时（已被压缩进摘要）不做修改
+func (m *SessionMemory) ReviseLast(ctx context.Context, question, answer string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n := len(m.turns)
+	if n == 0 || m.turns[n-1].Question != question {
+		return nil
+	}
+
+	turn := MemoryTurn{Question: question, Answer: answer}
+	if m.revise != nil {
+		if err := m.revise(ctx, turn); err != nil {
+			return err
+		}
+	}
+
+	m.turns[n-1] = turn
+	return m.fit(ctx)
+}
+
+// hasHistory 判断会话是否已保存过问答，包括已被压缩进摘要的问答
+func (m *SessionMemory) hasHistory() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.turns) > 0 || m.summary.Turns > 0
+}
+
+// buffer 与 langchaingo 对话缓冲区的格式保持一致，调用方需持有锁
+func (m *SessionMemory) buffer() string {
+	return m.format(m.turns)
+}
+
+func (m *SessionMemory) format(turns []MemoryTurn) string {
+	lines := make([]string, 0, len(turns)*2+1)
+	if m.summary.Content != "" {
+		lines = append(lines, "Summary: "+m.summary.Content)
+	}
+	for _, t := range turns {
+		lines = append(lines, "Human: "+t.Question, "AI: "+t.Answer)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// fit 记忆接近上限时把较早的一半问答合并进摘要；摘要生成失败时退化为丢弃最早的问答，不影响本轮回答的保存
+func (m *SessionMemory) fit(ctx context.Context) error {
+	if len(m.turns) == 0 || llms.CountTokens("", m.buffer()) <= m.limit*summaryTriggerPercent/100 {
+		return nil
+	}
+
+	folded := (len(m.turns) + 1) / 2
+	content, err := m.summarize(ctx, m.turns[:folded])
+	if err != nil {
+		logx.WithContext(ctx).Errorf("压缩会话记忆失败: %v", err)
+		m.truncate()
+		return nil
+	}
+
+	summary := MemorySummary{Content: content, Turns: m.summary.Turns + folded}
+	if m.compact != nil {
+		if err := m.compact(ctx, summary, folded); err != nil {
+			return err
+		}
+	}
+	if m.source != nil {
+		if err := m.source.SaveSummary(ctx, m.sessionID, summary); err != nil {
+			return fmt.Errorf("保存会话摘要失败: %w", err)
+		}
+	}
+
+	m.summary = summary
+	m.turns = slices.Clone(m.turns[folded:])
+	return nil
+}
+
+func (m *SessionMemory) summarize(ctx context.Context, turns []MemoryTurn) (string, error) {
+	lines := make([]string, 0, len(turns)*2)
+	for _, t := range turns {
+		lines = append(lines, "Human: "+t.Question, "AI: "+t.Answer)
+	}
+
+	previous := m.summary.Content
+	if previous == "" {
+		previous = "无"
+	}
+
+	content, err := llms.GenerateFromSinglePrompt(ctx, m.llm,
+		fmt.Sprintf(memorySummaryPrompt, previous, strings.Join(lines, "\n")),
+		llms.WithTemperature(0),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", fmt.Errorf("LLM 返回的摘要为空")
+	}
+	return content, nil
+}
+
+// truncate 从最早的问答开始丢弃，直到不超过上限
+func (m *SessionMemory) truncate() {
+	for len(m.turns) > 0 && llms.CountTokens("", m.buffer()) > m.limit {
+		m.turns = m.turns[1:]
+	}
+}
+
+// InitSessionMemoryStore 初始化会话记忆存储，配置 Redis 时多副本共享会话状态
+func InitSessionMemoryStore(c config.Config, llm LLMProvider) SessionMemoryStore {
+	if c.Redis == "" {
+		return NewLocalMemoryStore(llm, c.LLM.MemoryTokenLimit, c.Memory.MaxSessions, c.Memory.TTL)
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr: c.Redis,
+	})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		panic(fmt.Errorf("连接 Redis 失败: %w", err))
+	}
+
+	return NewRedisMemoryStore(client, llm, c.LLM.MemoryTokenLimit, c.Memory.MaxTurns, c.Memory.TTL)
+}
+
+// localMemoryStore 基于 LRU + TTL 的进程内会话记忆
+type localMemoryStore struct {
+	mu          sync.Mutex
+	llm         LLMProvider
+	tokenLimit  int
+	maxSessions int
+	ttl         time.Duration
+	ll          *list.List
+	items       map[string]*list.Element
+}
+
+type localMemoryEntry struct {
+	sessionID string
+	mem       *SessionMemory
+	expireAt  time.Time
+}
+
+// NewLocalMemoryStore 创建进程内会话记忆存储
+func NewLocalMemoryStore(llm LLMProvider, tokenLimit, maxSessions int, ttl time.Duration) SessionMemoryStore {
+	return &localMemoryStore{
+		llm:         llm,
+		tokenLimit:  tokenLimit,
+		maxSessions: maxSessions,
+		ttl:         ttl,
+		ll:          list.New(),
+		items:       make(map[string]*list.Element),
+	}
+}
+
+func (s *localMemoryStore) Get(ctx context.Context, sessionID string, source MemorySource) (*SessionMemory, bool, error) {
+	// 命中缓存但还没有保存过问答时（上次回答中途失败）仍视为新会话
+	if mem, ok := s.lookup(sessionID); ok {
+		return mem, mem.hasHistory(), nil
+	}
+
+	// 缓存未命中时在锁外重建，避免慢查询阻塞其他会话
+	summary, turns, err := source.LoadMemory(ctx, sessionID)
+	if err != nil {
+		return nil, false, fmt.Errorf("重建会话记忆失败: %w", err)
+	}
+
+	mem := newSessionMemory(sessionID, s.llm, s.tokenLimit, summary, turns, source)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 并发重建时以先写入的为准
+	if elem, ok := s.items[sessionID]; ok {
+		s.touch(elem)
+		mem = elem.Value.(*localMemoryEntry).mem
+		return mem, mem.hasHistory(), nil
+	}
+
+	s.items[sessionID] = s.ll.PushFront(&localMemoryEntry{
+		sessionID: sessionID,
+		mem:       mem,
+		expireAt:  time.Now().Add(s.ttl),
+	})
+	s.evict()
+
+	return mem, len(turns) > 0 || summary.Turns > 0, nil
+}
+
+func (s *localMemoryStore) Remove(ctx context.Context, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.items[sessionID]; ok {
+		s.ll.Remove(elem)
+		delete(s.items, sessionID)
+	}
+
+	return nil
+}
+
+func (s *localMemoryStore) lookup(sessionID string) (*SessionMemory, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.items[sessionID]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*localMemoryEntry)
+	if s.ttl > 0 && time.Now().After(entry.expireAt) {
+		s.ll.Remove(elem)
+		delete(s.items, sessionID)
+		return nil, false
+	}
+
+	s.touch(elem)
+	return entry.mem, true
+}
+
+func (s *localMemoryStore) touch(elem *list.Element) {
+	elem.Value.(*localMemoryEntry).expireAt = time.Now().Add(s.ttl)
+	s.ll.MoveToFront(elem)
+}
+
+// evict 淘汰过期及超出容量的会话，调用方需持有锁
+func (s *localMemoryStore) evict() {
+	now := time.Now()
+	for elem := s.ll.Back(); elem != nil; elem = s.ll.Back() {
+		entry := elem.Value.(*localMemoryEntry)
+		expired := s.ttl > 0 && now.After(entry.expireAt)
+		overflow := s.maxSessions > 0 && s.ll.Len() > s.maxSessions
+		if !expired && !overflow {
+			return
+		}
+
+		s.ll.Remove(elem)
+		delete(s.items, entry.sessionID)
+	}
+}
+
+// redisMemoryStore 基于 Redis 的会话记忆，每次获取都以 Redis 中的数据为准，保证多副本一致
+type redisMemoryStore struct {
+	client     redis.Cmdable
+	llm        LLMProvider
+	tokenLimit int
+	maxTurns   int
+	ttl        time.Duration
+}
+
+// NewRedisMemoryStore 创建 Redis 会话记忆存储
+func NewRedisMemoryStore(client redis.Cmdable, llm LLMProvider, tokenLimit, maxTurns int, ttl time.Duration) SessionMemoryStore {
+	return &redisMemoryStore{
+		client:     client,
+		llm:        llm,
+		tokenLimit: tokenLimit,
+		maxTurns:   maxTurns,
+		ttl:        ttl,
+	}
+}
+
+func (s *redisMemoryStore) Get(ctx context.Context, sessionID string, source MemorySource) (*SessionMemory, bool, error) {
+	key := memoryKeyPrefix + sessionID
+
+	summary, err := s.loadSummary(ctx, key)
+	if err != nil {
+		return nil, false, err
+	}
+	turns, err := s.loadTurns(ctx, key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Redis 中不存在时从数据库重建并回填
+	if len(turns) == 0 && summary.Turns == 0 {
+		if summary, turns, err = source.LoadMemory(ctx, sessionID); err != nil {
+			return nil, false, fmt.Errorf("重建会话记忆失败: %w", err)
+		}
+		if summary.Turns > 0 {
+			if err = s.saveSummary(ctx, s.client, key, summary); err != nil {
+				return nil, false, err
+			}
+		}
+		if err = s.appendTurns(ctx, key, turns...); err != nil {
+			return nil, false, err
+		}
+	}
+
+	mem := newSessionMemory(sessionID, s.llm, s.tokenLimit, summary, turns, source)
+	mem.persist = func(ctx context.Context, turn MemoryTurn) error {
+		return s.appendTurns(ctx, key, turn)
+	}
+	mem.compact = func(ctx context.Context, summary MemorySummary, folded int) error {
+		return s.compact(ctx, key, summary, folded)
+	}
+	mem.revise = func(ctx context.Context, turn MemoryTurn) error {
+		return s.reviseLast(ctx, key, turn)
+	}
+
+	return mem, len(turns) > 0 || summary.Turns > 0, nil
+}
+
+func (s *redisMemoryStore) Remove(ctx context.Context, sessionID string) error {
+	key := memoryKeyPrefix + sessionID
+	return s.client.Del(ctx, key, key+memorySummarySuffix).Err()
+}
+
+func (s *redisMemoryStore) loadSummary(ctx context.Context, key string) (MemorySummary, error) {
+	var summary MemorySummary
+
+	value, err := s.client.Get(ctx, key+memorySummarySuffix).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return summary, nil
+	}
+	if err != nil {
+		return summary, fmt.Errorf("读取 Redis 会话摘要失败: %w", err)
+	}
+
+	if err := json.Unmarshal(value, &summary); err != nil {
+		return summary, fmt.Errorf("解析 Redis 会话摘要失败: %w", err)
+	}
+	return summary, nil
+}
+
+func (s *redisMemoryStore) saveSummary(ctx context.Context, cmd redis.Cmdable, key string, summary MemorySummary) error {
+	b, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Set(ctx, key+memorySummarySuffix, b, s.ttl).Err(); err != nil {
+		return fmt.Errorf("写入 Redis 会话摘要失败: %w", err)
+	}
+	return nil
+}
+
+// compact 写入新摘要并移除已被摘要覆盖的最早 folded 轮问答
+func (s *redisMemoryStore) compact(ctx context.Context, key string, summary MemorySummary, folded int) error {
+	pipe := s.client.TxPipeline()
+	if err := s.saveSummary(ctx, pipe, key, summary); err != nil {
+		return err
+	}
+	pipe.LTrim(ctx, key, int64(folded), -1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("写入 Redis 会话摘要失败: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisMemoryStore) reviseLast(ctx context.Context, key string, turn MemoryTurn) error {
+	b, err := json.Marshal(turn)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.LSet(ctx, key, -1, b).Err(); err != nil {
+		return fmt.Errorf("写入 Redis 会话记忆失败: %w", err)
+	}
+	return nil
+}
+
+func (s *redisMemoryStore) loadTurns(ctx context.Context, key string) ([]MemoryTurn, error) {
+	values, err := s.client.LRange(ctx, key, 0, -1).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("读取 Redis 会话记忆失败: %w", err)
+	}
+
+	turns := make([]MemoryTurn, 0, len(values))
+	for _, v := range values {
+		var turn MemoryTurn
+		if err := json.Unmarshal([]byte(v), &turn); err != nil {
+			return nil, fmt.Errorf("解析 Redis 会话记忆失败: %w", err)
+		}
+		turns = append(turns, turn)
+	}
+
+	return turns, nil
+}
+
+func (s *redisMemoryStore) appendTurns(ctx context.Context, key string, turns ...MemoryTurn) error {
+	if len(turns) == 0 {
+		return nil
+	}
+
+	values := make([]any, 0, len(turns))
+	for _, t := range turns {
+		b, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		values = append(values, b)
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.RPush(ctx, key, values...)
+	if s.maxTurns > 0 {
+		pipe.LTrim(ctx, key, int64(-s.maxTurns), -1)
+	}
+	if s.ttl > 0 {
+		pipe.Expire(ctx, key, s.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("写入 Redis 会话记忆失败: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package pkg
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// fakeMemorySource 在内存中保存会话历史，记录重建次数
+type fakeMemorySource struct {
+	summaries map[string]MemorySummary
+	turns     map[string][]MemoryTurn
+	loads     map[string]int
+}
+
+func newFakeMemorySource() *fakeMemorySource {
+	return &fakeMemorySource{
+		summaries: map[string]MemorySummary{},
+		turns:     map[string][]MemoryTurn{},
+		loads:     map[string]int{},
+	}
+}
+
+func (f *fakeMemorySource) LoadMemory(ctx context.Context, sessionID string) (MemorySummary, []MemoryTurn, error) {
+	f.loads[sessionID]++
+	return f.summaries[sessionID], append([]MemoryTurn(nil), f.turns[sessionID]...), nil
+}
+
+func (f *fakeMemorySource) SaveSummary(ctx context.Context, sessionID string, summary MemorySummary) error {
+	f.summaries[sessionID] = summary
+	return nil
+}
+
+func mustGetMemory(t *testing.T, store SessionMemoryStore, sessionID string, source MemorySource) (*SessionMemory, bool) {
+	t.Helper()
+	mem, exists, err := store.Get(context.Background(), sessionID, source)
+	if err != nil {
+		t.Fatalf("Get(%s): %v", sessionID, err)
+	}
+	return mem, exists
+}
+
+func TestLocalMemoryStoreEviction(t *testing.T) {
+	source := newFakeMemorySource()
+	store := NewLocalMemoryStore(nil, 0, 2, time.Hour)
+
+	mustGetMemory(t, store, "a", source)
+	mustGetMemory(t, store, "b", source)
+	// 访问 a 后 b 成为最久未使用的会话，写入 c 时被淘汰
+	mustGetMemory(t, store, "a", source)
+	mustGetMemory(t, store, "c", source)
+
+	mustGetMemory(t, store, "a", source)
+	mustGetMemory(t, store, "c", source)
+	if source.loads["a"] != 1 || source.loads["c"] != 1 {
+		t.Errorf("loads = %v, want a and c cached", source.loads)
+	}
+	mustGetMemory(t, store, "b", source)
+	if source.loads["b"] != 2 {
+		t.Errorf("b loads = %d, want rebuilt after eviction", source.loads["b"])
+	}
+}
+
+func TestLocalMemoryStoreTTL(t *testing.T) {
+	source := newFakeMemorySource()
+	store := NewLocalMemoryStore(nil, 0, 0, 20*time.Millisecond)
+
+	mustGetMemory(t, store, "a", source)
+	mustGetMemory(t, store, "a", source)
+	if source.loads["a"] != 1 {
+		t.Fatalf("loads = %d, want cached before expiry", source.loads["a"])
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	mustGetMemory(t, store, "a", source)
+	if source.loads["a"] != 2 {
+		t.Errorf("loads = %d, want rebuilt after expiry", source.loads["a"])
+	}
+}
+
+func TestLocalMemoryStoreRebuild(t *testing.T) {
+	ctx := context.Background()
+	source := newFakeMemorySource()
+	source.summaries["a"] = MemorySummary{Content: "nginx 502", Turns: 2}
+	source.turns["a"] = []MemoryTurn{{Question: "重启了吗", Answer: "已重启"}}
+	store := NewLocalMemoryStore(nil, 0, 10, time.Hour)
+
+	mem, exists := mustGetMemory(t, store, "a", source)
+	if !exists {
+		t.Error("exists = false for rebuilt session")
+	}
+	history, err := mem.History(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Summary: nginx 502\nHuman: 重启了吗\nAI: 已重启"; history != want {
+		t.Errorf("history = %q, want %q", history, want)
+	}
+}
+
+func TestLocalMemoryStoreExists(t *testing.T) {
+	ctx := context.Background()
+	source := newFakeMemorySource()
+	store := NewLocalMemoryStore(nil, 0, 10, time.Hour)
+
+	if _, exists := mustGetMemory(t, store, "a", source); exists {
+		t.Error("exists = true for new session")
+	}
+	// 上次回答中途失败没有保存问答，再次获取命中缓存时仍是新会话
+	mem, exists := mustGetMemory(t, store, "a", source)
+	if exists {
+		t.Error("exists = true for cached session without turns")
+	}
+
+	if err := mem.Save(ctx, "磁盘满了", "清理日志"); err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := mustGetMemory(t, store, "a", source); !exists {
+		t.Error("exists = false after saving a turn")
+	}
+	if source.loads["a"] != 1 {
+		t.Errorf("loads = %d, want 1", source.loads["a"])
+	}
+}
+
+func TestRedisMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	source := newFakeMemorySource()
+	source.turns["old"] = []MemoryTurn{{Question: "q1", Answer: "a1"}, {Question: "q2", Answer: "a2"}}
+	store := NewRedisMemoryStore(client, nil, 0, 10, time.Hour)
+
+	// 新会话不存在历史
+	mem, exists := mustGetMemory(t, store, "new", source)
+	if exists {
+		t.Error("exists = true for new session")
+	}
+	if _, exists = mustGetMemory(t, store, "new", source); exists {
+		t.Error("exists = true for session without turns")
+	}
+	if err := mem.Save(ctx, "q", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, exists = mustGetMemory(t, store, "new", source); !exists {
+		t.Error("exists = false after saving a turn")
+	}
+
+	// Redis 中不存在时从数据库重建并回填，之后以 Redis 为准
+	mem, exists = mustGetMemory(t, store, "old", source)
+	if !exists {
+		t.Error("exists = false for rebuilt session")
+	}
+	values, err := mr.List(memoryKeyPrefix + "old")
+	if err != nil || len(values) != 2 {
+		t.Fatalf("backfilled = %v, err = %v", values, err)
+	}
+	mustGetMemory(t, store, "old", source)
+	if source.loads["old"] != 1 {
+		t.Errorf("loads = %d, want served from redis", source.loads["old"])
+	}
+	if history, _ := mem.History(ctx); !strings.Contains(history, "Human: q2\nAI: a2") {
+		t.Errorf("history = %q", history)
+	}
+
+	if err := store.Remove(ctx, "old"); err != nil {
+		t.Fatal(err)
+	}
+	mustGetMemory(t, store, "old", source)
+	if source.loads["old"] != 2 {
+		t.Errorf("loads = %d, want rebuilt after remove", source.loads["old"])
+	}
+}
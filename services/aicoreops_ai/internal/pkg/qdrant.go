@@ -1,35 +1,235 @@
 package pkg
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
 	"github.com/tmc/langchaingo/embeddings"
-	"github.com/tmc/langchaingo/llms/ollama"
 	"github.com/tmc/langchaingo/vectorstores/qdrant"
 )
 
 // InitQdrantStore 初始化并配置Qdrant向量存储
-func InitQdrantStore(c config.QdrantConfig, llm *ollama.LLM) *qdrant.Store {
-	parsedURL, err := url.Parse(c.Url)
+func InitQdrantStore(c config.QdrantConfig, llm LLMProvider) *qdrant.Store {
+	store, err := NewQdrantStore(c.Url, c.CollectionName, llm)
 	if err != nil {
-		panic(fmt.Errorf("解析Qdrant URL失败: %w", err))
+		panic(err)
 	}
 
-	ollamaEmbedder, err := embeddings.NewEmbedder(llm)
+	return store
+}
+
+// NewQdrantStore 创建指定集合的向量存储，写入和检索均使用 embedder 生成向量
+func NewQdrantStore(rawURL, collectionName string, embedder embeddings.EmbedderClient) (*qdrant.Store, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("解析Qdrant URL失败: %w", err)
+	}
+
+	e, err := embeddings.NewEmbedder(embedder)
 	if err != nil {
-		panic(fmt.Errorf("创建Ollama嵌入器失败: %w", err))
+		return nil, fmt.Errorf("创建嵌入器失败: %w", err)
 	}
 
 	vectorStore, err := qdrant.New(
 		qdrant.WithURL(*parsedURL),
-		qdrant.WithCollectionName(c.CollectionName),
-		qdrant.WithEmbedder(ollamaEmbedder),
+		qdrant.WithCollectionName(collectionName),
+		qdrant.WithEmbedder(e),
 	)
 	if err != nil {
-		panic(fmt.Errorf("创建Qdrant向量存储失败: %w", err))
+		return nil, fmt.Errorf("创建Qdrant向量存储失败: %w", err)
+	}
+
+	return &vectorStore, nil
+}
+
+// scrollPageSize 遍历向量点时的单页大小
+const scrollPageSize = 256
+
+// QdrantClient 补充 langchaingo 未封装的 Qdrant REST 接口
+type QdrantClient struct {
+	url            url.URL
+	collectionName string
+}
+
+// InitQdrantClient 初始化Qdrant REST客户端
+func InitQdrantClient(c config.QdrantConfig) *QdrantClient {
+	client, err := NewQdrantClient(c.Url, c.CollectionName)
+	if err != nil {
+		panic(err)
+	}
+
+	return client
+}
+
+// NewQdrantClient 创建指定集合的 REST 客户端
+func NewQdrantClient(rawURL, collectionName string) (*QdrantClient, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("解析Qdrant URL失败: %w", err)
+	}
+
+	return &QdrantClient{
+		url:            *parsedURL,
+		collectionName: collectionName,
+	}, nil
+}
+
+// CollectionExists 判断集合是否存在
+func (c *QdrantClient) CollectionExists(ctx context.Context) (bool, error) {
+	u := c.url.JoinPath("collections", c.collectionName)
+	body, status, err := qdrant.DoRequest(ctx, *u, "", http.MethodGet, nil)
+	if err != nil {
+		return false, fmt.Errorf("查询集合失败: %w", err)
+	}
+	defer body.Close()
+
+	switch status {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, newQdrantError("查询集合失败", body)
+	}
+}
+
+// CreateCollection 创建使用余弦距离的集合
+func (c *QdrantClient) CreateCollection(ctx context.Context, size int) error {
+	u := c.url.JoinPath("collections", c.collectionName)
+	body, status, err := qdrant.DoRequest(ctx, *u, "", http.MethodPut, map[string]any{
+		"vectors": map[string]any{"size": size, "distance": "Cosine"},
+	})
+	if err != nil {
+		return fmt.Errorf("创建集合失败: %w", err)
+	}
+	defer body.Close()
+
+	if status != http.StatusOK {
+		return newQdrantError("创建集合失败", body)
+	}
+
+	return nil
+}
+
+// ContentPayloadKey langchaingo 在 payload 中存放分块内容的字段
+const ContentPayloadKey = "content"
+
+// CreateTextIndex 为 payload 字段创建全文索引，供关键词检索使用；索引已存在时重复创建不会报错
+func (c *QdrantClient) CreateTextIndex(ctx context.Context, field string) error {
+	u := c.url.JoinPath("collections", c.collectionName, "index")
+	u.RawQuery = "wait=true"
+	body, status, err := qdrant.DoRequest(ctx, *u, "", http.MethodPut, map[string]any{
+		"field_name": field,
+		"field_schema": map[string]any{
+			"type":      "text",
+			"tokenizer": "multilingual",
+			"lowercase": true,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("创建全文索引失败: %w", err)
+	}
+	defer body.Close()
+
+	if status != http.StatusOK {
+		return newQdrantError("创建全文索引失败", body)
+	}
+
+	return nil
+}
+
+// DeletePoints 按过滤条件删除向量点
+func (c *QdrantClient) DeletePoints(ctx context.Context, filter any) error {
+	u := c.url.JoinPath("collections", c.collectionName, "points", "delete")
+	body, status, err := qdrant.DoRequest(ctx, *u, "", http.MethodPost, map[string]any{"filter": filter})
+	if err != nil {
+		return fmt.Errorf("删除向量失败: %w", err)
+	}
+	defer body.Close()
+
+	if status != http.StatusOK {
+		return newQdrantError("删除向量失败", body)
+	}
+
+	return nil
+}
+
+// QdrantPoint Qdrant 中的向量点，只包含 payload
+type QdrantPoint struct {
+	ID      any            `json:"id"`
+	Payload map[string]any `json:"payload"`
+}
+
+// ScrollPoints 按过滤条件分页遍历向量点，最多返回 limit 个
+func (c *QdrantClient) ScrollPoints(ctx context.Context, filter any, limit int) ([]QdrantPoint, error) {
+	u := c.url.JoinPath("collections", c.collectionName, "points", "scroll")
+
+	var (
+		points []QdrantPoint
+		offset any
+	)
+	for len(points) < limit {
+		payload := map[string]any{
+			"limit":        min(limit-len(points), scrollPageSize),
+			"with_payload": true,
+			"with_vector":  false,
+		}
+		if filter != nil {
+			payload["filter"] = filter
+		}
+		if offset != nil {
+			payload["offset"] = offset
+		}
+
+		page, next, err := c.scroll(ctx, *u, payload)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, page...)
+		if next == nil {
+			break
+		}
+		offset = next
+	}
+
+	return points, nil
+}
+
+func (c *QdrantClient) scroll(ctx context.Context, u url.URL, payload map[string]any) ([]QdrantPoint, any, error) {
+	body, status, err := qdrant.DoRequest(ctx, u, "", http.MethodPost, payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("遍历向量失败: %w", err)
+	}
+	defer body.Close()
+
+	if status != http.StatusOK {
+		return nil, nil, newQdrantError("遍历向量失败", body)
+	}
+
+	var resp struct {
+		Result struct {
+			Points         []QdrantPoint `json:"points"`
+			NextPageOffset any           `json:"next_page_offset"`
+		} `json:"result"`
+	}
+	if err = json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, nil, fmt.Errorf("解析遍历结果失败: %w", err)
+	}
+
+	return resp.Result.Points, resp.Result.NextPageOffset, nil
+}
+
+func newQdrantError(task string, body io.Reader) error {
+	buf := new(bytes.Buffer)
+	if _, err := io.Copy(buf, body); err != nil {
+		return fmt.Errorf("%s: 读取响应失败: %w", task, err)
 	}
 
-	return &vectorStore
+	return fmt.Errorf("%s: %s", task, buf.String())
 }
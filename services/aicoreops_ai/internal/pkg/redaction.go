@@ -0,0 +1,237 @@
+package pkg
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
+)
+
+const (
+	// redactionSecretGroup 规则中包含该命名分组时只替换分组内容，保留 key 等上下文
+	redactionSecretGroup = "secret"
+	// redactionPlaceholder 脱敏后的占位符，参数为规则名称
+	redactionPlaceholder = "[REDACTED:%s]"
+	// highEntropyRule 熵检测命中时使用的规则名称
+	highEntropyRule = "high_entropy"
+)
+
+// builtinRedactionRules 内置规则，按顺序执行，越具体的规则越靠前
+var builtinRedactionRules = []config.RedactionRule{
+	{Name: "private_key", Pattern: `-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`},
+	{Name: "jwt", Pattern: `\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`},
+	{Name: "aws_access_key", Pattern: `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`},
+	{Name: "aliyun_access_key", Pattern: `\bLTAI[0-9A-Za-z]{12,20}\b`},
+	{Name: "tencent_secret_id", Pattern: `\bAKID[0-9A-Za-z]{13,40}\b`},
+	{Name: "github_token", Pattern: `\bgh[pousr]_[0-9A-Za-z]{36,}\b`},
+	{Name: "slack_token", Pattern: `\bxox[abposr]-[0-9A-Za-z-]{10,}`},
+	{Name: "bearer_token", Pattern: `(?i)\bbearer\s+(?P<secret>[A-Za-z0-9._~+/=-]{16,})`},
+	{Name: "url_credential", Pattern: `\b[A-Za-z][A-Za-z0-9+.-]*://[^\s:/@]+:(?P<secret>[^\s@/]+)@`},
+	{Name: "key_value_secret", Pattern: `(?i)\b(?:password|passwd|pwd|secret|token|api[_-]?key|access[_-]?key(?:[_-]?id)?|secret[_-]?(?:access[_-]?)?key|credential)s?["']?\s*[:=]\s*["']?(?P<secret>[^\s"',;&]+)`},
+	{Name: "email", Pattern: `\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`},
+	{Name: "ipv4", Pattern: `\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`},
+}
+
+// entropyCandidateRegexp 熵检测的候选片段，只检查同时包含大小写字母和数字的片段，避免误伤单词、十六进制哈希和 Pod 名称
+var entropyCandidateRegexp = regexp.MustCompile(`[A-Za-z0-9+/_=-]+`)
+
+// RedactionReport 各规则的脱敏次数，不包含原文
+type RedactionReport map[string]int
+
+// Merge 合并其他报告
+func (r RedactionReport) Merge(other RedactionReport) RedactionReport {
+	if len(other) == 0 {
+		return r
+	}
+	if r == nil {
+		r = RedactionReport{}
+	}
+	for rule, count := range other {
+		r[rule] += count
+	}
+
+	return r
+}
+
+// Rules 按名称排序的命中规则
+func (r RedactionReport) Rules() []string {
+	rules := make([]string, 0, len(r))
+	for rule := range r {
+		rules = append(rules, rule)
+	}
+	slices.Sort(rules)
+
+	return rules
+}
+
+// String 用于日志输出，如 email=1, ipv4=2
+func (r RedactionReport) String() string {
+	pairs := make([]string, 0, len(r))
+	for _, rule := range r.Rules() {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", rule, r[rule]))
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+type redactionRule struct {
+	name  string
+	re    *regexp.Regexp
+	group int
+}
+
+// Redactor 在内容发送给大模型、写入向量库和历史记录之前屏蔽密钥和个人信息；值为 nil 时不做处理
+type Redactor struct {
+	rules            []redactionRule
+	entropyThreshold float64
+	entropyMinLength int
+}
+
+// InitRedactor 根据配置初始化脱敏器，未启用时返回 nil
+func InitRedactor(c config.RedactionConfig) *Redactor {
+	if !c.Enable {
+		return nil
+	}
+
+	redactor, err := NewRedactor(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return redactor
+}
+
+// NewRedactor 编译内置规则和自定义规则，自定义规则在内置规则之后执行
+func NewRedactor(c config.RedactionConfig) (*Redactor, error) {
+	r := &Redactor{
+		entropyThreshold: c.EntropyThreshold,
+		entropyMinLength: c.EntropyMinLength,
+	}
+
+	for _, rule := range slices.Concat(builtinRedactionRules, c.Rules) {
+		if slices.Contains(c.DisabledRules, rule.Name) {
+			continue
+		}
+
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("编译脱敏规则 %s 失败: %w", rule.Name, err)
+		}
+		r.rules = append(r.rules, redactionRule{
+			name:  rule.Name,
+			re:    re,
+			group: re.SubexpIndex(redactionSecretGroup),
+		})
+	}
+
+	return r, nil
+}
+
+// Redact 依次应用正则规则和熵检测，返回脱敏后的文本及各规则的命中次数
+func (r *Redactor) Redact(text string) (string, RedactionReport) {
+	if r == nil || text == "" {
+		return text, nil
+	}
+
+	var report RedactionReport
+	for _, rule := range r.rules {
+		var count int
+		text, count = rule.apply(text)
+		if count > 0 {
+			report = report.Merge(RedactionReport{rule.name: count})
+		}
+	}
+
+	if r.entropyThreshold > 0 {
+		var count int
+		text = entropyCandidateRegexp.ReplaceAllStringFunc(text, func(s string) string {
+			if !r.highEntropy(s) {
+				return s
+			}
+			count++
+			return fmt.Sprintf(redactionPlaceholder, highEntropyRule)
+		})
+		if count > 0 {
+			report = report.Merge(RedactionReport{highEntropyRule: count})
+		}
+	}
+
+	return text, report
+}
+
+func (rule redactionRule) apply(text string) (string, int) {
+	matches := rule.re.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text, 0
+	}
+
+	var (
+		b     strings.Builder
+		last  int
+		count int
+	)
+	placeholder := fmt.Sprintf(redactionPlaceholder, rule.name)
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		if rule.group > 0 {
+			start, end = m[2*rule.group], m[2*rule.group+1]
+		}
+		// 分组未参与匹配，或命中的是之前规则留下的占位符
+		if start < 0 || strings.HasPrefix(text[start:end], "[REDACTED:") {
+			continue
+		}
+
+		b.WriteString(text[last:start])
+		b.WriteString(placeholder)
+		last = end
+		count++
+	}
+	b.WriteString(text[last:])
+
+	return b.String(), count
+}
+
+func (r *Redactor) highEntropy(s string) bool {
+	if len(s) < r.entropyMinLength {
+		return false
+	}
+
+	var upper, lower, digit bool
+	for _, c := range s {
+		switch {
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsDigit(c):
+			digit = true
+		}
+	}
+	if !upper || !lower || !digit {
+		return false
+	}
+
+	return shannonEntropy(s) >= r.entropyThreshold
+}
+
+// shannonEntropy 按字符频率计算每个字符的平均信息量（bit）
+func shannonEntropy(s string) float64 {
+	freq := make(map[rune]int)
+	var n int
+	for _, c := range s {
+		freq[c]++
+		n++
+	}
+
+	var entropy float64
+	for _, count := range freq {
+		p := float64(count) / float64(n)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
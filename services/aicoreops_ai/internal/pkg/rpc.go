@@ -0,0 +1,14 @@
+package pkg
+
+import (
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+// InitRpcClient 初始化下游服务客户端，未配置地址时返回 nil
+func InitRpcClient(c zrpc.RpcClientConf) zrpc.Client {
+	if len(c.Etcd.Hosts) == 0 && len(c.Endpoints) == 0 && c.Target == "" {
+		return nil
+	}
+
+	return zrpc.MustNewClient(c)
+}
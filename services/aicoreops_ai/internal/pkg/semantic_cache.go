@@ -0,0 +1,161 @@
+package pkg
+
+import (
+	"container/list"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
+)
+
+// SemanticCacheKey 一次查找对应的缓存键，未命中时生成回答后用同一个键写入
+type SemanticCacheKey struct {
+	// Partition 影响回答的其他请求参数，只在相同分区内按相似度匹配
+	Partition string
+	Vector    []float32
+	// KnowledgeBases 检索范围，有序，必须完全一致才能命中，避免越权读取其他知识库的回答
+	KnowledgeBases []int64
+	versions       []int64
+}
+
+type semanticCacheEntry struct {
+	key      SemanticCacheKey
+	value    any
+	expireAt time.Time
+}
+
+// SemanticCache 进程内语义缓存，按问题向量的余弦相似度匹配；
+// 知识库中的文档变更时版本递增并清除相关条目，多副本部署时各副本独立失效，由 TTL 兜底
+type SemanticCache struct {
+	mu         sync.Mutex
+	threshold  float32
+	maxEntries int
+	ttl        time.Duration
+	ll         *list.List
+	versions   map[int64]int64
+}
+
+// InitSemanticCache 初始化语义缓存，未启用时返回 nil
+func InitSemanticCache(c config.SemanticCacheConfig) *SemanticCache {
+	if !c.Enable {
+		return nil
+	}
+
+	return NewSemanticCache(c.Threshold, c.MaxEntries, c.TTL)
+}
+
+// NewSemanticCache 创建语义缓存
+func NewSemanticCache(threshold float32, maxEntries int, ttl time.Duration) *SemanticCache {
+	return &SemanticCache{
+		threshold:  threshold,
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		ll:         list.New(),
+		versions:   make(map[int64]int64),
+	}
+}
+
+// Key 生成缓存键并记录当前知识库版本，kbIDs 会被排序
+func (c *SemanticCache) Key(partition string, vector []float32, kbIDs []int64) SemanticCacheKey {
+	ids := slices.Clone(kbIDs)
+	slices.Sort(ids)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	versions := make([]int64, len(ids))
+	for i, id := range ids {
+		versions[i] = c.versions[id]
+	}
+
+	return SemanticCacheKey{Partition: partition, Vector: vector, KnowledgeBases: ids, versions: versions}
+}
+
+// Get 查找同一分区、同一检索范围内相似度最高且不低于阈值的条目
+func (c *SemanticCache) Get(key SemanticCacheKey) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	var (
+		best      *list.Element
+		bestScore float32
+	)
+	for elem := c.ll.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*semanticCacheEntry)
+		switch {
+		case c.ttl > 0 && now.After(entry.expireAt):
+			c.ll.Remove(elem)
+		case entry.key.Partition != key.Partition || !slices.Equal(entry.key.KnowledgeBases, key.KnowledgeBases):
+		default:
+			if score := cosineSimilarity(entry.key.Vector, key.Vector); score >= c.threshold && score > bestScore {
+				best, bestScore = elem, score
+			}
+		}
+		elem = next
+	}
+
+	if best == nil {
+		return nil, false
+	}
+
+	c.ll.MoveToFront(best)
+	return best.Value.(*semanticCacheEntry).value, true
+}
+
+// Set 写入缓存；生成回答期间检索范围内的知识库发生变更时放弃写入
+func (c *SemanticCache) Set(key SemanticCacheKey, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, id := range key.KnowledgeBases {
+		if c.versions[id] != key.versions[i] {
+			return
+		}
+	}
+
+	c.ll.PushFront(&semanticCacheEntry{
+		key:      key,
+		value:    value,
+		expireAt: time.Now().Add(c.ttl),
+	})
+	for c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		c.ll.Remove(c.ll.Back())
+	}
+}
+
+// Invalidate 知识库文档变更后递增版本，并清除检索范围包含该知识库的条目
+func (c *SemanticCache) Invalidate(kbID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.versions[kbID]++
+	for elem := c.ll.Front(); elem != nil; {
+		next := elem.Next()
+		if _, found := slices.BinarySearch(elem.Value.(*semanticCacheEntry).key.KnowledgeBases, kbID); found {
+			c.ll.Remove(elem)
+		}
+		elem = next
+	}
+}
+
+func cosineSimilarity(a, b []float32) float32 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return float32(dot / (math.Sqrt(normA) * math.Sqrt(normB)))
+}
@@ -0,0 +1,124 @@
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
+
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/tmc/langchaingo/vectorstores"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// dimensionProbeText 创建集合前用于探测向量维度的文本
+const dimensionProbeText = "aicoreops"
+
+// PointClient 按 payload 过滤遍历、删除向量点
+type PointClient interface {
+	ScrollPoints(ctx context.Context, filter any, limit int) ([]QdrantPoint, error)
+	DeletePoints(ctx context.Context, filter any) error
+}
+
+// VectorCollection 一个向量集合及写入它时使用的向量模型，检索时必须使用同一模型
+type VectorCollection struct {
+	Name           string
+	EmbeddingModel string
+	Dimension      int
+	Store          vectorstores.VectorStore
+	Client         PointClient
+}
+
+// VectorCollectionOpener 打开集合，create 为 true 且集合不存在时按向量模型的维度创建
+type VectorCollectionOpener func(ctx context.Context, name, model string, create bool) (*VectorCollection, error)
+
+// NewQdrantCollectionOpener 基于 Qdrant 打开集合，向量模型与对话模型使用同一提供方
+func NewQdrantCollectionOpener(c config.Config) VectorCollectionOpener {
+	return func(ctx context.Context, name, model string, create bool) (*VectorCollection, error) {
+		llmConf := c.LLM
+		llmConf.EmbeddingModel = model
+		embedder, err := NewLLMProvider(llmConf)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := NewQdrantClient(c.Qdrant.Url, name)
+		if err != nil {
+			return nil, err
+		}
+		store, err := NewQdrantStore(c.Qdrant.Url, name, embedder)
+		if err != nil {
+			return nil, err
+		}
+
+		col := &VectorCollection{Name: name, EmbeddingModel: model, Store: store, Client: client}
+		if !create {
+			return col, nil
+		}
+
+		exists, err := client.CollectionExists(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			if col.Dimension, err = embeddingDimension(ctx, embedder); err != nil {
+				return nil, err
+			}
+			if err = client.CreateCollection(ctx, col.Dimension); err != nil {
+				return nil, err
+			}
+		}
+		if err = client.CreateTextIndex(ctx, ContentPayloadKey); err != nil {
+			return nil, err
+		}
+
+		return col, nil
+	}
+}
+
+func embeddingDimension(ctx context.Context, embedder embeddings.EmbedderClient) (int, error) {
+	vectors, err := embedder.CreateEmbedding(ctx, []string{dimensionProbeText})
+	if err != nil {
+		return 0, fmt.Errorf("探测向量维度失败: %w", err)
+	}
+	if len(vectors) == 0 || len(vectors[0]) == 0 {
+		return 0, fmt.Errorf("探测向量维度失败: 返回结果为空")
+	}
+
+	return len(vectors[0]), nil
+}
+
+// VectorIndex 当前用于检索和写入的集合，重建索引完成后原子切换
+type VectorIndex struct {
+	current atomic.Pointer[VectorCollection]
+}
+
+// InitVectorIndex 按配置打开集合，启动时会再根据 MySQL 中记录的启用集合切换
+func InitVectorIndex(c config.Config, llm LLMProvider) *VectorIndex {
+	client := InitQdrantClient(c.Qdrant)
+	// 关键词检索依赖 content 字段的全文索引，创建失败只影响关键词检索的性能，不阻止启动
+	if err := client.CreateTextIndex(context.Background(), ContentPayloadKey); err != nil {
+		logx.Errorf("创建全文索引失败: %v", err)
+	}
+
+	index := &VectorIndex{}
+	index.Switch(&VectorCollection{
+		Name:           c.Qdrant.CollectionName,
+		EmbeddingModel: EmbeddingModelName(c.LLM),
+		Store:          InitQdrantStore(c.Qdrant, llm),
+		Client:         client,
+	})
+
+	return index
+}
+
+// Current 当前启用的集合
+func (i *VectorIndex) Current() *VectorCollection {
+	return i.current.Load()
+}
+
+// Switch 切换启用的集合，已经开始的请求继续使用原集合
+func (i *VectorIndex) Switch(col *VectorCollection) {
+	i.current.Store(col)
+}
@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+)
+
+type DocumentRepo interface {
+	CreateDocument(ctx context.Context, doc *model.Document) error
+	GetDocumentByDocID(ctx context.Context, docID string) (*model.Document, error)
+	GetDocumentByHash(ctx context.Context, kbID int64, hash string) (*model.Document, error)
+	GetDocumentList(ctx context.Context, kbIDs []int64, offset, limit int) ([]*model.Document, int64, error)
+	CountDocuments(ctx context.Context, kbID int64) (int64, error)
+	UpdateDocument(ctx context.Context, doc *model.Document) error
+	DeleteDocument(ctx context.Context, docID string) error
+	// ListDocumentsAfter 按主键顺序获取 afterID 之后未删除的文档，用于分批重建索引
+	ListDocumentsAfter(ctx context.Context, afterID int64, limit int) ([]*model.Document, error)
+	// ListDocumentsUpdatedSince 获取 since 之后创建、更新或删除的文档，包含已删除的文档
+	ListDocumentsUpdatedSince(ctx context.Context, since int64) ([]*model.Document, error)
+	CountAllDocuments(ctx context.Context) (int64, error)
+}
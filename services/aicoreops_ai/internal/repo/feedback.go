@@ -0,0 +1,11 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+)
+
+type FeedbackRepo interface {
+	UpsertFeedback(ctx context.Context, feedback *model.Feedback) error
+}
@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+)
+
+type FixRunRepo interface {
+	CreateFixRun(ctx context.Context, run *model.FixRun) error
+	GetFixRunByRunID(ctx context.Context, runID string) (*model.FixRun, error)
+	// TransitFixRun 仅当当前状态为 from 时更新状态及其他字段，返回是否更新成功
+	TransitFixRun(ctx context.Context, runID, from, to string, fields map[string]any) (bool, error)
+}
@@ -8,7 +8,12 @@ import (
 
 type HistoryRepo interface {
 	CreateHistory(ctx context.Context, history *model.History) error
+	GetHistoryByID(ctx context.Context, id int64) (*model.History, error)
 	GetHistoryBySessionID(ctx context.Context, sessionID string) ([]*model.History, error)
+	// GetLastQuestion 获取会话中最后一个问题的原问答记录，不存在时返回 nil
+	GetLastQuestion(ctx context.Context, sessionID string) (*model.History, error)
+	GetHistoryPage(ctx context.Context, sessionID string, offset, limit int) ([]*model.History, int64, error)
+	SearchHistory(ctx context.Context, userId int64, keyword string, offset, limit int) ([]*model.HistoryHit, int64, error)
 	GetHistoryList(ctx context.Context, userId int64, offset, limit int) ([]*model.History, error)
 	UpdateHistory(ctx context.Context, history *model.History) error
 	DeleteHistory(ctx context.Context, id int64) error
@@ -9,7 +9,11 @@ import (
 type HistorySessionRepo interface {
 	CreateHistorySession(ctx context.Context, session *model.HistorySession) error
 	GetHistorySessionByID(ctx context.Context, id int64) (*model.HistorySession, error)
+	GetHistorySessionBySessionID(ctx context.Context, sessionID string) (*model.HistorySession, error)
 	GetHistorySessionList(ctx context.Context, userId int64, offset, limit int) ([]*model.HistorySession, error)
 	UpdateHistorySession(ctx context.Context, session *model.HistorySession) error
+	RenameHistorySession(ctx context.Context, sessionID, title string) error
+	UpdateHistorySessionSummary(ctx context.Context, sessionID, summary string, summarizedTurns int) error
+	DeleteHistorySessionBySessionID(ctx context.Context, sessionID string) error
 	DeleteHistorySession(ctx context.Context, sessionId int64) error
 }
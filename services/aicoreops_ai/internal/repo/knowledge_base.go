@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+)
+
+type KnowledgeBaseRepo interface {
+	CreateKnowledgeBase(ctx context.Context, kb *model.KnowledgeBase) error
+	GetKnowledgeBaseByName(ctx context.Context, name string) (*model.KnowledgeBase, error)
+	GetKnowledgeBaseByID(ctx context.Context, id int64) (*model.KnowledgeBase, error)
+	ListKnowledgeBases(ctx context.Context, treeNodeID int64) ([]*model.KnowledgeBase, error)
+	UpdateKnowledgeBase(ctx context.Context, kb *model.KnowledgeBase) error
+	DeleteKnowledgeBase(ctx context.Context, id int64) error
+}
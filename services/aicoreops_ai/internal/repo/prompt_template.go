@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+)
+
+type PromptTemplateRepo interface {
+	CreatePromptTemplate(ctx context.Context, tpl *model.PromptTemplate) error
+	GetPromptTemplate(ctx context.Context, name string, version int) (*model.PromptTemplate, error)
+	GetMaxPromptVersion(ctx context.Context, name string) (int, error)
+	ListPromptTemplates(ctx context.Context, feature string, offset, limit int) ([]*model.PromptTemplate, int64, error)
+	DeletePromptTemplate(ctx context.Context, name string) error
+}
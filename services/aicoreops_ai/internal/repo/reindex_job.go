@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+)
+
+type ReindexJobRepo interface {
+	CreateReindexJob(ctx context.Context, job *model.ReindexJob) error
+	GetReindexJob(ctx context.Context, id int64) (*model.ReindexJob, error)
+	GetLatestReindexJob(ctx context.Context) (*model.ReindexJob, error)
+	ListReindexJobs(ctx context.Context, statuses []string) ([]*model.ReindexJob, error)
+	// ClaimReindexJob 仅当任务处于 from 中的状态，或已是 to 状态但 staleBefore 之后没有进度时改为 to，返回是否抢占成功
+	ClaimReindexJob(ctx context.Context, id int64, from []string, to string, staleBefore int64) (bool, error)
+	UpdateReindexJob(ctx context.Context, id int64, fields map[string]any) error
+}
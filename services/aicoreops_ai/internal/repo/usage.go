@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+)
+
+type UsageRepo interface {
+	SumUserTokens(ctx context.Context, userId int64, since int64) (int64, error)
+	GetUsageStats(ctx context.Context, userId int64, start, end int64) ([]*model.UsageStat, error)
+}
@@ -0,0 +1,13 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/model"
+)
+
+type VectorCollectionRepo interface {
+	GetActiveCollection(ctx context.Context) (*model.VectorCollection, error)
+	// ActivateCollection 将集合设为唯一启用的集合，集合记录不存在时创建
+	ActivateCollection(ctx context.Context, name, embeddingModel string) error
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/logic"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+)
+
+type AlertRuleServer struct {
+	svcCtx *svc.ServiceContext
+	types.UnimplementedAlertRuleAssistantServer
+}
+
+func NewAlertRuleServer(svcCtx *svc.ServiceContext) *AlertRuleServer {
+	return &AlertRuleServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// GenerateAlertRule 根据自然语言描述生成告警规则
+func (s *AlertRuleServer) GenerateAlertRule(ctx context.Context, req *types.GenerateAlertRuleRequest) (*types.GenerateAlertRuleResponse, error) {
+	l := logic.NewAlertRuleLogic(ctx, s.svcCtx)
+	return l.GenerateAlertRule(req)
+}
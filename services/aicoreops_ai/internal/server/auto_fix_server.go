@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/logic"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+)
+
+type AutoFixServer struct {
+	svcCtx *svc.ServiceContext
+	types.UnimplementedAutoFixServer
+}
+
+func NewAutoFixServer(svcCtx *svc.ServiceContext) *AutoFixServer {
+	return &AutoFixServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// FixTask 提交修复任务
+func (s *AutoFixServer) FixTask(ctx context.Context, req *types.FixTaskRequest) (*types.FixTaskResponse, error) {
+	l := logic.NewAutoFixLogic(ctx, s.svcCtx)
+	return l.FixTask(req)
+}
+
+// GetFixRun 查询修复任务
+func (s *AutoFixServer) GetFixRun(ctx context.Context, req *types.GetFixRunRequest) (*types.GetFixRunResponse, error) {
+	l := logic.NewAutoFixLogic(ctx, s.svcCtx)
+	return l.GetFixRun(req)
+}
+
+// ApproveFixRun 审批修复任务
+func (s *AutoFixServer) ApproveFixRun(ctx context.Context, req *types.ApproveFixRunRequest) (*types.ApproveFixRunResponse, error) {
+	l := logic.NewAutoFixLogic(ctx, s.svcCtx)
+	return l.ApproveFixRun(req)
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/logic"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+)
+
+type KnowledgeBaseServer struct {
+	svcCtx *svc.ServiceContext
+	types.UnimplementedKnowledgeBaseServiceServer
+}
+
+func NewKnowledgeBaseServer(svcCtx *svc.ServiceContext) *KnowledgeBaseServer {
+	return &KnowledgeBaseServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// CreateKnowledgeBase 创建知识库
+func (s *KnowledgeBaseServer) CreateKnowledgeBase(ctx context.Context, req *types.CreateKnowledgeBaseRequest) (*types.CreateKnowledgeBaseResponse, error) {
+	l := logic.NewKnowledgeBaseLogic(ctx, s.svcCtx)
+	return l.CreateKnowledgeBase(req)
+}
+
+// UpdateKnowledgeBase 更新知识库
+func (s *KnowledgeBaseServer) UpdateKnowledgeBase(ctx context.Context, req *types.UpdateKnowledgeBaseRequest) (*types.UpdateKnowledgeBaseResponse, error) {
+	l := logic.NewKnowledgeBaseLogic(ctx, s.svcCtx)
+	return l.UpdateKnowledgeBase(req)
+}
+
+// ListKnowledgeBases 获取知识库列表
+func (s *KnowledgeBaseServer) ListKnowledgeBases(ctx context.Context, req *types.ListKnowledgeBasesRequest) (*types.ListKnowledgeBasesResponse, error) {
+	l := logic.NewKnowledgeBaseLogic(ctx, s.svcCtx)
+	return l.ListKnowledgeBases(req)
+}
+
+// DeleteKnowledgeBase 删除知识库
+func (s *KnowledgeBaseServer) DeleteKnowledgeBase(ctx context.Context, req *types.DeleteKnowledgeBaseRequest) (*types.DeleteKnowledgeBaseResponse, error) {
+	l := logic.NewKnowledgeBaseLogic(ctx, s.svcCtx)
+	return l.DeleteKnowledgeBase(req)
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/logic"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+)
+
+type LogAnalysisServer struct {
+	svcCtx *svc.ServiceContext
+	types.UnimplementedLogAnalysisServer
+}
+
+func NewLogAnalysisServer(svcCtx *svc.ServiceContext) *LogAnalysisServer {
+	return &LogAnalysisServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// AnalyzeLogs 分析日志
+func (s *LogAnalysisServer) AnalyzeLogs(ctx context.Context, req *types.AnalyzeLogsRequest) (*types.AnalyzeLogsResponse, error) {
+	l := logic.NewLogAnalysisLogic(ctx, s.svcCtx)
+	return l.AnalyzeLogs(req)
+}
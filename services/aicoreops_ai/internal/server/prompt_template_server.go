@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/logic"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+)
+
+type PromptTemplateServer struct {
+	svcCtx *svc.ServiceContext
+	types.UnimplementedPromptTemplateServiceServer
+}
+
+func NewPromptTemplateServer(svcCtx *svc.ServiceContext) *PromptTemplateServer {
+	return &PromptTemplateServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// CreatePromptTemplate 创建提示词模板
+func (s *PromptTemplateServer) CreatePromptTemplate(ctx context.Context, req *types.CreatePromptTemplateRequest) (*types.CreatePromptTemplateResponse, error) {
+	l := logic.NewPromptTemplateLogic(ctx, s.svcCtx)
+	return l.CreatePromptTemplate(req)
+}
+
+// UpdatePromptTemplate 更新提示词模板
+func (s *PromptTemplateServer) UpdatePromptTemplate(ctx context.Context, req *types.UpdatePromptTemplateRequest) (*types.UpdatePromptTemplateResponse, error) {
+	l := logic.NewPromptTemplateLogic(ctx, s.svcCtx)
+	return l.UpdatePromptTemplate(req)
+}
+
+// GetPromptTemplate 获取提示词模板
+func (s *PromptTemplateServer) GetPromptTemplate(ctx context.Context, req *types.GetPromptTemplateRequest) (*types.GetPromptTemplateResponse, error) {
+	l := logic.NewPromptTemplateLogic(ctx, s.svcCtx)
+	return l.GetPromptTemplate(req)
+}
+
+// ListPromptTemplates 获取提示词模板列表
+func (s *PromptTemplateServer) ListPromptTemplates(ctx context.Context, req *types.ListPromptTemplatesRequest) (*types.ListPromptTemplatesResponse, error) {
+	l := logic.NewPromptTemplateLogic(ctx, s.svcCtx)
+	return l.ListPromptTemplates(req)
+}
+
+// DeletePromptTemplate 删除提示词模板
+func (s *PromptTemplateServer) DeletePromptTemplate(ctx context.Context, req *types.DeletePromptTemplateRequest) (*types.DeletePromptTemplateResponse, error) {
+	l := logic.NewPromptTemplateLogic(ctx, s.svcCtx)
+	return l.DeletePromptTemplate(req)
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/logic"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
+)
+
+type VectorIndexServer struct {
+	svcCtx *svc.ServiceContext
+	types.UnimplementedVectorIndexServiceServer
+}
+
+func NewVectorIndexServer(svcCtx *svc.ServiceContext) *VectorIndexServer {
+	return &VectorIndexServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// StartReindex 发起重建索引
+func (s *VectorIndexServer) StartReindex(ctx context.Context, req *types.StartReindexRequest) (*types.StartReindexResponse, error) {
+	l := logic.NewReindexLogic(ctx, s.svcCtx)
+	return l.StartReindex(req)
+}
+
+// GetReindexStatus 查询启用集合与重建进度
+func (s *VectorIndexServer) GetReindexStatus(ctx context.Context, req *types.GetReindexStatusRequest) (*types.GetReindexStatusResponse, error) {
+	l := logic.NewReindexLogic(ctx, s.svcCtx)
+	return l.GetReindexStatus(req)
+}
@@ -37,14 +37,74 @@ func (s *AicoreopsAiServer) GetChatHistory(ctx context.Context, req *types.GetCh
 	return l.GetChatHistory(req)
 }
 
+// RenameChat 重命名会话
+func (s *AicoreopsAiServer) RenameChat(ctx context.Context, req *types.RenameChatRequest) (*types.RenameChatResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.RenameChat(req)
+}
+
+// DeleteChat 删除会话
+func (s *AicoreopsAiServer) DeleteChat(ctx context.Context, req *types.DeleteChatRequest) (*types.DeleteChatResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.DeleteChat(req)
+}
+
+// SearchChats 搜索历史问答
+func (s *AicoreopsAiServer) SearchChats(ctx context.Context, req *types.SearchChatsRequest) (*types.SearchChatsResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.SearchChats(req)
+}
+
+// ExportChat 导出会话
+func (s *AicoreopsAiServer) ExportChat(ctx context.Context, req *types.ExportChatRequest) (*types.ExportChatResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.ExportChat(req)
+}
+
+// GetUsageStats 查询 token 用量
+func (s *AicoreopsAiServer) GetUsageStats(ctx context.Context, req *types.GetUsageStatsRequest) (*types.GetUsageStatsResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.GetUsageStats(req)
+}
+
 // UploadDocument 上传文档
 func (s *AicoreopsAiServer) UploadDocument(ctx context.Context, req *types.UploadDocumentRequest) (*types.UploadDocumentResponse, error) {
 	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
 	return l.UploadDocument(req)
 }
 
+// GetDocList 获取文档列表
+func (s *AicoreopsAiServer) GetDocList(ctx context.Context, req *types.GetDocListRequest) (*types.GetDocListResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.GetDocList(req)
+}
+
+// GetDocument 获取文档详情
+func (s *AicoreopsAiServer) GetDocument(ctx context.Context, req *types.GetDocumentRequest) (*types.GetDocumentResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.GetDocument(req)
+}
+
+// UpdateDocument 更新文档
+func (s *AicoreopsAiServer) UpdateDocument(ctx context.Context, req *types.UpdateDocumentRequest) (*types.UpdateDocumentResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.UpdateDocument(req)
+}
+
+// DeleteDocument 删除文档
+func (s *AicoreopsAiServer) DeleteDocument(ctx context.Context, req *types.DeleteDocumentRequest) (*types.DeleteDocumentResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.DeleteDocument(req)
+}
+
 // AskQuestion AI问答
 func (s *AicoreopsAiServer) AskQuestion(stream types.AIHelper_AskQuestionServer) error {
 	l := logic.NewAIHelperLogic(stream.Context(), s.svcCtx)
 	return l.AskQuestion(stream)
 }
+
+// SubmitFeedback 对回答提交反馈
+func (s *AicoreopsAiServer) SubmitFeedback(ctx context.Context, req *types.SubmitFeedbackRequest) (*types.SubmitFeedbackResponse, error) {
+	l := logic.NewAIHelperLogic(ctx, s.svcCtx)
+	return l.SubmitFeedback(req)
+}
@@ -1,37 +1,55 @@
 package svc
 
 import (
-	"sync"
-
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_common/types/prometheus"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_common/types/tree"
 	"gorm.io/gorm"
-
-	"github.com/tmc/langchaingo/llms/ollama"
-	"github.com/tmc/langchaingo/memory"
-	"github.com/tmc/langchaingo/vectorstores/qdrant"
 )
 
 type ServiceContext struct {
-	Config    config.Config
-	LLM       *ollama.LLM
-	Qdrant    *qdrant.Store
-	DB        *gorm.DB
-	MemoryBuf map[string]*memory.ConversationTokenBuffer
-	Mutex     *sync.RWMutex
+	Config config.Config
+	LLM    pkg.LLMProvider
+	// VectorIndex 当前启用的向量集合，重建索引完成后切换
+	VectorIndex *pkg.VectorIndex
+	DB          *gorm.DB
+	MemoryStore pkg.SessionMemoryStore
+	// SemanticCache 未启用时为 nil
+	SemanticCache *pkg.SemanticCache
+	// Redactor 未启用脱敏时为 nil
+	Redactor *pkg.Redactor
+	K8s      *pkg.K8sClient
+	// Agent 工具依赖的下游服务，未配置时为 nil
+	PrometheusRpc prometheus.PrometheusRpcClient
+	TreeRpc       tree.ResourceTreeServiceClient
+	EcsRpc        tree.EcsServiceClient
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	llm := pkg.InitLLM(c.LLM)
-	store := pkg.InitQdrantStore(c.Qdrant, llm)
 	db := pkg.InitDB(c.MySQL)
+	memoryStore := pkg.InitSessionMemoryStore(c, llm)
+	k8sClient := pkg.InitK8sClient(c.K8s)
+
+	svcCtx := &ServiceContext{
+		Config:        c,
+		LLM:           llm,
+		VectorIndex:   pkg.InitVectorIndex(c, llm),
+		DB:            db,
+		MemoryStore:   memoryStore,
+		SemanticCache: pkg.InitSemanticCache(c.SemanticCache),
+		Redactor:      pkg.InitRedactor(c.Redaction),
+		K8s:           k8sClient,
+	}
 
-	return &ServiceContext{
-		Config:    c,
-		LLM:       llm,
-		Qdrant:    store,
-		DB:        db,
-		MemoryBuf: make(map[string]*memory.ConversationTokenBuffer),
-		Mutex:     &sync.RWMutex{},
+	if client := pkg.InitRpcClient(c.PrometheusRpc); client != nil {
+		svcCtx.PrometheusRpc = prometheus.NewPrometheusRpcClient(client.Conn())
+	}
+	if client := pkg.InitRpcClient(c.TreeRpc); client != nil {
+		svcCtx.TreeRpc = tree.NewResourceTreeServiceClient(client.Conn())
+		svcCtx.EcsRpc = tree.NewEcsServiceClient(client.Conn())
 	}
+
+	return svcCtx
 }
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/config"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/logic"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/server"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/internal/svc"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_ai/types"
@@ -24,9 +26,18 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
+	if err := logic.NewReindexLogic(context.Background(), ctx).Bootstrap(); err != nil {
+		panic(err)
+	}
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		types.RegisterAIHelperServer(grpcServer, server.NewAicoreopsAiServer(ctx))
+		types.RegisterLogAnalysisServer(grpcServer, server.NewLogAnalysisServer(ctx))
+		types.RegisterAutoFixServer(grpcServer, server.NewAutoFixServer(ctx))
+		types.RegisterPromptTemplateServiceServer(grpcServer, server.NewPromptTemplateServer(ctx))
+		types.RegisterAlertRuleAssistantServer(grpcServer, server.NewAlertRuleServer(ctx))
+		types.RegisterKnowledgeBaseServiceServer(grpcServer, server.NewKnowledgeBaseServer(ctx))
+		types.RegisterVectorIndexServiceServer(grpcServer, server.NewVectorIndexServer(ctx))
 
 		if c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
@@ -76,6 +76,125 @@ func (x *HistorySession) GetTitle() string {
 	return ""
 }
 
+type Document struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocId         string `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"`                          // 文档ID
+	Title         string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                                       // 文档标题
+	OwnerId       int64  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                   // 上传者ID
+	ContentHash   string `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`        // 内容哈希
+	ChunkCount    int32  `protobuf:"varint,5,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`          // 分块数量
+	Content       string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`                                   // 文档内容，列表接口不返回
+	CreateTime    int64  `protobuf:"varint,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`          // 创建时间
+	UpdateTime    int64  `protobuf:"varint,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`          // 更新时间
+	Format        string `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`                                     // 文档格式
+	KnowledgeBase string `protobuf:"bytes,10,opt,name=knowledge_base,json=knowledgeBase,proto3" json:"knowledge_base,omitempty"` // 所属知识库，为空表示公共知识库
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Document) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *Document) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Document) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Document) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *Document) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *Document) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Document) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *Document) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *Document) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Document) GetKnowledgeBase() string {
+	if x != nil {
+		return x.KnowledgeBase
+	}
+	return ""
+}
+
 // ----------------------- AI 助手相关消息 -----------------------
 // 创建新会话
 type CreateNewChatRequest struct {
@@ -87,7 +206,7 @@ type CreateNewChatRequest struct {
 func (x *CreateNewChatRequest) Reset() {
 	*x = CreateNewChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[1]
+		mi := &file_aicoreops_ai_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -100,7 +219,7 @@ func (x *CreateNewChatRequest) String() string {
 func (*CreateNewChatRequest) ProtoMessage() {}
 
 func (x *CreateNewChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[1]
+	mi := &file_aicoreops_ai_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -113,7 +232,7 @@ func (x *CreateNewChatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNewChatRequest.ProtoReflect.Descriptor instead.
 func (*CreateNewChatRequest) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{1}
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{2}
 }
 
 type CreateNewChatResponse struct {
@@ -129,7 +248,7 @@ type CreateNewChatResponse struct {
 func (x *CreateNewChatResponse) Reset() {
 	*x = CreateNewChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[2]
+		mi := &file_aicoreops_ai_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -142,7 +261,7 @@ func (x *CreateNewChatResponse) String() string {
 func (*CreateNewChatResponse) ProtoMessage() {}
 
 func (x *CreateNewChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[2]
+	mi := &file_aicoreops_ai_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +274,7 @@ func (x *CreateNewChatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNewChatResponse.ProtoReflect.Descriptor instead.
 func (*CreateNewChatResponse) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{2}
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateNewChatResponse) GetCode() int32 {
@@ -185,17 +304,25 @@ type AskQuestionRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title          string  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                                           // 用于指定文档检索
-	Question       string  `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`                                     // 用户提问内容
-	SessionId      string  `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                  // 会话ID,用于追踪对话上下文
-	ScoreThreshold float32 `protobuf:"fixed32,4,opt,name=score_threshold,json=scoreThreshold,proto3" json:"score_threshold,omitempty"` // 用于指定文档检索的相似度阈值
-	TopK           int32   `protobuf:"varint,5,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`                                // 用于指定文档检索的返回数量
+	Title          string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                                           // 用于指定文档检索
+	Question       string   `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`                                     // 用户提问内容
+	SessionId      string   `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                  // 会话ID,用于追踪对话上下文
+	ScoreThreshold float32  `protobuf:"fixed32,4,opt,name=score_threshold,json=scoreThreshold,proto3" json:"score_threshold,omitempty"` // 用于指定文档检索的相似度阈值
+	TopK           int32    `protobuf:"varint,5,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`                                // 用于指定文档检索的返回数量
+	RetrievalMode  string   `protobuf:"bytes,6,opt,name=retrieval_mode,json=retrievalMode,proto3" json:"retrieval_mode,omitempty"`      // 检索模式: vector（默认）/keyword/hybrid
+	Rerank         bool     `protobuf:"varint,7,opt,name=rerank,proto3" json:"rerank,omitempty"`                                        // 是否使用大模型对检索结果重排序
+	Agent          bool     `protobuf:"varint,8,opt,name=agent,proto3" json:"agent,omitempty"`                                          // Agent 模式，允许大模型调用平台工具查询实时状态
+	PromptTemplate string   `protobuf:"bytes,9,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`   // 提示词模板名称，为空时使用内置模板
+	KnowledgeBases []string `protobuf:"bytes,10,rep,name=knowledge_bases,json=knowledgeBases,proto3" json:"knowledge_bases,omitempty"`  // 检索的知识库，为空时检索所有有权读取的知识库
+	Action         string   `protobuf:"bytes,11,opt,name=action,proto3" json:"action,omitempty"`                                        // 控制指令：为空时提问；stop 停止生成中的回答并保存已生成的部分；regenerate 重新生成上一个问题的回答
+	Temperature    float32  `protobuf:"fixed32,12,opt,name=temperature,proto3" json:"temperature,omitempty"`                            // 采样温度，0 表示使用模型默认值，Agent 模式不生效
+	TopP           float32  `protobuf:"fixed32,13,opt,name=top_p,json=topP,proto3" json:"top_p,omitempty"`                              // 核采样概率，0 表示使用模型默认值，Agent 模式不生效
 }
 
 func (x *AskQuestionRequest) Reset() {
 	*x = AskQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[3]
+		mi := &file_aicoreops_ai_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -208,7 +335,7 @@ func (x *AskQuestionRequest) String() string {
 func (*AskQuestionRequest) ProtoMessage() {}
 
 func (x *AskQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[3]
+	mi := &file_aicoreops_ai_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +348,7 @@ func (x *AskQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AskQuestionRequest.ProtoReflect.Descriptor instead.
 func (*AskQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{3}
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AskQuestionRequest) GetTitle() string {
@@ -259,6 +386,62 @@ func (x *AskQuestionRequest) GetTopK() int32 {
 	return 0
 }
 
+func (x *AskQuestionRequest) GetRetrievalMode() string {
+	if x != nil {
+		return x.RetrievalMode
+	}
+	return ""
+}
+
+func (x *AskQuestionRequest) GetRerank() bool {
+	if x != nil {
+		return x.Rerank
+	}
+	return false
+}
+
+func (x *AskQuestionRequest) GetAgent() bool {
+	if x != nil {
+		return x.Agent
+	}
+	return false
+}
+
+func (x *AskQuestionRequest) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *AskQuestionRequest) GetKnowledgeBases() []string {
+	if x != nil {
+		return x.KnowledgeBases
+	}
+	return nil
+}
+
+func (x *AskQuestionRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AskQuestionRequest) GetTemperature() float32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *AskQuestionRequest) GetTopP() float32 {
+	if x != nil {
+		return x.TopP
+	}
+	return 0
+}
+
 type AskQuestionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -272,7 +455,7 @@ type AskQuestionResponse struct {
 func (x *AskQuestionResponse) Reset() {
 	*x = AskQuestionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[4]
+		mi := &file_aicoreops_ai_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -285,7 +468,7 @@ func (x *AskQuestionResponse) String() string {
 func (*AskQuestionResponse) ProtoMessage() {}
 
 func (x *AskQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[4]
+	mi := &file_aicoreops_ai_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +481,7 @@ func (x *AskQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AskQuestionResponse.ProtoReflect.Descriptor instead.
 func (*AskQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{4}
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AskQuestionResponse) GetCode() int32 {
@@ -322,33 +505,33 @@ func (x *AskQuestionResponse) GetData() *AskQuestionResponse_AnswerData {
 	return nil
 }
 
-// 获取会话列表
-type GetChatListRequest struct {
+// 脱敏报告，记录命中的规则及次数，不包含原文
+type Redaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	Rule  string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`    // 规则名称，如 aws_access_key、email、high_entropy
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // 命中次数
 }
 
-func (x *GetChatListRequest) Reset() {
-	*x = GetChatListRequest{}
+func (x *Redaction) Reset() {
+	*x = Redaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[5]
+		mi := &file_aicoreops_ai_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChatListRequest) String() string {
+func (x *Redaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatListRequest) ProtoMessage() {}
+func (*Redaction) ProtoMessage() {}
 
-func (x *GetChatListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[5]
+func (x *Redaction) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,52 +542,53 @@ func (x *GetChatListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChatListRequest.ProtoReflect.Descriptor instead.
-func (*GetChatListRequest) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Redaction.ProtoReflect.Descriptor instead.
+func (*Redaction) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetChatListRequest) GetPage() int32 {
+func (x *Redaction) GetRule() string {
 	if x != nil {
-		return x.Page
+		return x.Rule
 	}
-	return 0
+	return ""
 }
 
-func (x *GetChatListRequest) GetPageSize() int32 {
+func (x *Redaction) GetCount() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Count
 	}
 	return 0
 }
 
-type GetChatListResponse struct {
+// Agent 发起的工具调用
+type ToolCall struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    int32             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
-	Message string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
-	Data    []*HistorySession `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`               // 调用ID
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`           // 工具名称
+	Arguments string `protobuf:"bytes,3,opt,name=arguments,proto3" json:"arguments,omitempty"` // 调用参数(JSON)
 }
 
-func (x *GetChatListResponse) Reset() {
-	*x = GetChatListResponse{}
+func (x *ToolCall) Reset() {
+	*x = ToolCall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[6]
+		mi := &file_aicoreops_ai_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChatListResponse) String() string {
+func (x *ToolCall) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatListResponse) ProtoMessage() {}
+func (*ToolCall) ProtoMessage() {}
 
-func (x *GetChatListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[6]
+func (x *ToolCall) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,59 +599,61 @@ func (x *GetChatListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChatListResponse.ProtoReflect.Descriptor instead.
-func (*GetChatListResponse) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ToolCall.ProtoReflect.Descriptor instead.
+func (*ToolCall) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetChatListResponse) GetCode() int32 {
+func (x *ToolCall) GetId() string {
 	if x != nil {
-		return x.Code
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetChatListResponse) GetMessage() string {
+func (x *ToolCall) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetChatListResponse) GetData() []*HistorySession {
+func (x *ToolCall) GetArguments() string {
 	if x != nil {
-		return x.Data
+		return x.Arguments
 	}
-	return nil
+	return ""
 }
 
-type GetChatHistoryRequest struct {
+// 工具调用结果
+type ToolResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
-	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                           // 页码
-	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 每页数量
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                           // 对应的调用ID
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                       // 工具名称
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                 // 结果内容
+	IsError bool   `protobuf:"varint,4,opt,name=is_error,json=isError,proto3" json:"is_error,omitempty"` // 是否调用失败
 }
 
-func (x *GetChatHistoryRequest) Reset() {
-	*x = GetChatHistoryRequest{}
+func (x *ToolResult) Reset() {
+	*x = ToolResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[7]
+		mi := &file_aicoreops_ai_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChatHistoryRequest) String() string {
+func (x *ToolResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatHistoryRequest) ProtoMessage() {}
+func (*ToolResult) ProtoMessage() {}
 
-func (x *GetChatHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[7]
+func (x *ToolResult) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,60 +664,70 @@ func (x *GetChatHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChatHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetChatHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ToolResult.ProtoReflect.Descriptor instead.
+func (*ToolResult) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetChatHistoryRequest) GetSessionId() string {
+func (x *ToolResult) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetChatHistoryRequest) GetPage() int32 {
+func (x *ToolResult) GetName() string {
 	if x != nil {
-		return x.Page
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetChatHistoryRequest) GetPageSize() int32 {
+func (x *ToolResult) GetContent() string {
 	if x != nil {
-		return x.PageSize
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-// 获取具体会话历史
-type GetChatHistoryResponse struct {
+func (x *ToolResult) GetIsError() bool {
+	if x != nil {
+		return x.IsError
+	}
+	return false
+}
+
+// 回答引用的文档分块
+type Citation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    int32                                   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
-	Message string                                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
-	Data    *GetChatHistoryResponse_ChatHistoryData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+	Title       string  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                                  // 文档标题
+	ChunkId     string  `protobuf:"bytes,2,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`               // 分块ID，格式为 doc_id:chunk_index
+	Score       float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`                                // 相似度得分，混合检索时为向量相似度，仅被关键词检索命中时为 BM25 得分
+	Snippet     string  `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`                              // 分块内容摘要
+	Section     string  `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`                              // 分块所在章节
+	FusionScore float32 `protobuf:"fixed32,6,opt,name=fusion_score,json=fusionScore,proto3" json:"fusion_score,omitempty"` // 混合检索的倒数排名融合得分，其他检索模式为 0
 }
 
-func (x *GetChatHistoryResponse) Reset() {
-	*x = GetChatHistoryResponse{}
+func (x *Citation) Reset() {
+	*x = Citation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[8]
+		mi := &file_aicoreops_ai_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChatHistoryResponse) String() string {
+func (x *Citation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatHistoryResponse) ProtoMessage() {}
+func (*Citation) ProtoMessage() {}
 
-func (x *GetChatHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[8]
+func (x *Citation) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,59 +738,80 @@ func (x *GetChatHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChatHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetChatHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Citation.ProtoReflect.Descriptor instead.
+func (*Citation) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetChatHistoryResponse) GetCode() int32 {
+func (x *Citation) GetTitle() string {
 	if x != nil {
-		return x.Code
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *GetChatHistoryResponse) GetMessage() string {
+func (x *Citation) GetChunkId() string {
 	if x != nil {
-		return x.Message
+		return x.ChunkId
 	}
 	return ""
 }
 
-func (x *GetChatHistoryResponse) GetData() *GetChatHistoryResponse_ChatHistoryData {
+func (x *Citation) GetScore() float32 {
 	if x != nil {
-		return x.Data
+		return x.Score
 	}
-	return nil
+	return 0
 }
 
-// 文档管理相关
-type UploadDocumentRequest struct {
+func (x *Citation) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *Citation) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Citation) GetFusionScore() float32 {
+	if x != nil {
+		return x.FusionScore
+	}
+	return 0
+}
+
+// 获取会话列表
+type GetChatListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`     // 文档标题
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // 文档内容
+	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
 }
 
-func (x *UploadDocumentRequest) Reset() {
-	*x = UploadDocumentRequest{}
+func (x *GetChatListRequest) Reset() {
+	*x = GetChatListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[9]
+		mi := &file_aicoreops_ai_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadDocumentRequest) String() string {
+func (x *GetChatListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadDocumentRequest) ProtoMessage() {}
+func (*GetChatListRequest) ProtoMessage() {}
 
-func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[9]
+func (x *GetChatListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,53 +822,52 @@ func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
-func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetChatListRequest.ProtoReflect.Descriptor instead.
+func (*GetChatListRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UploadDocumentRequest) GetTitle() string {
+func (x *GetChatListRequest) GetPage() int32 {
 	if x != nil {
-		return x.Title
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UploadDocumentRequest) GetContent() string {
+func (x *GetChatListRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Content
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// 上传文档
-type UploadDocumentResponse struct {
+type GetChatListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    int32                           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
-	Message string                          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
-	Data    *UploadDocumentResponse_DocData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+	Code    int32             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    []*HistorySession `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`       // 返回的数据
 }
 
-func (x *UploadDocumentResponse) Reset() {
-	*x = UploadDocumentResponse{}
+func (x *GetChatListResponse) Reset() {
+	*x = GetChatListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[10]
+		mi := &file_aicoreops_ai_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadDocumentResponse) String() string {
+func (x *GetChatListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadDocumentResponse) ProtoMessage() {}
+func (*GetChatListResponse) ProtoMessage() {}
 
-func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[10]
+func (x *GetChatListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,59 +878,59 @@ func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadDocumentResponse.ProtoReflect.Descriptor instead.
-func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetChatListResponse.ProtoReflect.Descriptor instead.
+func (*GetChatListResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UploadDocumentResponse) GetCode() int32 {
+func (x *GetChatListResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *UploadDocumentResponse) GetMessage() string {
+func (x *GetChatListResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *UploadDocumentResponse) GetData() *UploadDocumentResponse_DocData {
+func (x *GetChatListResponse) GetData() []*HistorySession {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// 获取文档列表
-type GetDocListRequest struct {
+type GetChatHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                           // 页码
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 每页数量
 }
 
-func (x *GetDocListRequest) Reset() {
-	*x = GetDocListRequest{}
+func (x *GetChatHistoryRequest) Reset() {
+	*x = GetChatHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[11]
+		mi := &file_aicoreops_ai_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDocListRequest) String() string {
+func (x *GetChatHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDocListRequest) ProtoMessage() {}
+func (*GetChatHistoryRequest) ProtoMessage() {}
 
-func (x *GetDocListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[11]
+func (x *GetChatHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,52 +941,60 @@ func (x *GetDocListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDocListRequest.ProtoReflect.Descriptor instead.
-func (*GetDocListRequest) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetChatHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetChatHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetDocListRequest) GetPage() int32 {
+func (x *GetChatHistoryRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetChatHistoryRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetDocListRequest) GetPageSize() int32 {
+func (x *GetChatHistoryRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-type GetDocListResponse struct {
+// 获取具体会话历史
+type GetChatHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    int32                         `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
-	Message string                        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
-	Data    []*GetDocListResponse_DocData `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+	Code    int32                                   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string                                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *GetChatHistoryResponse_ChatHistoryData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
 }
 
-func (x *GetDocListResponse) Reset() {
-	*x = GetDocListResponse{}
+func (x *GetChatHistoryResponse) Reset() {
+	*x = GetChatHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[12]
+		mi := &file_aicoreops_ai_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDocListResponse) String() string {
+func (x *GetChatHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDocListResponse) ProtoMessage() {}
+func (*GetChatHistoryResponse) ProtoMessage() {}
 
-func (x *GetDocListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[12]
+func (x *GetChatHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,58 +1005,60 @@ func (x *GetDocListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDocListResponse.ProtoReflect.Descriptor instead.
-func (*GetDocListResponse) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetChatHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetChatHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetDocListResponse) GetCode() int32 {
+func (x *GetChatHistoryResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetDocListResponse) GetMessage() string {
+func (x *GetChatHistoryResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetDocListResponse) GetData() []*GetDocListResponse_DocData {
+func (x *GetChatHistoryResponse) GetData() *GetChatHistoryResponse_ChatHistoryData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// ----------------------- 日志分析相关消息 -----------------------
-type AnalyzeLogsRequest struct {
+// 对回答的反馈
+type SubmitFeedbackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LogData string `protobuf:"bytes,1,opt,name=log_data,json=logData,proto3" json:"log_data,omitempty"` // 提交的日志内容
+	HistoryId int64  `protobuf:"varint,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"` // 问答记录ID
+	Rating    int32  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`                        // 评分，1-5
+	Comment   string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`                       // 补充说明
 }
 
-func (x *AnalyzeLogsRequest) Reset() {
-	*x = AnalyzeLogsRequest{}
+func (x *SubmitFeedbackRequest) Reset() {
+	*x = SubmitFeedbackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[13]
+		mi := &file_aicoreops_ai_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnalyzeLogsRequest) String() string {
+func (x *SubmitFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeLogsRequest) ProtoMessage() {}
+func (*SubmitFeedbackRequest) ProtoMessage() {}
 
-func (x *AnalyzeLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[13]
+func (x *SubmitFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,45 +1069,58 @@ func (x *AnalyzeLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeLogsRequest.ProtoReflect.Descriptor instead.
-func (*AnalyzeLogsRequest) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SubmitFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*SubmitFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AnalyzeLogsRequest) GetLogData() string {
+func (x *SubmitFeedbackRequest) GetHistoryId() int64 {
 	if x != nil {
-		return x.LogData
+		return x.HistoryId
+	}
+	return 0
+}
+
+func (x *SubmitFeedbackRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitFeedbackRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
 	}
 	return ""
 }
 
-type AnalyzeLogsResponse struct {
+type SubmitFeedbackResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    int32                               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
-	Message string                              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
-	Data    *AnalyzeLogsResponse_SuggestionData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
 }
 
-func (x *AnalyzeLogsResponse) Reset() {
-	*x = AnalyzeLogsResponse{}
+func (x *SubmitFeedbackResponse) Reset() {
+	*x = SubmitFeedbackResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[14]
+		mi := &file_aicoreops_ai_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnalyzeLogsResponse) String() string {
+func (x *SubmitFeedbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeLogsResponse) ProtoMessage() {}
+func (*SubmitFeedbackResponse) ProtoMessage() {}
 
-func (x *AnalyzeLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[14]
+func (x *SubmitFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,59 +1131,52 @@ func (x *AnalyzeLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeLogsResponse.ProtoReflect.Descriptor instead.
-func (*AnalyzeLogsResponse) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SubmitFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*SubmitFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AnalyzeLogsResponse) GetCode() int32 {
+func (x *SubmitFeedbackResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *AnalyzeLogsResponse) GetMessage() string {
+func (x *SubmitFeedbackResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *AnalyzeLogsResponse) GetData() *AnalyzeLogsResponse_SuggestionData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// ----------------------- 自动修复相关消息 -----------------------
-type FixTaskRequest struct {
+// 重命名会话
+type RenameChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Task  string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`                // 修复任务类型（如 fixOOM）
-	PodId string `protobuf:"bytes,2,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"` // Pod ID
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                          // 新标题
 }
 
-func (x *FixTaskRequest) Reset() {
-	*x = FixTaskRequest{}
+func (x *RenameChatRequest) Reset() {
+	*x = RenameChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[15]
+		mi := &file_aicoreops_ai_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FixTaskRequest) String() string {
+func (x *RenameChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FixTaskRequest) ProtoMessage() {}
+func (*RenameChatRequest) ProtoMessage() {}
 
-func (x *FixTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[15]
+func (x *RenameChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,52 +1187,51 @@ func (x *FixTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FixTaskRequest.ProtoReflect.Descriptor instead.
-func (*FixTaskRequest) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RenameChatRequest.ProtoReflect.Descriptor instead.
+func (*RenameChatRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *FixTaskRequest) GetTask() string {
+func (x *RenameChatRequest) GetSessionId() string {
 	if x != nil {
-		return x.Task
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *FixTaskRequest) GetPodId() string {
+func (x *RenameChatRequest) GetTitle() string {
 	if x != nil {
-		return x.PodId
+		return x.Title
 	}
 	return ""
 }
 
-type FixTaskResponse struct {
+type RenameChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    int32                          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
-	Message string                         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
-	Data    *FixTaskResponse_FixStatusData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
 }
 
-func (x *FixTaskResponse) Reset() {
-	*x = FixTaskResponse{}
+func (x *RenameChatResponse) Reset() {
+	*x = RenameChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[16]
+		mi := &file_aicoreops_ai_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FixTaskResponse) String() string {
+func (x *RenameChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FixTaskResponse) ProtoMessage() {}
+func (*RenameChatResponse) ProtoMessage() {}
 
-func (x *FixTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[16]
+func (x *RenameChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,33 +1242,27 @@ func (x *FixTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FixTaskResponse.ProtoReflect.Descriptor instead.
-func (*FixTaskResponse) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RenameChatResponse.ProtoReflect.Descriptor instead.
+func (*RenameChatResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *FixTaskResponse) GetCode() int32 {
+func (x *RenameChatResponse) GetCode() int32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *FixTaskResponse) GetMessage() string {
+func (x *RenameChatResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *FixTaskResponse) GetData() *FixTaskResponse_FixStatusData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type CreateNewChatResponse_SessionData struct {
+// 删除会话（软删除）
+type DeleteChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1045,23 +1270,23 @@ type CreateNewChatResponse_SessionData struct {
 	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
 }
 
-func (x *CreateNewChatResponse_SessionData) Reset() {
-	*x = CreateNewChatResponse_SessionData{}
+func (x *DeleteChatRequest) Reset() {
+	*x = DeleteChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[17]
+		mi := &file_aicoreops_ai_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateNewChatResponse_SessionData) String() string {
+func (x *DeleteChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNewChatResponse_SessionData) ProtoMessage() {}
+func (*DeleteChatRequest) ProtoMessage() {}
 
-func (x *CreateNewChatResponse_SessionData) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[17]
+func (x *DeleteChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,44 +1297,44 @@ func (x *CreateNewChatResponse_SessionData) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNewChatResponse_SessionData.ProtoReflect.Descriptor instead.
-func (*CreateNewChatResponse_SessionData) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use DeleteChatRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChatRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateNewChatResponse_SessionData) GetSessionId() string {
+func (x *DeleteChatRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-type AskQuestionResponse_AnswerData struct {
+type DeleteChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Answer    string `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`                        // AI 助手的回答内容
-	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
 }
 
-func (x *AskQuestionResponse_AnswerData) Reset() {
-	*x = AskQuestionResponse_AnswerData{}
+func (x *DeleteChatResponse) Reset() {
+	*x = DeleteChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[18]
+		mi := &file_aicoreops_ai_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AskQuestionResponse_AnswerData) String() string {
+func (x *DeleteChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AskQuestionResponse_AnswerData) ProtoMessage() {}
+func (*DeleteChatResponse) ProtoMessage() {}
 
-func (x *AskQuestionResponse_AnswerData) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[18]
+func (x *DeleteChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,51 +1345,53 @@ func (x *AskQuestionResponse_AnswerData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AskQuestionResponse_AnswerData.ProtoReflect.Descriptor instead.
-func (*AskQuestionResponse_AnswerData) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use DeleteChatResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChatResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AskQuestionResponse_AnswerData) GetAnswer() string {
+func (x *DeleteChatResponse) GetCode() int32 {
 	if x != nil {
-		return x.Answer
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *AskQuestionResponse_AnswerData) GetSessionId() string {
+func (x *DeleteChatResponse) GetMessage() string {
 	if x != nil {
-		return x.SessionId
+		return x.Message
 	}
 	return ""
 }
 
-type GetChatHistoryResponse_ChatHistoryData struct {
+// 搜索当前用户的问答记录
+type SearchChatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*GetChatHistoryResponse_ChatMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // 聊天记录列表
-	Total    int32                                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`      // 总记录数
+	Keyword  string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                    // 关键字，匹配问题和回答
+	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
 }
 
-func (x *GetChatHistoryResponse_ChatHistoryData) Reset() {
-	*x = GetChatHistoryResponse_ChatHistoryData{}
+func (x *SearchChatsRequest) Reset() {
+	*x = SearchChatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[19]
+		mi := &file_aicoreops_ai_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChatHistoryResponse_ChatHistoryData) String() string {
+func (x *SearchChatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatHistoryResponse_ChatHistoryData) ProtoMessage() {}
+func (*SearchChatsRequest) ProtoMessage() {}
 
-func (x *GetChatHistoryResponse_ChatHistoryData) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[19]
+func (x *SearchChatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,52 +1402,60 @@ func (x *GetChatHistoryResponse_ChatHistoryData) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChatHistoryResponse_ChatHistoryData.ProtoReflect.Descriptor instead.
-func (*GetChatHistoryResponse_ChatHistoryData) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use SearchChatsRequest.ProtoReflect.Descriptor instead.
+func (*SearchChatsRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetChatHistoryResponse_ChatHistoryData) GetMessages() []*GetChatHistoryResponse_ChatMessage {
+func (x *SearchChatsRequest) GetKeyword() string {
 	if x != nil {
-		return x.Messages
+		return x.Keyword
 	}
-	return nil
+	return ""
 }
 
-func (x *GetChatHistoryResponse_ChatHistoryData) GetTotal() int32 {
+func (x *SearchChatsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Total
+		return x.Page
 	}
 	return 0
 }
 
-type GetChatHistoryResponse_ChatMessage struct {
+func (x *SearchChatsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchChatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Question   string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`                        // 问题
-	Answer     string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`                            // 回答
-	CreateTime int64  `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 创建时间
+	Code    int32                                `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string                               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    []*SearchChatsResponse_ChatSearchHit `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+	Total   int64                                `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`    // 总数
 }
 
-func (x *GetChatHistoryResponse_ChatMessage) Reset() {
-	*x = GetChatHistoryResponse_ChatMessage{}
+func (x *SearchChatsResponse) Reset() {
+	*x = SearchChatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[20]
+		mi := &file_aicoreops_ai_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChatHistoryResponse_ChatMessage) String() string {
+func (x *SearchChatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatHistoryResponse_ChatMessage) ProtoMessage() {}
+func (*SearchChatsResponse) ProtoMessage() {}
 
-func (x *GetChatHistoryResponse_ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[20]
+func (x *SearchChatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,57 +1466,66 @@ func (x *GetChatHistoryResponse_ChatMessage) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChatHistoryResponse_ChatMessage.ProtoReflect.Descriptor instead.
-func (*GetChatHistoryResponse_ChatMessage) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use SearchChatsResponse.ProtoReflect.Descriptor instead.
+func (*SearchChatsResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetChatHistoryResponse_ChatMessage) GetQuestion() string {
+func (x *SearchChatsResponse) GetCode() int32 {
 	if x != nil {
-		return x.Question
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *GetChatHistoryResponse_ChatMessage) GetAnswer() string {
+func (x *SearchChatsResponse) GetMessage() string {
 	if x != nil {
-		return x.Answer
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GetChatHistoryResponse_ChatMessage) GetCreateTime() int64 {
+func (x *SearchChatsResponse) GetData() []*SearchChatsResponse_ChatSearchHit {
 	if x != nil {
-		return x.CreateTime
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SearchChatsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-type UploadDocumentResponse_DocData struct {
+// 导出会话
+type ExportChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DocId string `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"` // 文档ID
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	Format    string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                        // 导出格式: markdown（默认）/json
 }
 
-func (x *UploadDocumentResponse_DocData) Reset() {
-	*x = UploadDocumentResponse_DocData{}
+func (x *ExportChatRequest) Reset() {
+	*x = ExportChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[21]
+		mi := &file_aicoreops_ai_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadDocumentResponse_DocData) String() string {
+func (x *ExportChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadDocumentResponse_DocData) ProtoMessage() {}
+func (*ExportChatRequest) ProtoMessage() {}
 
-func (x *UploadDocumentResponse_DocData) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[21]
+func (x *ExportChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,45 +1536,52 @@ func (x *UploadDocumentResponse_DocData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadDocumentResponse_DocData.ProtoReflect.Descriptor instead.
-func (*UploadDocumentResponse_DocData) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use ExportChatRequest.ProtoReflect.Descriptor instead.
+func (*ExportChatRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UploadDocumentResponse_DocData) GetDocId() string {
+func (x *ExportChatRequest) GetSessionId() string {
 	if x != nil {
-		return x.DocId
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ExportChatRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
 	}
 	return ""
 }
 
-type GetDocListResponse_DocData struct {
+type ExportChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DocId   string `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"` // 文档ID
-	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`              // 文档标题
-	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`          // 文档内容 or URL
+	Code    int32                          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string                         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *ExportChatResponse_ExportData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
 }
 
-func (x *GetDocListResponse_DocData) Reset() {
-	*x = GetDocListResponse_DocData{}
+func (x *ExportChatResponse) Reset() {
+	*x = ExportChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[22]
+		mi := &file_aicoreops_ai_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDocListResponse_DocData) String() string {
+func (x *ExportChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDocListResponse_DocData) ProtoMessage() {}
+func (*ExportChatResponse) ProtoMessage() {}
 
-func (x *GetDocListResponse_DocData) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[22]
+func (x *ExportChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,57 +1592,60 @@ func (x *GetDocListResponse_DocData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDocListResponse_DocData.ProtoReflect.Descriptor instead.
-func (*GetDocListResponse_DocData) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{12, 0}
+// Deprecated: Use ExportChatResponse.ProtoReflect.Descriptor instead.
+func (*ExportChatResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetDocListResponse_DocData) GetDocId() string {
+func (x *ExportChatResponse) GetCode() int32 {
 	if x != nil {
-		return x.DocId
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *GetDocListResponse_DocData) GetTitle() string {
+func (x *ExportChatResponse) GetMessage() string {
 	if x != nil {
-		return x.Title
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GetDocListResponse_DocData) GetContent() string {
+func (x *ExportChatResponse) GetData() *ExportChatResponse_ExportData {
 	if x != nil {
-		return x.Content
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type AnalyzeLogsResponse_SuggestionData struct {
+// 查询 token 用量
+type GetUsageStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Suggestion string `protobuf:"bytes,1,opt,name=suggestion,proto3" json:"suggestion,omitempty"` // 修复建议
+	UserId    int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户ID，为 0 时查询自己；管理员传 -1 查询所有用户
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期(含)，格式 2006-01-02，默认 30 天前
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期(含)，默认今天
 }
 
-func (x *AnalyzeLogsResponse_SuggestionData) Reset() {
-	*x = AnalyzeLogsResponse_SuggestionData{}
+func (x *GetUsageStatsRequest) Reset() {
+	*x = GetUsageStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[23]
+		mi := &file_aicoreops_ai_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnalyzeLogsResponse_SuggestionData) String() string {
+func (x *GetUsageStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalyzeLogsResponse_SuggestionData) ProtoMessage() {}
+func (*GetUsageStatsRequest) ProtoMessage() {}
 
-func (x *AnalyzeLogsResponse_SuggestionData) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[23]
+func (x *GetUsageStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1402,43 +1656,60 @@ func (x *AnalyzeLogsResponse_SuggestionData) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalyzeLogsResponse_SuggestionData.ProtoReflect.Descriptor instead.
-func (*AnalyzeLogsResponse_SuggestionData) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use GetUsageStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageStatsRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUsageStatsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUsageStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
 }
 
-func (x *AnalyzeLogsResponse_SuggestionData) GetSuggestion() string {
+func (x *GetUsageStatsRequest) GetEndDate() string {
 	if x != nil {
-		return x.Suggestion
+		return x.EndDate
 	}
 	return ""
 }
 
-type FixTaskResponse_FixStatusData struct {
+type GetUsageStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // 修复任务状态
+	Code    int32                              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string                             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    []*GetUsageStatsResponse_UsageStat `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`       // 按用户和日期聚合的用量
+	Quota   *GetUsageStatsResponse_UsageQuota  `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`     // 查询单个用户时返回其配额使用情况
 }
 
-func (x *FixTaskResponse_FixStatusData) Reset() {
-	*x = FixTaskResponse_FixStatusData{}
+func (x *GetUsageStatsResponse) Reset() {
+	*x = GetUsageStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_aicoreops_ai_proto_msgTypes[24]
+		mi := &file_aicoreops_ai_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FixTaskResponse_FixStatusData) String() string {
+func (x *GetUsageStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FixTaskResponse_FixStatusData) ProtoMessage() {}
+func (*GetUsageStatsResponse) ProtoMessage() {}
 
-func (x *FixTaskResponse_FixStatusData) ProtoReflect() protoreflect.Message {
-	mi := &file_aicoreops_ai_proto_msgTypes[24]
+func (x *GetUsageStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,284 +1720,5926 @@ func (x *FixTaskResponse_FixStatusData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FixTaskResponse_FixStatusData.ProtoReflect.Descriptor instead.
-func (*FixTaskResponse_FixStatusData) Descriptor() ([]byte, []int) {
-	return file_aicoreops_ai_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use GetUsageStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageStatsResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *FixTaskResponse_FixStatusData) GetStatus() string {
+func (x *GetUsageStatsResponse) GetCode() int32 {
 	if x != nil {
-		return x.Status
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetUsageStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-var File_aicoreops_ai_proto protoreflect.FileDescriptor
+func (x *GetUsageStatsResponse) GetData() []*GetUsageStatsResponse_UsageStat {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
 
-var file_aicoreops_ai_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x61, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x69, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x61, 0x69, 0x22, 0x45, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
-	0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x61, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2c, 0x0a, 0x0b, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x6b,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70,
-	0x5f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x22, 0xc0,
-	0x01, 0x0a, 0x13, 0x41, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x41, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x43, 0x0a, 0x0a,
-	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
-	0x68, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x69,
-	0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x67, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xd7,
-	0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x6b, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61,
-	0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x62, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x1a, 0x20, 0x0a, 0x07, 0x44, 0x6f, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x0a,
-	0x06, 0x64, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
-	0x6f, 0x63, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x1a, 0x50, 0x0a, 0x07, 0x44, 0x6f, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15,
-	0x0a, 0x06, 0x64, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x12, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
-	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c,
-	0x6f, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
-	0x6f, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x41, 0x6e, 0x61, 0x6c, 0x79,
-	0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x69, 0x2e,
-	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x30, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x0e, 0x46, 0x69,
-	0x78, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b,
-	0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x46, 0x69, 0x78,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x78,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x27, 0x0a, 0x0d, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xa3, 0x03, 0x0a, 0x08, 0x41, 0x49,
-	0x48, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77,
-	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19,
-	0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
-	0x0a, 0x0b, 0x41, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e,
-	0x61, 0x69, 0x2e, 0x41, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x41, 0x73, 0x6b, 0x51, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01,
-	0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x15, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0x4d, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x3e,
-	0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x16, 0x2e,
-	0x61, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
-	0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x3d,
-	0x0a, 0x07, 0x41, 0x75, 0x74, 0x6f, 0x46, 0x69, 0x78, 0x12, 0x32, 0x0a, 0x07, 0x46, 0x69, 0x78,
-	0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x2e, 0x61, 0x69, 0x2e, 0x46, 0x69, 0x78, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x69, 0x2e, 0x46, 0x69,
-	0x78, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x5a,
-	0x07, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GetUsageStatsResponse) GetQuota() *GetUsageStatsResponse_UsageQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
 }
 
-var (
-	file_aicoreops_ai_proto_rawDescOnce sync.Once
-	file_aicoreops_ai_proto_rawDescData = file_aicoreops_ai_proto_rawDesc
-)
+// 文档管理相关
+type UploadDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_aicoreops_ai_proto_rawDescGZIP() []byte {
-	file_aicoreops_ai_proto_rawDescOnce.Do(func() {
-		file_aicoreops_ai_proto_rawDescData = protoimpl.X.CompressGZIP(file_aicoreops_ai_proto_rawDescData)
-	})
-	return file_aicoreops_ai_proto_rawDescData
+	Title         string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                                      // 文档标题，带扩展名时用于识别格式
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                                  // 文档内容，Base64 编码
+	Format        string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                                    // 文档格式: text/markdown/html/csv/pdf，为空时自动识别
+	KnowledgeBase string `protobuf:"bytes,4,opt,name=knowledge_base,json=knowledgeBase,proto3" json:"knowledge_base,omitempty"` // 写入的知识库，为空时写入公共知识库
 }
 
-var file_aicoreops_ai_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_aicoreops_ai_proto_goTypes = []any{
-	(*HistorySession)(nil),                         // 0: ai.HistorySession
-	(*CreateNewChatRequest)(nil),                   // 1: ai.CreateNewChatRequest
-	(*CreateNewChatResponse)(nil),                  // 2: ai.CreateNewChatResponse
-	(*AskQuestionRequest)(nil),                     // 3: ai.AskQuestionRequest
-	(*AskQuestionResponse)(nil),                    // 4: ai.AskQuestionResponse
-	(*GetChatListRequest)(nil),                     // 5: ai.GetChatListRequest
-	(*GetChatListResponse)(nil),                    // 6: ai.GetChatListResponse
-	(*GetChatHistoryRequest)(nil),                  // 7: ai.GetChatHistoryRequest
-	(*GetChatHistoryResponse)(nil),                 // 8: ai.GetChatHistoryResponse
-	(*UploadDocumentRequest)(nil),                  // 9: ai.UploadDocumentRequest
-	(*UploadDocumentResponse)(nil),                 // 10: ai.UploadDocumentResponse
-	(*GetDocListRequest)(nil),                      // 11: ai.GetDocListRequest
-	(*GetDocListResponse)(nil),                     // 12: ai.GetDocListResponse
-	(*AnalyzeLogsRequest)(nil),                     // 13: ai.AnalyzeLogsRequest
-	(*AnalyzeLogsResponse)(nil),                    // 14: ai.AnalyzeLogsResponse
-	(*FixTaskRequest)(nil),                         // 15: ai.FixTaskRequest
-	(*FixTaskResponse)(nil),                        // 16: ai.FixTaskResponse
-	(*CreateNewChatResponse_SessionData)(nil),      // 17: ai.CreateNewChatResponse.SessionData
-	(*AskQuestionResponse_AnswerData)(nil),         // 18: ai.AskQuestionResponse.AnswerData
-	(*GetChatHistoryResponse_ChatHistoryData)(nil), // 19: ai.GetChatHistoryResponse.ChatHistoryData
-	(*GetChatHistoryResponse_ChatMessage)(nil),     // 20: ai.GetChatHistoryResponse.ChatMessage
-	(*UploadDocumentResponse_DocData)(nil),         // 21: ai.UploadDocumentResponse.DocData
-	(*GetDocListResponse_DocData)(nil),             // 22: ai.GetDocListResponse.DocData
-	(*AnalyzeLogsResponse_SuggestionData)(nil),     // 23: ai.AnalyzeLogsResponse.SuggestionData
-	(*FixTaskResponse_FixStatusData)(nil),          // 24: ai.FixTaskResponse.FixStatusData
+func (x *UploadDocumentRequest) Reset() {
+	*x = UploadDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_aicoreops_ai_proto_depIdxs = []int32{
-	17, // 0: ai.CreateNewChatResponse.data:type_name -> ai.CreateNewChatResponse.SessionData
-	18, // 1: ai.AskQuestionResponse.data:type_name -> ai.AskQuestionResponse.AnswerData
-	0,  // 2: ai.GetChatListResponse.data:type_name -> ai.HistorySession
-	19, // 3: ai.GetChatHistoryResponse.data:type_name -> ai.GetChatHistoryResponse.ChatHistoryData
-	21, // 4: ai.UploadDocumentResponse.data:type_name -> ai.UploadDocumentResponse.DocData
-	22, // 5: ai.GetDocListResponse.data:type_name -> ai.GetDocListResponse.DocData
-	23, // 6: ai.AnalyzeLogsResponse.data:type_name -> ai.AnalyzeLogsResponse.SuggestionData
-	24, // 7: ai.FixTaskResponse.data:type_name -> ai.FixTaskResponse.FixStatusData
-	20, // 8: ai.GetChatHistoryResponse.ChatHistoryData.messages:type_name -> ai.GetChatHistoryResponse.ChatMessage
-	1,  // 9: ai.AIHelper.CreateNewChat:input_type -> ai.CreateNewChatRequest
-	5,  // 10: ai.AIHelper.GetChatList:input_type -> ai.GetChatListRequest
-	7,  // 11: ai.AIHelper.GetChatHistory:input_type -> ai.GetChatHistoryRequest
-	9,  // 12: ai.AIHelper.UploadDocument:input_type -> ai.UploadDocumentRequest
-	3,  // 13: ai.AIHelper.AskQuestion:input_type -> ai.AskQuestionRequest
-	11, // 14: ai.AIHelper.GetDocList:input_type -> ai.GetDocListRequest
-	13, // 15: ai.LogAnalysis.AnalyzeLogs:input_type -> ai.AnalyzeLogsRequest
-	15, // 16: ai.AutoFix.FixTask:input_type -> ai.FixTaskRequest
-	2,  // 17: ai.AIHelper.CreateNewChat:output_type -> ai.CreateNewChatResponse
-	6,  // 18: ai.AIHelper.GetChatList:output_type -> ai.GetChatListResponse
-	8,  // 19: ai.AIHelper.GetChatHistory:output_type -> ai.GetChatHistoryResponse
-	10, // 20: ai.AIHelper.UploadDocument:output_type -> ai.UploadDocumentResponse
-	4,  // 21: ai.AIHelper.AskQuestion:output_type -> ai.AskQuestionResponse
-	12, // 22: ai.AIHelper.GetDocList:output_type -> ai.GetDocListResponse
-	14, // 23: ai.LogAnalysis.AnalyzeLogs:output_type -> ai.AnalyzeLogsResponse
-	16, // 24: ai.AutoFix.FixTask:output_type -> ai.FixTaskResponse
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+func (x *UploadDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_aicoreops_ai_proto_init() }
-func file_aicoreops_ai_proto_init() {
-	if File_aicoreops_ai_proto != nil {
-		return
+func (*UploadDocumentRequest) ProtoMessage() {}
+
+func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_aicoreops_ai_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*HistorySession); i {
-			case 0:
-				return &v.state
-			case 1:
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UploadDocumentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetKnowledgeBase() string {
+	if x != nil {
+		return x.KnowledgeBase
+	}
+	return ""
+}
+
+// 上传文档
+type UploadDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32                           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string                          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *UploadDocumentResponse_DocData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *UploadDocumentResponse) Reset() {
+	*x = UploadDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentResponse) ProtoMessage() {}
+
+func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UploadDocumentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UploadDocumentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadDocumentResponse) GetData() *UploadDocumentResponse_DocData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 获取文档列表
+type GetDocListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page          int32  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                                       // 页码
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`               // 每页数量
+	KnowledgeBase string `protobuf:"bytes,3,opt,name=knowledge_base,json=knowledgeBase,proto3" json:"knowledge_base,omitempty"` // 知识库，为空时返回所有有权读取的文档
+}
+
+func (x *GetDocListRequest) Reset() {
+	*x = GetDocListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocListRequest) ProtoMessage() {}
+
+func (x *GetDocListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocListRequest.ProtoReflect.Descriptor instead.
+func (*GetDocListRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetDocListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDocListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDocListRequest) GetKnowledgeBase() string {
+	if x != nil {
+		return x.KnowledgeBase
+	}
+	return ""
+}
+
+type GetDocListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    []*Document `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+	Total   int64       `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`    // 总数
+}
+
+func (x *GetDocListResponse) Reset() {
+	*x = GetDocListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocListResponse) ProtoMessage() {}
+
+func (x *GetDocListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocListResponse.ProtoReflect.Descriptor instead.
+func (*GetDocListResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetDocListResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetDocListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDocListResponse) GetData() []*Document {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetDocListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取文档详情
+type GetDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocId string `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"` // 文档ID
+}
+
+func (x *GetDocumentRequest) Reset() {
+	*x = GetDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentRequest) ProtoMessage() {}
+
+func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetDocumentRequest) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+type GetDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *Document `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *GetDocumentResponse) Reset() {
+	*x = GetDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentResponse) ProtoMessage() {}
+
+func (x *GetDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetDocumentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetDocumentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDocumentResponse) GetData() *Document {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 更新文档，重新分块并替换向量
+type UpdateDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocId   string `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"` // 文档ID
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`              // 文档标题
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`          // 文档内容，Base64 编码，为空时沿用原内容
+	Format  string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`            // 文档格式，为空时自动识别
+}
+
+func (x *UpdateDocumentRequest) Reset() {
+	*x = UpdateDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDocumentRequest) ProtoMessage() {}
+
+func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateDocumentRequest) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type UpdateDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *Document `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *UpdateDocumentResponse) Reset() {
+	*x = UpdateDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDocumentResponse) ProtoMessage() {}
+
+func (x *UpdateDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateDocumentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateDocumentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateDocumentResponse) GetData() *Document {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 删除文档
+type DeleteDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocId string `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"` // 文档ID
+}
+
+func (x *DeleteDocumentRequest) Reset() {
+	*x = DeleteDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentRequest) ProtoMessage() {}
+
+func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteDocumentRequest) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+type DeleteDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+}
+
+func (x *DeleteDocumentResponse) Reset() {
+	*x = DeleteDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentResponse) ProtoMessage() {}
+
+func (x *DeleteDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteDocumentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteDocumentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ----------------------- 日志分析相关消息 -----------------------
+type AnalyzeLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogData        string `protobuf:"bytes,1,opt,name=log_data,json=logData,proto3" json:"log_data,omitempty"`                      // 提交的日志内容
+	WindowTokens   int32  `protobuf:"varint,2,opt,name=window_tokens,json=windowTokens,proto3" json:"window_tokens,omitempty"`      // 单个分析窗口的最大 token 数，0 表示使用默认值
+	PromptTemplate string `protobuf:"bytes,3,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"` // 提示词模板名称，为空时使用内置模板
+}
+
+func (x *AnalyzeLogsRequest) Reset() {
+	*x = AnalyzeLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeLogsRequest) ProtoMessage() {}
+
+func (x *AnalyzeLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeLogsRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeLogsRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AnalyzeLogsRequest) GetLogData() string {
+	if x != nil {
+		return x.LogData
+	}
+	return ""
+}
+
+func (x *AnalyzeLogsRequest) GetWindowTokens() int32 {
+	if x != nil {
+		return x.WindowTokens
+	}
+	return 0
+}
+
+func (x *AnalyzeLogsRequest) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+type AnalyzeLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32                               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string                              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *AnalyzeLogsResponse_SuggestionData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *AnalyzeLogsResponse) Reset() {
+	*x = AnalyzeLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeLogsResponse) ProtoMessage() {}
+
+func (x *AnalyzeLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeLogsResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeLogsResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AnalyzeLogsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AnalyzeLogsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AnalyzeLogsResponse) GetData() *AnalyzeLogsResponse_SuggestionData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ----------------------- 自动修复相关消息 -----------------------
+// 修复任务执行记录
+type FixRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId       string            `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`                                                                              // 执行记录ID
+	Task        string            `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`                                                                                             // 修复任务类型
+	PodId       string            `protobuf:"bytes,3,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`                                                                              // Pod ID，格式为 namespace/name
+	Params      map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 修复参数
+	DryRun      bool              `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                                          // 是否仅生成执行计划
+	Status      string            `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                                                                         // 状态: planned/pending_approval/rejected/running/succeeded/failed
+	Diff        string            `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`                                                                                             // 计划变更内容
+	Message     string            `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`                                                                                       // 审批意见或执行结果
+	CreatorId   int64             `protobuf:"varint,9,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`                                                                 // 发起人ID
+	ApproverId  int64             `protobuf:"varint,10,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`                                                             // 审批人ID
+	CreateTime  int64             `protobuf:"varint,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                                                             // 创建时间
+	UpdateTime  int64             `protobuf:"varint,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`                                                             // 更新时间
+	Explanation string            `protobuf:"bytes,13,opt,name=explanation,proto3" json:"explanation,omitempty"`                                                                              // 大模型生成的计划说明，生成失败时为空
+}
+
+func (x *FixRun) Reset() {
+	*x = FixRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixRun) ProtoMessage() {}
+
+func (x *FixRun) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixRun.ProtoReflect.Descriptor instead.
+func (*FixRun) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FixRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *FixRun) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *FixRun) GetPodId() string {
+	if x != nil {
+		return x.PodId
+	}
+	return ""
+}
+
+func (x *FixRun) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *FixRun) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *FixRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FixRun) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *FixRun) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FixRun) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *FixRun) GetApproverId() int64 {
+	if x != nil {
+		return x.ApproverId
+	}
+	return 0
+}
+
+func (x *FixRun) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *FixRun) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *FixRun) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type FixTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task           string            `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`                                                                                             // 修复任务类型: fixOOM/restartCrashLoop/scaleUp/clearDisk
+	PodId          string            `protobuf:"bytes,2,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`                                                                              // Pod ID，格式为 namespace/name，省略命名空间时为 default
+	Params         map[string]string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 修复参数
+	DryRun         bool              `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                                          // 仅返回计划变更，不进入审批
+	PromptTemplate string            `protobuf:"bytes,5,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`                                                   // 生成计划说明的提示词模板名称，为空时使用内置模板
+}
+
+func (x *FixTaskRequest) Reset() {
+	*x = FixTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixTaskRequest) ProtoMessage() {}
+
+func (x *FixTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixTaskRequest.ProtoReflect.Descriptor instead.
+func (*FixTaskRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FixTaskRequest) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *FixTaskRequest) GetPodId() string {
+	if x != nil {
+		return x.PodId
+	}
+	return ""
+}
+
+func (x *FixTaskRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *FixTaskRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *FixTaskRequest) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+type FixTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *FixRun `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *FixTaskResponse) Reset() {
+	*x = FixTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixTaskResponse) ProtoMessage() {}
+
+func (x *FixTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixTaskResponse.ProtoReflect.Descriptor instead.
+func (*FixTaskResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FixTaskResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *FixTaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FixTaskResponse) GetData() *FixRun {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetFixRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // 执行记录ID
+}
+
+func (x *GetFixRunRequest) Reset() {
+	*x = GetFixRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFixRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFixRunRequest) ProtoMessage() {}
+
+func (x *GetFixRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFixRunRequest.ProtoReflect.Descriptor instead.
+func (*GetFixRunRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetFixRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type GetFixRunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *FixRun `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *GetFixRunResponse) Reset() {
+	*x = GetFixRunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFixRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFixRunResponse) ProtoMessage() {}
+
+func (x *GetFixRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFixRunResponse.ProtoReflect.Descriptor instead.
+func (*GetFixRunResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetFixRunResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetFixRunResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetFixRunResponse) GetData() *FixRun {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ApproveFixRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId    string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // 执行记录ID
+	Approved bool   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`       // 是否批准，false 表示驳回
+	Comment  string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`          // 审批意见
+}
+
+func (x *ApproveFixRunRequest) Reset() {
+	*x = ApproveFixRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFixRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFixRunRequest) ProtoMessage() {}
+
+func (x *ApproveFixRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFixRunRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFixRunRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ApproveFixRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ApproveFixRunRequest) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ApproveFixRunRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ApproveFixRunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *FixRun `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *ApproveFixRunResponse) Reset() {
+	*x = ApproveFixRunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFixRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFixRunResponse) ProtoMessage() {}
+
+func (x *ApproveFixRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFixRunResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFixRunResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ApproveFixRunResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ApproveFixRunResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApproveFixRunResponse) GetData() *FixRun {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ----------------------- 提示词模板相关消息 -----------------------
+type PromptTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // 模板名称
+	Version         int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`                                       // 版本号
+	Feature         string `protobuf:"bytes,3,opt,name=feature,proto3" json:"feature,omitempty"`                                        // 适用功能: chat/log_analysis/auto_fix
+	Description     string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                // 描述
+	SystemPrompt    string `protobuf:"bytes,5,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`          // 系统提示词，text/template 语法
+	ContextTemplate string `protobuf:"bytes,6,opt,name=context_template,json=contextTemplate,proto3" json:"context_template,omitempty"` // 上下文模板，用于组织检索文档、历史对话等内容
+	CreatorId       int64  `protobuf:"varint,7,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`                  // 创建人ID
+	CreateTime      int64  `protobuf:"varint,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`               // 创建时间
+}
+
+func (x *PromptTemplate) Reset() {
+	*x = PromptTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptTemplate) ProtoMessage() {}
+
+func (x *PromptTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptTemplate.ProtoReflect.Descriptor instead.
+func (*PromptTemplate) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PromptTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PromptTemplate) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetSystemPrompt() string {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetContextTemplate() string {
+	if x != nil {
+		return x.ContextTemplate
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *PromptTemplate) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type CreatePromptTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // 模板名称
+	Feature         string `protobuf:"bytes,2,opt,name=feature,proto3" json:"feature,omitempty"`                                        // 适用功能: chat/log_analysis/auto_fix
+	Description     string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                // 描述
+	SystemPrompt    string `protobuf:"bytes,4,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`          // 系统提示词
+	ContextTemplate string `protobuf:"bytes,5,opt,name=context_template,json=contextTemplate,proto3" json:"context_template,omitempty"` // 上下文模板
+}
+
+func (x *CreatePromptTemplateRequest) Reset() {
+	*x = CreatePromptTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePromptTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptTemplateRequest) ProtoMessage() {}
+
+func (x *CreatePromptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreatePromptTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePromptTemplateRequest) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *CreatePromptTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePromptTemplateRequest) GetSystemPrompt() string {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return ""
+}
+
+func (x *CreatePromptTemplateRequest) GetContextTemplate() string {
+	if x != nil {
+		return x.ContextTemplate
+	}
+	return ""
+}
+
+type CreatePromptTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *PromptTemplate `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *CreatePromptTemplateResponse) Reset() {
+	*x = CreatePromptTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePromptTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptTemplateResponse) ProtoMessage() {}
+
+func (x *CreatePromptTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromptTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreatePromptTemplateResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreatePromptTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreatePromptTemplateResponse) GetData() *PromptTemplate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 更新模板，生成新版本
+type UpdatePromptTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                              // 模板名称
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                // 描述
+	SystemPrompt    string `protobuf:"bytes,3,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`          // 系统提示词
+	ContextTemplate string `protobuf:"bytes,4,opt,name=context_template,json=contextTemplate,proto3" json:"context_template,omitempty"` // 上下文模板
+}
+
+func (x *UpdatePromptTemplateRequest) Reset() {
+	*x = UpdatePromptTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePromptTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromptTemplateRequest) ProtoMessage() {}
+
+func (x *UpdatePromptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdatePromptTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePromptTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePromptTemplateRequest) GetSystemPrompt() string {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return ""
+}
+
+func (x *UpdatePromptTemplateRequest) GetContextTemplate() string {
+	if x != nil {
+		return x.ContextTemplate
+	}
+	return ""
+}
+
+type UpdatePromptTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *PromptTemplate `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *UpdatePromptTemplateResponse) Reset() {
+	*x = UpdatePromptTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePromptTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromptTemplateResponse) ProtoMessage() {}
+
+func (x *UpdatePromptTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromptTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromptTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdatePromptTemplateResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdatePromptTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdatePromptTemplateResponse) GetData() *PromptTemplate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetPromptTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`        // 模板名称
+	Version int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 版本号，0 表示最新版本
+}
+
+func (x *GetPromptTemplateRequest) Reset() {
+	*x = GetPromptTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPromptTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromptTemplateRequest) ProtoMessage() {}
+
+func (x *GetPromptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetPromptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetPromptTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPromptTemplateRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetPromptTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *PromptTemplate `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *GetPromptTemplateResponse) Reset() {
+	*x = GetPromptTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPromptTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromptTemplateResponse) ProtoMessage() {}
+
+func (x *GetPromptTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromptTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetPromptTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPromptTemplateResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetPromptTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPromptTemplateResponse) GetData() *PromptTemplate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListPromptTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Feature  string `protobuf:"bytes,1,opt,name=feature,proto3" json:"feature,omitempty"`                    // 适用功能，为空时返回全部
+	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+}
+
+func (x *ListPromptTemplatesRequest) Reset() {
+	*x = ListPromptTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPromptTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptTemplatesRequest) ProtoMessage() {}
+
+func (x *ListPromptTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListPromptTemplatesRequest) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *ListPromptTemplatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPromptTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPromptTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    []*PromptTemplate `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`       // 各模板的最新版本
+	Total   int64             `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`    // 总数
+}
+
+func (x *ListPromptTemplatesResponse) Reset() {
+	*x = ListPromptTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPromptTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptTemplatesResponse) ProtoMessage() {}
+
+func (x *ListPromptTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListPromptTemplatesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListPromptTemplatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPromptTemplatesResponse) GetData() []*PromptTemplate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListPromptTemplatesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeletePromptTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 模板名称，删除所有版本
+}
+
+func (x *DeletePromptTemplateRequest) Reset() {
+	*x = DeletePromptTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePromptTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromptTemplateRequest) ProtoMessage() {}
+
+func (x *DeletePromptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeletePromptTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeletePromptTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+}
+
+func (x *DeletePromptTemplateResponse) Reset() {
+	*x = DeletePromptTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePromptTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromptTemplateResponse) ProtoMessage() {}
+
+func (x *DeletePromptTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromptTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromptTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeletePromptTemplateResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeletePromptTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ----------------------- 告警规则生成相关消息 -----------------------
+type GenerateAlertRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description    string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`                             // 告警需求的自然语言描述
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // 规则名称，为空时由大模型生成
+	PoolId         int64  `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`                        // 关联的 Prometheus 实例池ID
+	SendGroupId    int64  `protobuf:"varint,4,opt,name=send_group_id,json=sendGroupId,proto3" json:"send_group_id,omitempty"`       // 关联的发送组ID
+	TreeNodeId     int64  `protobuf:"varint,5,opt,name=tree_node_id,json=treeNodeId,proto3" json:"tree_node_id,omitempty"`          // 绑定的树节点ID
+	PromptTemplate string `protobuf:"bytes,6,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"` // 提示词模板名称，为空时使用内置模板
+}
+
+func (x *GenerateAlertRuleRequest) Reset() {
+	*x = GenerateAlertRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAlertRuleRequest) ProtoMessage() {}
+
+func (x *GenerateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*GenerateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GenerateAlertRuleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GenerateAlertRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GenerateAlertRuleRequest) GetPoolId() int64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *GenerateAlertRuleRequest) GetSendGroupId() int64 {
+	if x != nil {
+		return x.SendGroupId
+	}
+	return 0
+}
+
+func (x *GenerateAlertRuleRequest) GetTreeNodeId() int64 {
+	if x != nil {
+		return x.TreeNodeId
+	}
+	return 0
+}
+
+func (x *GenerateAlertRuleRequest) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+// 生成的告警规则，字段编号与 prometheus_rpc.AlertRule 一致，可直接作为 CreateAlertRuleRequest.rule 提交
+type AlertRuleDraft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId      int64    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PoolId      int64    `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SendGroupId int64    `protobuf:"varint,5,opt,name=send_group_id,json=sendGroupId,proto3" json:"send_group_id,omitempty"`
+	TreeNodeId  int64    `protobuf:"varint,6,opt,name=tree_node_id,json=treeNodeId,proto3" json:"tree_node_id,omitempty"`
+	Enable      int32    `protobuf:"varint,7,opt,name=enable,proto3" json:"enable,omitempty"`
+	Expr        string   `protobuf:"bytes,8,opt,name=expr,proto3" json:"expr,omitempty"`
+	Severity    string   `protobuf:"bytes,9,opt,name=severity,proto3" json:"severity,omitempty"`
+	GrafanaLink string   `protobuf:"bytes,10,opt,name=grafana_link,json=grafanaLink,proto3" json:"grafana_link,omitempty"`
+	ForDuration string   `protobuf:"bytes,11,opt,name=for_duration,json=forDuration,proto3" json:"for_duration,omitempty"`
+	Labels      []string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty"`           // key=value
+	Annotations []string `protobuf:"bytes,13,rep,name=annotations,proto3" json:"annotations,omitempty"` // key=value
+}
+
+func (x *AlertRuleDraft) Reset() {
+	*x = AlertRuleDraft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlertRuleDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRuleDraft) ProtoMessage() {}
+
+func (x *AlertRuleDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRuleDraft.ProtoReflect.Descriptor instead.
+func (*AlertRuleDraft) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AlertRuleDraft) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertRuleDraft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRuleDraft) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AlertRuleDraft) GetPoolId() int64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *AlertRuleDraft) GetSendGroupId() int64 {
+	if x != nil {
+		return x.SendGroupId
+	}
+	return 0
+}
+
+func (x *AlertRuleDraft) GetTreeNodeId() int64 {
+	if x != nil {
+		return x.TreeNodeId
+	}
+	return 0
+}
+
+func (x *AlertRuleDraft) GetEnable() int32 {
+	if x != nil {
+		return x.Enable
+	}
+	return 0
+}
+
+func (x *AlertRuleDraft) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *AlertRuleDraft) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AlertRuleDraft) GetGrafanaLink() string {
+	if x != nil {
+		return x.GrafanaLink
+	}
+	return ""
+}
+
+func (x *AlertRuleDraft) GetForDuration() string {
+	if x != nil {
+		return x.ForDuration
+	}
+	return ""
+}
+
+func (x *AlertRuleDraft) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *AlertRuleDraft) GetAnnotations() []string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type GenerateAlertRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32                                    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string                                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *GenerateAlertRuleResponse_GeneratedData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *GenerateAlertRuleResponse) Reset() {
+	*x = GenerateAlertRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAlertRuleResponse) ProtoMessage() {}
+
+func (x *GenerateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*GenerateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GenerateAlertRuleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GenerateAlertRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GenerateAlertRuleResponse) GetData() *GenerateAlertRuleResponse_GeneratedData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ----------------------- 知识库相关消息 -----------------------
+// 知识库，成员格式为 user:<用户ID> 或 role:<角色名>
+type KnowledgeBase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // 知识库名称
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                    // 描述
+	OwnerTeam   string   `protobuf:"bytes,3,opt,name=owner_team,json=ownerTeam,proto3" json:"owner_team,omitempty"`       // 所属团队（角色名），团队成员可读写
+	TreeNodeId  int64    `protobuf:"varint,4,opt,name=tree_node_id,json=treeNodeId,proto3" json:"tree_node_id,omitempty"` // 所属服务树节点ID
+	Public      bool     `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"`                             // 是否所有用户可读
+	Readers     []string `protobuf:"bytes,6,rep,name=readers,proto3" json:"readers,omitempty"`                            // 可读成员
+	Writers     []string `protobuf:"bytes,7,rep,name=writers,proto3" json:"writers,omitempty"`                            // 可写成员，可写即可读
+	CreatorId   int64    `protobuf:"varint,8,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`      // 创建人ID
+	Writable    bool     `protobuf:"varint,9,opt,name=writable,proto3" json:"writable,omitempty"`                         // 当前用户是否可写
+	CreateTime  int64    `protobuf:"varint,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`  // 创建时间
+	UpdateTime  int64    `protobuf:"varint,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`  // 更新时间
+}
+
+func (x *KnowledgeBase) Reset() {
+	*x = KnowledgeBase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnowledgeBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeBase) ProtoMessage() {}
+
+func (x *KnowledgeBase) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeBase.ProtoReflect.Descriptor instead.
+func (*KnowledgeBase) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *KnowledgeBase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KnowledgeBase) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *KnowledgeBase) GetOwnerTeam() string {
+	if x != nil {
+		return x.OwnerTeam
+	}
+	return ""
+}
+
+func (x *KnowledgeBase) GetTreeNodeId() int64 {
+	if x != nil {
+		return x.TreeNodeId
+	}
+	return 0
+}
+
+func (x *KnowledgeBase) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *KnowledgeBase) GetReaders() []string {
+	if x != nil {
+		return x.Readers
+	}
+	return nil
+}
+
+func (x *KnowledgeBase) GetWriters() []string {
+	if x != nil {
+		return x.Writers
+	}
+	return nil
+}
+
+func (x *KnowledgeBase) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *KnowledgeBase) GetWritable() bool {
+	if x != nil {
+		return x.Writable
+	}
+	return false
+}
+
+func (x *KnowledgeBase) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *KnowledgeBase) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type CreateKnowledgeBaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // 知识库名称
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                    // 描述
+	OwnerTeam   string   `protobuf:"bytes,3,opt,name=owner_team,json=ownerTeam,proto3" json:"owner_team,omitempty"`       // 所属团队（角色名），为空时归创建人所有
+	TreeNodeId  int64    `protobuf:"varint,4,opt,name=tree_node_id,json=treeNodeId,proto3" json:"tree_node_id,omitempty"` // 所属服务树节点ID
+	Public      bool     `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"`                             // 是否所有用户可读
+	Readers     []string `protobuf:"bytes,6,rep,name=readers,proto3" json:"readers,omitempty"`                            // 可读成员
+	Writers     []string `protobuf:"bytes,7,rep,name=writers,proto3" json:"writers,omitempty"`                            // 可写成员
+}
+
+func (x *CreateKnowledgeBaseRequest) Reset() {
+	*x = CreateKnowledgeBaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKnowledgeBaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKnowledgeBaseRequest) ProtoMessage() {}
+
+func (x *CreateKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateKnowledgeBaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateKnowledgeBaseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateKnowledgeBaseRequest) GetOwnerTeam() string {
+	if x != nil {
+		return x.OwnerTeam
+	}
+	return ""
+}
+
+func (x *CreateKnowledgeBaseRequest) GetTreeNodeId() int64 {
+	if x != nil {
+		return x.TreeNodeId
+	}
+	return 0
+}
+
+func (x *CreateKnowledgeBaseRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *CreateKnowledgeBaseRequest) GetReaders() []string {
+	if x != nil {
+		return x.Readers
+	}
+	return nil
+}
+
+func (x *CreateKnowledgeBaseRequest) GetWriters() []string {
+	if x != nil {
+		return x.Writers
+	}
+	return nil
+}
+
+type CreateKnowledgeBaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *KnowledgeBase `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *CreateKnowledgeBaseResponse) Reset() {
+	*x = CreateKnowledgeBaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKnowledgeBaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKnowledgeBaseResponse) ProtoMessage() {}
+
+func (x *CreateKnowledgeBaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKnowledgeBaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateKnowledgeBaseResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateKnowledgeBaseResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateKnowledgeBaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateKnowledgeBaseResponse) GetData() *KnowledgeBase {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 更新知识库，成员列表整体替换
+type UpdateKnowledgeBaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // 知识库名称
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                    // 描述
+	OwnerTeam   string   `protobuf:"bytes,3,opt,name=owner_team,json=ownerTeam,proto3" json:"owner_team,omitempty"`       // 所属团队（角色名）
+	TreeNodeId  int64    `protobuf:"varint,4,opt,name=tree_node_id,json=treeNodeId,proto3" json:"tree_node_id,omitempty"` // 所属服务树节点ID
+	Public      bool     `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"`                             // 是否所有用户可读
+	Readers     []string `protobuf:"bytes,6,rep,name=readers,proto3" json:"readers,omitempty"`                            // 可读成员
+	Writers     []string `protobuf:"bytes,7,rep,name=writers,proto3" json:"writers,omitempty"`                            // 可写成员
+}
+
+func (x *UpdateKnowledgeBaseRequest) Reset() {
+	*x = UpdateKnowledgeBaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateKnowledgeBaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKnowledgeBaseRequest) ProtoMessage() {}
+
+func (x *UpdateKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetOwnerTeam() string {
+	if x != nil {
+		return x.OwnerTeam
+	}
+	return ""
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetTreeNodeId() int64 {
+	if x != nil {
+		return x.TreeNodeId
+	}
+	return 0
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetReaders() []string {
+	if x != nil {
+		return x.Readers
+	}
+	return nil
+}
+
+func (x *UpdateKnowledgeBaseRequest) GetWriters() []string {
+	if x != nil {
+		return x.Writers
+	}
+	return nil
+}
+
+type UpdateKnowledgeBaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    *KnowledgeBase `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`       // 返回的数据
+}
+
+func (x *UpdateKnowledgeBaseResponse) Reset() {
+	*x = UpdateKnowledgeBaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateKnowledgeBaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKnowledgeBaseResponse) ProtoMessage() {}
+
+func (x *UpdateKnowledgeBaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKnowledgeBaseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKnowledgeBaseResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateKnowledgeBaseResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateKnowledgeBaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateKnowledgeBaseResponse) GetData() *KnowledgeBase {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListKnowledgeBasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TreeNodeId int64 `protobuf:"varint,1,opt,name=tree_node_id,json=treeNodeId,proto3" json:"tree_node_id,omitempty"` // 按服务树节点过滤，0 表示不过滤
+}
+
+func (x *ListKnowledgeBasesRequest) Reset() {
+	*x = ListKnowledgeBasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKnowledgeBasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeBasesRequest) ProtoMessage() {}
+
+func (x *ListKnowledgeBasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeBasesRequest.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeBasesRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListKnowledgeBasesRequest) GetTreeNodeId() int64 {
+	if x != nil {
+		return x.TreeNodeId
+	}
+	return 0
+}
+
+type ListKnowledgeBasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32            `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+	Data    []*KnowledgeBase `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`       // 当前用户有权读取的知识库
+}
+
+func (x *ListKnowledgeBasesResponse) Reset() {
+	*x = ListKnowledgeBasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKnowledgeBasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeBasesResponse) ProtoMessage() {}
+
+func (x *ListKnowledgeBasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeBasesResponse.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeBasesResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListKnowledgeBasesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListKnowledgeBasesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListKnowledgeBasesResponse) GetData() []*KnowledgeBase {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 删除知识库，知识库中仍有文档时不允许删除
+type DeleteKnowledgeBaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 知识库名称
+}
+
+func (x *DeleteKnowledgeBaseRequest) Reset() {
+	*x = DeleteKnowledgeBaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteKnowledgeBaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKnowledgeBaseRequest) ProtoMessage() {}
+
+func (x *DeleteKnowledgeBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKnowledgeBaseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKnowledgeBaseRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteKnowledgeBaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteKnowledgeBaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // 状态码
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 错误信息或成功提示
+}
+
+func (x *DeleteKnowledgeBaseResponse) Reset() {
+	*x = DeleteKnowledgeBaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteKnowledgeBaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKnowledgeBaseResponse) ProtoMessage() {}
+
+func (x *DeleteKnowledgeBaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKnowledgeBaseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKnowledgeBaseResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteKnowledgeBaseResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteKnowledgeBaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ----------------------- 重建索引 -----------------------
+type ReindexJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source         string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                                       // 原集合
+	Target         string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`                                       // 新集合
+	EmbeddingModel string `protobuf:"bytes,4,opt,name=embedding_model,json=embeddingModel,proto3" json:"embedding_model,omitempty"` // 新集合使用的向量模型
+	Status         string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                       // pending / running / succeeded / failed
+	Total          int64  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`                                        // 任务创建时的文档数
+	Processed      int64  `protobuf:"varint,7,opt,name=processed,proto3" json:"processed,omitempty"`                                // 已重建的文档数
+	Message        string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`                                     // 失败原因
+	CreatorId      int64  `protobuf:"varint,9,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`               // 发起人ID，0 表示启动时自动创建
+	CreateTime     int64  `protobuf:"varint,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime     int64  `protobuf:"varint,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	FinishTime     int64  `protobuf:"varint,12,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+}
+
+func (x *ReindexJob) Reset() {
+	*x = ReindexJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexJob) ProtoMessage() {}
+
+func (x *ReindexJob) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexJob.ProtoReflect.Descriptor instead.
+func (*ReindexJob) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReindexJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReindexJob) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ReindexJob) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ReindexJob) GetEmbeddingModel() string {
+	if x != nil {
+		return x.EmbeddingModel
+	}
+	return ""
+}
+
+func (x *ReindexJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReindexJob) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReindexJob) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ReindexJob) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReindexJob) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ReindexJob) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *ReindexJob) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *ReindexJob) GetFinishTime() int64 {
+	if x != nil {
+		return x.FinishTime
+	}
+	return 0
+}
+
+type ReindexStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActiveCollection         string      `protobuf:"bytes,1,opt,name=active_collection,json=activeCollection,proto3" json:"active_collection,omitempty"`                           // 当前检索使用的集合
+	ActiveEmbeddingModel     string      `protobuf:"bytes,2,opt,name=active_embedding_model,json=activeEmbeddingModel,proto3" json:"active_embedding_model,omitempty"`             // 当前集合的向量模型
+	ConfiguredEmbeddingModel string      `protobuf:"bytes,3,opt,name=configured_embedding_model,json=configuredEmbeddingModel,proto3" json:"configured_embedding_model,omitempty"` // 配置的向量模型
+	ModelMismatch            bool        `protobuf:"varint,4,opt,name=model_mismatch,json=modelMismatch,proto3" json:"model_mismatch,omitempty"`                                   // 两者不一致时检索仍使用原模型，需重建索引
+	Job                      *ReindexJob `protobuf:"bytes,5,opt,name=job,proto3" json:"job,omitempty"`                                                                             // 指定或最近一次的任务，没有任务时为空
+}
+
+func (x *ReindexStatus) Reset() {
+	*x = ReindexStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexStatus) ProtoMessage() {}
+
+func (x *ReindexStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexStatus.ProtoReflect.Descriptor instead.
+func (*ReindexStatus) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReindexStatus) GetActiveCollection() string {
+	if x != nil {
+		return x.ActiveCollection
+	}
+	return ""
+}
+
+func (x *ReindexStatus) GetActiveEmbeddingModel() string {
+	if x != nil {
+		return x.ActiveEmbeddingModel
+	}
+	return ""
+}
+
+func (x *ReindexStatus) GetConfiguredEmbeddingModel() string {
+	if x != nil {
+		return x.ConfiguredEmbeddingModel
+	}
+	return ""
+}
+
+func (x *ReindexStatus) GetModelMismatch() bool {
+	if x != nil {
+		return x.ModelMismatch
+	}
+	return false
+}
+
+func (x *ReindexStatus) GetJob() *ReindexJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// 发起重建索引，已有未完成的任务时返回该任务，仅管理员可用
+type StartReindexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Force bool `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"` // 向量模型未变更时也重建，用于调整分块参数后重新入库
+}
+
+func (x *StartReindexRequest) Reset() {
+	*x = StartReindexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartReindexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReindexRequest) ProtoMessage() {}
+
+func (x *StartReindexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReindexRequest.ProtoReflect.Descriptor instead.
+func (*StartReindexRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StartReindexRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type StartReindexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ReindexJob `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *StartReindexResponse) Reset() {
+	*x = StartReindexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartReindexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReindexResponse) ProtoMessage() {}
+
+func (x *StartReindexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReindexResponse.ProtoReflect.Descriptor instead.
+func (*StartReindexResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StartReindexResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *StartReindexResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StartReindexResponse) GetData() *ReindexJob {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetReindexStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId int64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // 为 0 时返回最近一次任务
+}
+
+func (x *GetReindexStatusRequest) Reset() {
+	*x = GetReindexStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReindexStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReindexStatusRequest) ProtoMessage() {}
+
+func (x *GetReindexStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReindexStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetReindexStatusRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetReindexStatusRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type GetReindexStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ReindexStatus `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetReindexStatusResponse) Reset() {
+	*x = GetReindexStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReindexStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReindexStatusResponse) ProtoMessage() {}
+
+func (x *GetReindexStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReindexStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetReindexStatusResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetReindexStatusResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetReindexStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetReindexStatusResponse) GetData() *ReindexStatus {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateNewChatResponse_SessionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+}
+
+func (x *CreateNewChatResponse_SessionData) Reset() {
+	*x = CreateNewChatResponse_SessionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateNewChatResponse_SessionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNewChatResponse_SessionData) ProtoMessage() {}
+
+func (x *CreateNewChatResponse_SessionData) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNewChatResponse_SessionData.ProtoReflect.Descriptor instead.
+func (*CreateNewChatResponse_SessionData) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *CreateNewChatResponse_SessionData) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type AskQuestionResponse_AnswerData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer     string       `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`                           // AI 助手的回答内容
+	SessionId  string       `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`    // 会话ID
+	Finished   bool         `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`                      // 是否为本轮回答的最后一帧
+	Citations  []*Citation  `protobuf:"bytes,4,rep,name=citations,proto3" json:"citations,omitempty"`                     // 回答引用的文档分块，仅在最后一帧返回
+	ToolCall   *ToolCall    `protobuf:"bytes,5,opt,name=tool_call,json=toolCall,proto3" json:"tool_call,omitempty"`       // Agent 模式下的工具调用帧
+	ToolResult *ToolResult  `protobuf:"bytes,6,opt,name=tool_result,json=toolResult,proto3" json:"tool_result,omitempty"` // Agent 模式下的工具结果帧
+	HistoryId  int64        `protobuf:"varint,7,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`   // 本轮问答的记录ID，用于提交反馈，仅在最后一帧返回
+	Redactions []*Redaction `protobuf:"bytes,8,rep,name=redactions,proto3" json:"redactions,omitempty"`                   // 提问中被脱敏的内容，仅在最后一帧返回
+	Truncated  bool         `protobuf:"varint,9,opt,name=truncated,proto3" json:"truncated,omitempty"`                    // 回答被 stop 指令中断，只包含已生成的部分，仅在最后一帧返回
+	ParentId   int64        `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`     // 重新生成时为原问答记录ID，本轮回答作为它的备选回答保存，仅在最后一帧返回
+}
+
+func (x *AskQuestionResponse_AnswerData) Reset() {
+	*x = AskQuestionResponse_AnswerData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AskQuestionResponse_AnswerData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskQuestionResponse_AnswerData) ProtoMessage() {}
+
+func (x *AskQuestionResponse_AnswerData) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskQuestionResponse_AnswerData.ProtoReflect.Descriptor instead.
+func (*AskQuestionResponse_AnswerData) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *AskQuestionResponse_AnswerData) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *AskQuestionResponse_AnswerData) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AskQuestionResponse_AnswerData) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *AskQuestionResponse_AnswerData) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *AskQuestionResponse_AnswerData) GetToolCall() *ToolCall {
+	if x != nil {
+		return x.ToolCall
+	}
+	return nil
+}
+
+func (x *AskQuestionResponse_AnswerData) GetToolResult() *ToolResult {
+	if x != nil {
+		return x.ToolResult
+	}
+	return nil
+}
+
+func (x *AskQuestionResponse_AnswerData) GetHistoryId() int64 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+func (x *AskQuestionResponse_AnswerData) GetRedactions() []*Redaction {
+	if x != nil {
+		return x.Redactions
+	}
+	return nil
+}
+
+func (x *AskQuestionResponse_AnswerData) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *AskQuestionResponse_AnswerData) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type GetChatHistoryResponse_ChatHistoryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*GetChatHistoryResponse_ChatMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // 聊天记录列表
+	Total    int32                                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`      // 总记录数
+}
+
+func (x *GetChatHistoryResponse_ChatHistoryData) Reset() {
+	*x = GetChatHistoryResponse_ChatHistoryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatHistoryResponse_ChatHistoryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatHistoryResponse_ChatHistoryData) ProtoMessage() {}
+
+func (x *GetChatHistoryResponse_ChatHistoryData) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatHistoryResponse_ChatHistoryData.ProtoReflect.Descriptor instead.
+func (*GetChatHistoryResponse_ChatHistoryData) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetChatHistoryResponse_ChatHistoryData) GetMessages() []*GetChatHistoryResponse_ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetChatHistoryResponse_ChatHistoryData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetChatHistoryResponse_ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question   string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`                        // 问题
+	Answer     string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`                            // 回答
+	CreateTime int64  `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 创建时间
+	Id         int64  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`                                   // 问答记录ID，用于提交反馈
+	ParentId   int64  `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`       // 备选回答对应的原问答记录ID，0 表示原回答
+	Truncated  bool   `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`                     // 回答是否被中断
+}
+
+func (x *GetChatHistoryResponse_ChatMessage) Reset() {
+	*x = GetChatHistoryResponse_ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatHistoryResponse_ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatHistoryResponse_ChatMessage) ProtoMessage() {}
+
+func (x *GetChatHistoryResponse_ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatHistoryResponse_ChatMessage.ProtoReflect.Descriptor instead.
+func (*GetChatHistoryResponse_ChatMessage) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *GetChatHistoryResponse_ChatMessage) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *GetChatHistoryResponse_ChatMessage) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *GetChatHistoryResponse_ChatMessage) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *GetChatHistoryResponse_ChatMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetChatHistoryResponse_ChatMessage) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *GetChatHistoryResponse_ChatMessage) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type SearchChatsResponse_ChatSearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`     // 会话ID
+	Title      string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                              // 会话标题
+	Question   string `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`                        // 问题
+	Snippet    string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`                          // 命中关键字附近的内容
+	CreateTime int64  `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 创建时间
+}
+
+func (x *SearchChatsResponse_ChatSearchHit) Reset() {
+	*x = SearchChatsResponse_ChatSearchHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchChatsResponse_ChatSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchChatsResponse_ChatSearchHit) ProtoMessage() {}
+
+func (x *SearchChatsResponse_ChatSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchChatsResponse_ChatSearchHit.ProtoReflect.Descriptor instead.
+func (*SearchChatsResponse_ChatSearchHit) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *SearchChatsResponse_ChatSearchHit) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SearchChatsResponse_ChatSearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchChatsResponse_ChatSearchHit) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *SearchChatsResponse_ChatSearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchChatsResponse_ChatSearchHit) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type ExportChatResponse_ExportData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename    string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`                          // 文件名
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // 内容类型
+	Content     string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                            // 导出内容
+}
+
+func (x *ExportChatResponse_ExportData) Reset() {
+	*x = ExportChatResponse_ExportData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportChatResponse_ExportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChatResponse_ExportData) ProtoMessage() {}
+
+func (x *ExportChatResponse_ExportData) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChatResponse_ExportData.ProtoReflect.Descriptor instead.
+func (*ExportChatResponse_ExportData) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *ExportChatResponse_ExportData) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportChatResponse_ExportData) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportChatResponse_ExportData) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type GetUsageStatsResponse_UsageStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                               // 用户ID
+	Date             string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                                                  // 日期
+	PromptTokens     int64  `protobuf:"varint,3,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`             // 输入 token 数
+	CompletionTokens int64  `protobuf:"varint,4,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"` // 输出 token 数
+	TotalTokens      int64  `protobuf:"varint,5,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`                // 总 token 数
+	Requests         int64  `protobuf:"varint,6,opt,name=requests,proto3" json:"requests,omitempty"`                                         // 提问次数
+}
+
+func (x *GetUsageStatsResponse_UsageStat) Reset() {
+	*x = GetUsageStatsResponse_UsageStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageStatsResponse_UsageStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageStatsResponse_UsageStat) ProtoMessage() {}
+
+func (x *GetUsageStatsResponse_UsageStat) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageStatsResponse_UsageStat.ProtoReflect.Descriptor instead.
+func (*GetUsageStatsResponse_UsageStat) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *GetUsageStatsResponse_UsageStat) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUsageStatsResponse_UsageStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetUsageStatsResponse_UsageStat) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *GetUsageStatsResponse_UsageStat) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *GetUsageStatsResponse_UsageStat) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *GetUsageStatsResponse_UsageStat) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+type GetUsageStatsResponse_UsageQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DailyLimit   int64 `protobuf:"varint,1,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`       // 每日配额，0 表示不限制
+	DailyUsed    int64 `protobuf:"varint,2,opt,name=daily_used,json=dailyUsed,proto3" json:"daily_used,omitempty"`          // 今日已用
+	MonthlyLimit int64 `protobuf:"varint,3,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"` // 每月配额，0 表示不限制
+	MonthlyUsed  int64 `protobuf:"varint,4,opt,name=monthly_used,json=monthlyUsed,proto3" json:"monthly_used,omitempty"`    // 本月已用
+}
+
+func (x *GetUsageStatsResponse_UsageQuota) Reset() {
+	*x = GetUsageStatsResponse_UsageQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageStatsResponse_UsageQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageStatsResponse_UsageQuota) ProtoMessage() {}
+
+func (x *GetUsageStatsResponse_UsageQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageStatsResponse_UsageQuota.ProtoReflect.Descriptor instead.
+func (*GetUsageStatsResponse_UsageQuota) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *GetUsageStatsResponse_UsageQuota) GetDailyLimit() int64 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *GetUsageStatsResponse_UsageQuota) GetDailyUsed() int64 {
+	if x != nil {
+		return x.DailyUsed
+	}
+	return 0
+}
+
+func (x *GetUsageStatsResponse_UsageQuota) GetMonthlyLimit() int64 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+func (x *GetUsageStatsResponse_UsageQuota) GetMonthlyUsed() int64 {
+	if x != nil {
+		return x.MonthlyUsed
+	}
+	return 0
+}
+
+type UploadDocumentResponse_DocData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocId      string       `protobuf:"bytes,1,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"` // 文档ID
+	Duplicated bool         `protobuf:"varint,2,opt,name=duplicated,proto3" json:"duplicated,omitempty"`   // 内容已存在，未重复入库
+	Redactions []*Redaction `protobuf:"bytes,3,rep,name=redactions,proto3" json:"redactions,omitempty"`    // 写入向量库前被脱敏的内容
+}
+
+func (x *UploadDocumentResponse_DocData) Reset() {
+	*x = UploadDocumentResponse_DocData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDocumentResponse_DocData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentResponse_DocData) ProtoMessage() {}
+
+func (x *UploadDocumentResponse_DocData) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentResponse_DocData.ProtoReflect.Descriptor instead.
+func (*UploadDocumentResponse_DocData) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *UploadDocumentResponse_DocData) GetDocId() string {
+	if x != nil {
+		return x.DocId
+	}
+	return ""
+}
+
+func (x *UploadDocumentResponse_DocData) GetDuplicated() bool {
+	if x != nil {
+		return x.Duplicated
+	}
+	return false
+}
+
+func (x *UploadDocumentResponse_DocData) GetRedactions() []*Redaction {
+	if x != nil {
+		return x.Redactions
+	}
+	return nil
+}
+
+type AnalyzeLogsResponse_SuggestionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RootCause          string                             `protobuf:"bytes,1,opt,name=root_cause,json=rootCause,proto3" json:"root_cause,omitempty"`                            // 可能的根因
+	AffectedComponents []string                           `protobuf:"bytes,2,rep,name=affected_components,json=affectedComponents,proto3" json:"affected_components,omitempty"` // 受影响的组件
+	Severity           string                             `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`                                               // 严重程度: critical/high/medium/low
+	RemediationSteps   []string                           `protobuf:"bytes,4,rep,name=remediation_steps,json=remediationSteps,proto3" json:"remediation_steps,omitempty"`       // 修复步骤
+	Templates          []*AnalyzeLogsResponse_LogTemplate `protobuf:"bytes,5,rep,name=templates,proto3" json:"templates,omitempty"`                                             // 聚类后的日志模板
+	Windows            int32                              `protobuf:"varint,6,opt,name=windows,proto3" json:"windows,omitempty"`                                                // 实际分析的窗口数
+	Redactions         []*Redaction                       `protobuf:"bytes,7,rep,name=redactions,proto3" json:"redactions,omitempty"`                                           // 发送给大模型前被脱敏的内容
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) Reset() {
+	*x = AnalyzeLogsResponse_SuggestionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeLogsResponse_SuggestionData) ProtoMessage() {}
+
+func (x *AnalyzeLogsResponse_SuggestionData) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeLogsResponse_SuggestionData.ProtoReflect.Descriptor instead.
+func (*AnalyzeLogsResponse_SuggestionData) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) GetRootCause() string {
+	if x != nil {
+		return x.RootCause
+	}
+	return ""
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) GetAffectedComponents() []string {
+	if x != nil {
+		return x.AffectedComponents
+	}
+	return nil
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) GetRemediationSteps() []string {
+	if x != nil {
+		return x.RemediationSteps
+	}
+	return nil
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) GetTemplates() []*AnalyzeLogsResponse_LogTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) GetWindows() int32 {
+	if x != nil {
+		return x.Windows
+	}
+	return 0
+}
+
+func (x *AnalyzeLogsResponse_SuggestionData) GetRedactions() []*Redaction {
+	if x != nil {
+		return x.Redactions
+	}
+	return nil
+}
+
+type AnalyzeLogsResponse_LogTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"` // 日志模板
+	Count    int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`      // 出现次数
+	Sample   string `protobuf:"bytes,3,opt,name=sample,proto3" json:"sample,omitempty"`     // 原始样例
+}
+
+func (x *AnalyzeLogsResponse_LogTemplate) Reset() {
+	*x = AnalyzeLogsResponse_LogTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeLogsResponse_LogTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeLogsResponse_LogTemplate) ProtoMessage() {}
+
+func (x *AnalyzeLogsResponse_LogTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeLogsResponse_LogTemplate.ProtoReflect.Descriptor instead.
+func (*AnalyzeLogsResponse_LogTemplate) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{37, 1}
+}
+
+func (x *AnalyzeLogsResponse_LogTemplate) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *AnalyzeLogsResponse_LogTemplate) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AnalyzeLogsResponse_LogTemplate) GetSample() string {
+	if x != nil {
+		return x.Sample
+	}
+	return ""
+}
+
+type GenerateAlertRuleResponse_GeneratedData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule        *AlertRuleDraft `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`               // 通过 PromQL 校验的告警规则
+	Explanation string          `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"` // 表达式说明
+	Attempts    int32           `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`      // 调用大模型的次数，包含校验失败后的重试
+}
+
+func (x *GenerateAlertRuleResponse_GeneratedData) Reset() {
+	*x = GenerateAlertRuleResponse_GeneratedData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ai_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateAlertRuleResponse_GeneratedData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAlertRuleResponse_GeneratedData) ProtoMessage() {}
+
+func (x *GenerateAlertRuleResponse_GeneratedData) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ai_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAlertRuleResponse_GeneratedData.ProtoReflect.Descriptor instead.
+func (*GenerateAlertRuleResponse_GeneratedData) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ai_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *GenerateAlertRuleResponse_GeneratedData) GetRule() *AlertRuleDraft {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *GenerateAlertRuleResponse_GeneratedData) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *GenerateAlertRuleResponse_GeneratedData) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+var File_aicoreops_ai_proto protoreflect.FileDescriptor
+
+var file_aicoreops_ai_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x61, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x69, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x61, 0x69, 0x22, 0x45, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
+	0xb1, 0x02, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f,
+	0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2c,
+	0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x99, 0x03, 0x0a,
+	0x12, 0x41, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f,
+	0x70, 0x4b, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x72, 0x61, 0x6e,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x50, 0x22, 0xee, 0x03, 0x0a, 0x13, 0x41, 0x73, 0x6b,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61,
+	0x69, 0x2e, 0x41, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xf0, 0x02, 0x0a, 0x0a, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x63, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x69,
+	0x2e, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x6c,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x69, 0x2e, 0x54, 0x6f, 0x6f,
+	0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x08, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12,
+	0x2f, 0x0a, 0x0b, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x69, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x69, 0x2e, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x09, 0x52, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x4c, 0x0a, 0x08, 0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x65,
+	0x0a, 0x0a, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa8, 0x01, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0b, 0x66, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x69, 0x2e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x67, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa3, 0x03,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x6b, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x1a, 0xad, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65,
+	0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x46, 0x0a,
+	0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x48, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
+	0x42, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5f, 0x0a, 0x12, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb2, 0x02, 0x0a,
+	0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x61, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x1a, 0x9b, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x48, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x4a, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xe0, 0x01,
+	0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x65, 0x0a, 0x0a, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x9d, 0x04, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x1a, 0xc9, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x1a, 0x94, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x55, 0x73, 0x65, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x15,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x69,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x6f, 0x0a, 0x07, 0x44, 0x6f, 0x63, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x15, 0x0a, 0x06, 0x64, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x69,
+	0x2e, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x22, 0x7a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22,
+	0x2b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x61, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x76, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f,
+	0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x68, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2e, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x64, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x6f, 0x63, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7d, 0x0a,
+	0x12, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23,
+	0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x90, 0x04, 0x0a,
+	0x13, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x61, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xb5,
+	0x02, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x13, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x61, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x69,
+	0x2e, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x57, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22,
+	0xb8, 0x03, 0x0a, 0x06, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x69, 0x2e, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf0, 0x01, 0x0a, 0x0e, 0x46,
+	0x69, 0x78, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73,
+	0x6b, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x46, 0x69,
+	0x78, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f, 0x0a,
+	0x0f, 0x46, 0x69, 0x78, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x69, 0x2e, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x29,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x69, 0x2e,
+	0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x63, 0x0a, 0x14,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x65, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x78, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x69, 0x2e, 0x46, 0x69, 0x78, 0x52,
+	0x75, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8a, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x74, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x1b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x22, 0x74, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x69, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x67, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x89, 0x01,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x69, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x31, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x1c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73,
+	0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x72,
+	0x65, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xf4, 0x02, 0x0a, 0x0e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x72, 0x65, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x65, 0x78, 0x70, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x72, 0x61, 0x66, 0x61, 0x6e, 0x61, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x66, 0x61, 0x6e, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x81, 0x02, 0x0a,
+	0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x75, 0x0a, 0x0d, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x69, 0x2e, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x22, 0xcf, 0x02, 0x0a, 0x0d, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x72, 0x65, 0x65, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x72,
+	0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x72, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdf, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x0a, 0x0c,
+	0x74, 0x72, 0x65, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x22, 0x72, 0x0a, 0x1b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3d,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x74,
+	0x72, 0x65, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x71, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x69, 0x2e, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x30, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xdd, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4a, 0x6f, 0x62, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xf9, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x1a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x64, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x03, 0x6a, 0x6f, 0x62,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x69, 0x2e, 0x52, 0x65, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x2b, 0x0a, 0x13, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x69, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x30, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
+	0x69, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xfb, 0x07, 0x0a, 0x08, 0x41, 0x49, 0x48, 0x65, 0x6c, 0x70,
+	0x65, 0x72, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x43,
+	0x68, 0x61, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+	0x65, 0x77, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x61, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x61, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12,
+	0x15, 0x2e, 0x61, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x15, 0x2e, 0x61,
+	0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x61, 0x69, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x69, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x41, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x41, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x61, 0x69, 0x2e, 0x41, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x19, 0x2e,
+	0x61, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x6f, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x61,
+	0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0x4d, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x12, 0x16, 0x2e, 0x61, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x69, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xbd, 0x01, 0x0a, 0x07, 0x41, 0x75, 0x74, 0x6f, 0x46, 0x69, 0x78, 0x12, 0x32,
+	0x0a, 0x07, 0x46, 0x69, 0x78, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x2e, 0x61, 0x69, 0x2e, 0x46,
+	0x69, 0x78, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x61, 0x69, 0x2e, 0x46, 0x69, 0x78, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x12,
+	0x14, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x78, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x12, 0x18, 0x2e,
+	0x61, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x69, 0x2e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x78, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0x66, 0x0a, 0x12, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x2e,
+	0x61, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x69,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd2, 0x03, 0x0a, 0x15, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x61,
+	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x61,
+	0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xf3, 0x02, 0x0a, 0x14, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12,
+	0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x56, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1d,
+	0x2e, 0x61, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x61, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa6, 0x01, 0x0a, 0x12, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0c,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x2e, 0x61,
+	0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x61, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09,
+	0x5a, 0x07, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_aicoreops_ai_proto_rawDescOnce sync.Once
+	file_aicoreops_ai_proto_rawDescData = file_aicoreops_ai_proto_rawDesc
+)
+
+func file_aicoreops_ai_proto_rawDescGZIP() []byte {
+	file_aicoreops_ai_proto_rawDescOnce.Do(func() {
+		file_aicoreops_ai_proto_rawDescData = protoimpl.X.CompressGZIP(file_aicoreops_ai_proto_rawDescData)
+	})
+	return file_aicoreops_ai_proto_rawDescData
+}
+
+var file_aicoreops_ai_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
+var file_aicoreops_ai_proto_goTypes = []any{
+	(*HistorySession)(nil),                          // 0: ai.HistorySession
+	(*Document)(nil),                                // 1: ai.Document
+	(*CreateNewChatRequest)(nil),                    // 2: ai.CreateNewChatRequest
+	(*CreateNewChatResponse)(nil),                   // 3: ai.CreateNewChatResponse
+	(*AskQuestionRequest)(nil),                      // 4: ai.AskQuestionRequest
+	(*AskQuestionResponse)(nil),                     // 5: ai.AskQuestionResponse
+	(*Redaction)(nil),                               // 6: ai.Redaction
+	(*ToolCall)(nil),                                // 7: ai.ToolCall
+	(*ToolResult)(nil),                              // 8: ai.ToolResult
+	(*Citation)(nil),                                // 9: ai.Citation
+	(*GetChatListRequest)(nil),                      // 10: ai.GetChatListRequest
+	(*GetChatListResponse)(nil),                     // 11: ai.GetChatListResponse
+	(*GetChatHistoryRequest)(nil),                   // 12: ai.GetChatHistoryRequest
+	(*GetChatHistoryResponse)(nil),                  // 13: ai.GetChatHistoryResponse
+	(*SubmitFeedbackRequest)(nil),                   // 14: ai.SubmitFeedbackRequest
+	(*SubmitFeedbackResponse)(nil),                  // 15: ai.SubmitFeedbackResponse
+	(*RenameChatRequest)(nil),                       // 16: ai.RenameChatRequest
+	(*RenameChatResponse)(nil),                      // 17: ai.RenameChatResponse
+	(*DeleteChatRequest)(nil),                       // 18: ai.DeleteChatRequest
+	(*DeleteChatResponse)(nil),                      // 19: ai.DeleteChatResponse
+	(*SearchChatsRequest)(nil),                      // 20: ai.SearchChatsRequest
+	(*SearchChatsResponse)(nil),                     // 21: ai.SearchChatsResponse
+	(*ExportChatRequest)(nil),                       // 22: ai.ExportChatRequest
+	(*ExportChatResponse)(nil),                      // 23: ai.ExportChatResponse
+	(*GetUsageStatsRequest)(nil),                    // 24: ai.GetUsageStatsRequest
+	(*GetUsageStatsResponse)(nil),                   // 25: ai.GetUsageStatsResponse
+	(*UploadDocumentRequest)(nil),                   // 26: ai.UploadDocumentRequest
+	(*UploadDocumentResponse)(nil),                  // 27: ai.UploadDocumentResponse
+	(*GetDocListRequest)(nil),                       // 28: ai.GetDocListRequest
+	(*GetDocListResponse)(nil),                      // 29: ai.GetDocListResponse
+	(*GetDocumentRequest)(nil),                      // 30: ai.GetDocumentRequest
+	(*GetDocumentResponse)(nil),                     // 31: ai.GetDocumentResponse
+	(*UpdateDocumentRequest)(nil),                   // 32: ai.UpdateDocumentRequest
+	(*UpdateDocumentResponse)(nil),                  // 33: ai.UpdateDocumentResponse
+	(*DeleteDocumentRequest)(nil),                   // 34: ai.DeleteDocumentRequest
+	(*DeleteDocumentResponse)(nil),                  // 35: ai.DeleteDocumentResponse
+	(*AnalyzeLogsRequest)(nil),                      // 36: ai.AnalyzeLogsRequest
+	(*AnalyzeLogsResponse)(nil),                     // 37: ai.AnalyzeLogsResponse
+	(*FixRun)(nil),                                  // 38: ai.FixRun
+	(*FixTaskRequest)(nil),                          // 39: ai.FixTaskRequest
+	(*FixTaskResponse)(nil),                         // 40: ai.FixTaskResponse
+	(*GetFixRunRequest)(nil),                        // 41: ai.GetFixRunRequest
+	(*GetFixRunResponse)(nil),                       // 42: ai.GetFixRunResponse
+	(*ApproveFixRunRequest)(nil),                    // 43: ai.ApproveFixRunRequest
+	(*ApproveFixRunResponse)(nil),                   // 44: ai.ApproveFixRunResponse
+	(*PromptTemplate)(nil),                          // 45: ai.PromptTemplate
+	(*CreatePromptTemplateRequest)(nil),             // 46: ai.CreatePromptTemplateRequest
+	(*CreatePromptTemplateResponse)(nil),            // 47: ai.CreatePromptTemplateResponse
+	(*UpdatePromptTemplateRequest)(nil),             // 48: ai.UpdatePromptTemplateRequest
+	(*UpdatePromptTemplateResponse)(nil),            // 49: ai.UpdatePromptTemplateResponse
+	(*GetPromptTemplateRequest)(nil),                // 50: ai.GetPromptTemplateRequest
+	(*GetPromptTemplateResponse)(nil),               // 51: ai.GetPromptTemplateResponse
+	(*ListPromptTemplatesRequest)(nil),              // 52: ai.ListPromptTemplatesRequest
+	(*ListPromptTemplatesResponse)(nil),             // 53: ai.ListPromptTemplatesResponse
+	(*DeletePromptTemplateRequest)(nil),             // 54: ai.DeletePromptTemplateRequest
+	(*DeletePromptTemplateResponse)(nil),            // 55: ai.DeletePromptTemplateResponse
+	(*GenerateAlertRuleRequest)(nil),                // 56: ai.GenerateAlertRuleRequest
+	(*AlertRuleDraft)(nil),                          // 57: ai.AlertRuleDraft
+	(*GenerateAlertRuleResponse)(nil),               // 58: ai.GenerateAlertRuleResponse
+	(*KnowledgeBase)(nil),                           // 59: ai.KnowledgeBase
+	(*CreateKnowledgeBaseRequest)(nil),              // 60: ai.CreateKnowledgeBaseRequest
+	(*CreateKnowledgeBaseResponse)(nil),             // 61: ai.CreateKnowledgeBaseResponse
+	(*UpdateKnowledgeBaseRequest)(nil),              // 62: ai.UpdateKnowledgeBaseRequest
+	(*UpdateKnowledgeBaseResponse)(nil),             // 63: ai.UpdateKnowledgeBaseResponse
+	(*ListKnowledgeBasesRequest)(nil),               // 64: ai.ListKnowledgeBasesRequest
+	(*ListKnowledgeBasesResponse)(nil),              // 65: ai.ListKnowledgeBasesResponse
+	(*DeleteKnowledgeBaseRequest)(nil),              // 66: ai.DeleteKnowledgeBaseRequest
+	(*DeleteKnowledgeBaseResponse)(nil),             // 67: ai.DeleteKnowledgeBaseResponse
+	(*ReindexJob)(nil),                              // 68: ai.ReindexJob
+	(*ReindexStatus)(nil),                           // 69: ai.ReindexStatus
+	(*StartReindexRequest)(nil),                     // 70: ai.StartReindexRequest
+	(*StartReindexResponse)(nil),                    // 71: ai.StartReindexResponse
+	(*GetReindexStatusRequest)(nil),                 // 72: ai.GetReindexStatusRequest
+	(*GetReindexStatusResponse)(nil),                // 73: ai.GetReindexStatusResponse
+	(*CreateNewChatResponse_SessionData)(nil),       // 74: ai.CreateNewChatResponse.SessionData
+	(*AskQuestionResponse_AnswerData)(nil),          // 75: ai.AskQuestionResponse.AnswerData
+	(*GetChatHistoryResponse_ChatHistoryData)(nil),  // 76: ai.GetChatHistoryResponse.ChatHistoryData
+	(*GetChatHistoryResponse_ChatMessage)(nil),      // 77: ai.GetChatHistoryResponse.ChatMessage
+	(*SearchChatsResponse_ChatSearchHit)(nil),       // 78: ai.SearchChatsResponse.ChatSearchHit
+	(*ExportChatResponse_ExportData)(nil),           // 79: ai.ExportChatResponse.ExportData
+	(*GetUsageStatsResponse_UsageStat)(nil),         // 80: ai.GetUsageStatsResponse.UsageStat
+	(*GetUsageStatsResponse_UsageQuota)(nil),        // 81: ai.GetUsageStatsResponse.UsageQuota
+	(*UploadDocumentResponse_DocData)(nil),          // 82: ai.UploadDocumentResponse.DocData
+	(*AnalyzeLogsResponse_SuggestionData)(nil),      // 83: ai.AnalyzeLogsResponse.SuggestionData
+	(*AnalyzeLogsResponse_LogTemplate)(nil),         // 84: ai.AnalyzeLogsResponse.LogTemplate
+	nil,                                             // 85: ai.FixRun.ParamsEntry
+	nil,                                             // 86: ai.FixTaskRequest.ParamsEntry
+	(*GenerateAlertRuleResponse_GeneratedData)(nil), // 87: ai.GenerateAlertRuleResponse.GeneratedData
+}
+var file_aicoreops_ai_proto_depIdxs = []int32{
+	74, // 0: ai.CreateNewChatResponse.data:type_name -> ai.CreateNewChatResponse.SessionData
+	75, // 1: ai.AskQuestionResponse.data:type_name -> ai.AskQuestionResponse.AnswerData
+	0,  // 2: ai.GetChatListResponse.data:type_name -> ai.HistorySession
+	76, // 3: ai.GetChatHistoryResponse.data:type_name -> ai.GetChatHistoryResponse.ChatHistoryData
+	78, // 4: ai.SearchChatsResponse.data:type_name -> ai.SearchChatsResponse.ChatSearchHit
+	79, // 5: ai.ExportChatResponse.data:type_name -> ai.ExportChatResponse.ExportData
+	80, // 6: ai.GetUsageStatsResponse.data:type_name -> ai.GetUsageStatsResponse.UsageStat
+	81, // 7: ai.GetUsageStatsResponse.quota:type_name -> ai.GetUsageStatsResponse.UsageQuota
+	82, // 8: ai.UploadDocumentResponse.data:type_name -> ai.UploadDocumentResponse.DocData
+	1,  // 9: ai.GetDocListResponse.data:type_name -> ai.Document
+	1,  // 10: ai.GetDocumentResponse.data:type_name -> ai.Document
+	1,  // 11: ai.UpdateDocumentResponse.data:type_name -> ai.Document
+	83, // 12: ai.AnalyzeLogsResponse.data:type_name -> ai.AnalyzeLogsResponse.SuggestionData
+	85, // 13: ai.FixRun.params:type_name -> ai.FixRun.ParamsEntry
+	86, // 14: ai.FixTaskRequest.params:type_name -> ai.FixTaskRequest.ParamsEntry
+	38, // 15: ai.FixTaskResponse.data:type_name -> ai.FixRun
+	38, // 16: ai.GetFixRunResponse.data:type_name -> ai.FixRun
+	38, // 17: ai.ApproveFixRunResponse.data:type_name -> ai.FixRun
+	45, // 18: ai.CreatePromptTemplateResponse.data:type_name -> ai.PromptTemplate
+	45, // 19: ai.UpdatePromptTemplateResponse.data:type_name -> ai.PromptTemplate
+	45, // 20: ai.GetPromptTemplateResponse.data:type_name -> ai.PromptTemplate
+	45, // 21: ai.ListPromptTemplatesResponse.data:type_name -> ai.PromptTemplate
+	87, // 22: ai.GenerateAlertRuleResponse.data:type_name -> ai.GenerateAlertRuleResponse.GeneratedData
+	59, // 23: ai.CreateKnowledgeBaseResponse.data:type_name -> ai.KnowledgeBase
+	59, // 24: ai.UpdateKnowledgeBaseResponse.data:type_name -> ai.KnowledgeBase
+	59, // 25: ai.ListKnowledgeBasesResponse.data:type_name -> ai.KnowledgeBase
+	68, // 26: ai.ReindexStatus.job:type_name -> ai.ReindexJob
+	68, // 27: ai.StartReindexResponse.data:type_name -> ai.ReindexJob
+	69, // 28: ai.GetReindexStatusResponse.data:type_name -> ai.ReindexStatus
+	9,  // 29: ai.AskQuestionResponse.AnswerData.citations:type_name -> ai.Citation
+	7,  // 30: ai.AskQuestionResponse.AnswerData.tool_call:type_name -> ai.ToolCall
+	8,  // 31: ai.AskQuestionResponse.AnswerData.tool_result:type_name -> ai.ToolResult
+	6,  // 32: ai.AskQuestionResponse.AnswerData.redactions:type_name -> ai.Redaction
+	77, // 33: ai.GetChatHistoryResponse.ChatHistoryData.messages:type_name -> ai.GetChatHistoryResponse.ChatMessage
+	6,  // 34: ai.UploadDocumentResponse.DocData.redactions:type_name -> ai.Redaction
+	84, // 35: ai.AnalyzeLogsResponse.SuggestionData.templates:type_name -> ai.AnalyzeLogsResponse.LogTemplate
+	6,  // 36: ai.AnalyzeLogsResponse.SuggestionData.redactions:type_name -> ai.Redaction
+	57, // 37: ai.GenerateAlertRuleResponse.GeneratedData.rule:type_name -> ai.AlertRuleDraft
+	2,  // 38: ai.AIHelper.CreateNewChat:input_type -> ai.CreateNewChatRequest
+	10, // 39: ai.AIHelper.GetChatList:input_type -> ai.GetChatListRequest
+	12, // 40: ai.AIHelper.GetChatHistory:input_type -> ai.GetChatHistoryRequest
+	16, // 41: ai.AIHelper.RenameChat:input_type -> ai.RenameChatRequest
+	18, // 42: ai.AIHelper.DeleteChat:input_type -> ai.DeleteChatRequest
+	20, // 43: ai.AIHelper.SearchChats:input_type -> ai.SearchChatsRequest
+	22, // 44: ai.AIHelper.ExportChat:input_type -> ai.ExportChatRequest
+	24, // 45: ai.AIHelper.GetUsageStats:input_type -> ai.GetUsageStatsRequest
+	26, // 46: ai.AIHelper.UploadDocument:input_type -> ai.UploadDocumentRequest
+	4,  // 47: ai.AIHelper.AskQuestion:input_type -> ai.AskQuestionRequest
+	14, // 48: ai.AIHelper.SubmitFeedback:input_type -> ai.SubmitFeedbackRequest
+	28, // 49: ai.AIHelper.GetDocList:input_type -> ai.GetDocListRequest
+	30, // 50: ai.AIHelper.GetDocument:input_type -> ai.GetDocumentRequest
+	32, // 51: ai.AIHelper.UpdateDocument:input_type -> ai.UpdateDocumentRequest
+	34, // 52: ai.AIHelper.DeleteDocument:input_type -> ai.DeleteDocumentRequest
+	36, // 53: ai.LogAnalysis.AnalyzeLogs:input_type -> ai.AnalyzeLogsRequest
+	39, // 54: ai.AutoFix.FixTask:input_type -> ai.FixTaskRequest
+	41, // 55: ai.AutoFix.GetFixRun:input_type -> ai.GetFixRunRequest
+	43, // 56: ai.AutoFix.ApproveFixRun:input_type -> ai.ApproveFixRunRequest
+	56, // 57: ai.AlertRuleAssistant.GenerateAlertRule:input_type -> ai.GenerateAlertRuleRequest
+	46, // 58: ai.PromptTemplateService.CreatePromptTemplate:input_type -> ai.CreatePromptTemplateRequest
+	48, // 59: ai.PromptTemplateService.UpdatePromptTemplate:input_type -> ai.UpdatePromptTemplateRequest
+	50, // 60: ai.PromptTemplateService.GetPromptTemplate:input_type -> ai.GetPromptTemplateRequest
+	52, // 61: ai.PromptTemplateService.ListPromptTemplates:input_type -> ai.ListPromptTemplatesRequest
+	54, // 62: ai.PromptTemplateService.DeletePromptTemplate:input_type -> ai.DeletePromptTemplateRequest
+	60, // 63: ai.KnowledgeBaseService.CreateKnowledgeBase:input_type -> ai.CreateKnowledgeBaseRequest
+	62, // 64: ai.KnowledgeBaseService.UpdateKnowledgeBase:input_type -> ai.UpdateKnowledgeBaseRequest
+	64, // 65: ai.KnowledgeBaseService.ListKnowledgeBases:input_type -> ai.ListKnowledgeBasesRequest
+	66, // 66: ai.KnowledgeBaseService.DeleteKnowledgeBase:input_type -> ai.DeleteKnowledgeBaseRequest
+	70, // 67: ai.VectorIndexService.StartReindex:input_type -> ai.StartReindexRequest
+	72, // 68: ai.VectorIndexService.GetReindexStatus:input_type -> ai.GetReindexStatusRequest
+	3,  // 69: ai.AIHelper.CreateNewChat:output_type -> ai.CreateNewChatResponse
+	11, // 70: ai.AIHelper.GetChatList:output_type -> ai.GetChatListResponse
+	13, // 71: ai.AIHelper.GetChatHistory:output_type -> ai.GetChatHistoryResponse
+	17, // 72: ai.AIHelper.RenameChat:output_type -> ai.RenameChatResponse
+	19, // 73: ai.AIHelper.DeleteChat:output_type -> ai.DeleteChatResponse
+	21, // 74: ai.AIHelper.SearchChats:output_type -> ai.SearchChatsResponse
+	23, // 75: ai.AIHelper.ExportChat:output_type -> ai.ExportChatResponse
+	25, // 76: ai.AIHelper.GetUsageStats:output_type -> ai.GetUsageStatsResponse
+	27, // 77: ai.AIHelper.UploadDocument:output_type -> ai.UploadDocumentResponse
+	5,  // 78: ai.AIHelper.AskQuestion:output_type -> ai.AskQuestionResponse
+	15, // 79: ai.AIHelper.SubmitFeedback:output_type -> ai.SubmitFeedbackResponse
+	29, // 80: ai.AIHelper.GetDocList:output_type -> ai.GetDocListResponse
+	31, // 81: ai.AIHelper.GetDocument:output_type -> ai.GetDocumentResponse
+	33, // 82: ai.AIHelper.UpdateDocument:output_type -> ai.UpdateDocumentResponse
+	35, // 83: ai.AIHelper.DeleteDocument:output_type -> ai.DeleteDocumentResponse
+	37, // 84: ai.LogAnalysis.AnalyzeLogs:output_type -> ai.AnalyzeLogsResponse
+	40, // 85: ai.AutoFix.FixTask:output_type -> ai.FixTaskResponse
+	42, // 86: ai.AutoFix.GetFixRun:output_type -> ai.GetFixRunResponse
+	44, // 87: ai.AutoFix.ApproveFixRun:output_type -> ai.ApproveFixRunResponse
+	58, // 88: ai.AlertRuleAssistant.GenerateAlertRule:output_type -> ai.GenerateAlertRuleResponse
+	47, // 89: ai.PromptTemplateService.CreatePromptTemplate:output_type -> ai.CreatePromptTemplateResponse
+	49, // 90: ai.PromptTemplateService.UpdatePromptTemplate:output_type -> ai.UpdatePromptTemplateResponse
+	51, // 91: ai.PromptTemplateService.GetPromptTemplate:output_type -> ai.GetPromptTemplateResponse
+	53, // 92: ai.PromptTemplateService.ListPromptTemplates:output_type -> ai.ListPromptTemplatesResponse
+	55, // 93: ai.PromptTemplateService.DeletePromptTemplate:output_type -> ai.DeletePromptTemplateResponse
+	61, // 94: ai.KnowledgeBaseService.CreateKnowledgeBase:output_type -> ai.CreateKnowledgeBaseResponse
+	63, // 95: ai.KnowledgeBaseService.UpdateKnowledgeBase:output_type -> ai.UpdateKnowledgeBaseResponse
+	65, // 96: ai.KnowledgeBaseService.ListKnowledgeBases:output_type -> ai.ListKnowledgeBasesResponse
+	67, // 97: ai.KnowledgeBaseService.DeleteKnowledgeBase:output_type -> ai.DeleteKnowledgeBaseResponse
+	71, // 98: ai.VectorIndexService.StartReindex:output_type -> ai.StartReindexResponse
+	73, // 99: ai.VectorIndexService.GetReindexStatus:output_type -> ai.GetReindexStatusResponse
+	69, // [69:100] is the sub-list for method output_type
+	38, // [38:69] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_aicoreops_ai_proto_init() }
+func file_aicoreops_ai_proto_init() {
+	if File_aicoreops_ai_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_aicoreops_ai_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*HistorySession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Document); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateNewChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateNewChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*AskQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*AskQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Redaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ToolCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ToolResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Citation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChatListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChatListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChatHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetChatHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitFeedbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitFeedbackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*RenameChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*RenameChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchChatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchChatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDocListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDocListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*FixRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*FixTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*FixTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -1734,8 +7647,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateNewChatRequest); i {
+		file_aicoreops_ai_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFixRunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1746,8 +7659,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateNewChatResponse); i {
+		file_aicoreops_ai_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFixRunResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1758,8 +7671,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*AskQuestionRequest); i {
+		file_aicoreops_ai_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveFixRunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1770,8 +7683,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*AskQuestionResponse); i {
+		file_aicoreops_ai_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*ApproveFixRunResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1782,8 +7695,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetChatListRequest); i {
+		file_aicoreops_ai_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1794,8 +7707,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GetChatListResponse); i {
+		file_aicoreops_ai_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePromptTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1806,8 +7719,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetChatHistoryRequest); i {
+		file_aicoreops_ai_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePromptTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1818,8 +7731,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetChatHistoryResponse); i {
+		file_aicoreops_ai_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePromptTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1830,8 +7743,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*UploadDocumentRequest); i {
+		file_aicoreops_ai_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePromptTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1842,8 +7755,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*UploadDocumentResponse); i {
+		file_aicoreops_ai_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPromptTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1854,8 +7767,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDocListRequest); i {
+		file_aicoreops_ai_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPromptTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1866,8 +7779,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDocListResponse); i {
+		file_aicoreops_ai_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPromptTemplatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1878,8 +7791,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*AnalyzeLogsRequest); i {
+		file_aicoreops_ai_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPromptTemplatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1890,8 +7803,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*AnalyzeLogsResponse); i {
+		file_aicoreops_ai_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePromptTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1902,8 +7815,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*FixTaskRequest); i {
+		file_aicoreops_ai_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePromptTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1914,8 +7827,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*FixTaskResponse); i {
+		file_aicoreops_ai_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateAlertRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1926,7 +7839,211 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_aicoreops_ai_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*AlertRuleDraft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateAlertRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*KnowledgeBase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateKnowledgeBaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateKnowledgeBaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateKnowledgeBaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateKnowledgeBaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*ListKnowledgeBasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*ListKnowledgeBasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteKnowledgeBaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteKnowledgeBaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ReindexJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ReindexStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*StartReindexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*StartReindexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReindexStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReindexStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[74].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateNewChatResponse_SessionData); i {
 			case 0:
 				return &v.state
@@ -1938,7 +8055,7 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_aicoreops_ai_proto_msgTypes[75].Exporter = func(v any, i int) any {
 			switch v := v.(*AskQuestionResponse_AnswerData); i {
 			case 0:
 				return &v.state
@@ -1950,7 +8067,7 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[19].Exporter = func(v any, i int) any {
+		file_aicoreops_ai_proto_msgTypes[76].Exporter = func(v any, i int) any {
 			switch v := v.(*GetChatHistoryResponse_ChatHistoryData); i {
 			case 0:
 				return &v.state
@@ -1962,7 +8079,7 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[20].Exporter = func(v any, i int) any {
+		file_aicoreops_ai_proto_msgTypes[77].Exporter = func(v any, i int) any {
 			switch v := v.(*GetChatHistoryResponse_ChatMessage); i {
 			case 0:
 				return &v.state
@@ -1974,8 +8091,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*UploadDocumentResponse_DocData); i {
+		file_aicoreops_ai_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchChatsResponse_ChatSearchHit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1986,8 +8103,8 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDocListResponse_DocData); i {
+		file_aicoreops_ai_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportChatResponse_ExportData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1998,7 +8115,43 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[23].Exporter = func(v any, i int) any {
+		file_aicoreops_ai_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageStatsResponse_UsageStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageStatsResponse_UsageQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadDocumentResponse_DocData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[83].Exporter = func(v any, i int) any {
 			switch v := v.(*AnalyzeLogsResponse_SuggestionData); i {
 			case 0:
 				return &v.state
@@ -2010,8 +8163,20 @@ func file_aicoreops_ai_proto_init() {
 				return nil
 			}
 		}
-		file_aicoreops_ai_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*FixTaskResponse_FixStatusData); i {
+		file_aicoreops_ai_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*AnalyzeLogsResponse_LogTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ai_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateAlertRuleResponse_GeneratedData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2029,9 +8194,9 @@ func file_aicoreops_ai_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_aicoreops_ai_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   88,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   7,
 		},
 		GoTypes:           file_aicoreops_ai_proto_goTypes,
 		DependencyIndexes: file_aicoreops_ai_proto_depIdxs,
@@ -22,9 +22,18 @@ const (
 	AIHelper_CreateNewChat_FullMethodName  = "/ai.AIHelper/CreateNewChat"
 	AIHelper_GetChatList_FullMethodName    = "/ai.AIHelper/GetChatList"
 	AIHelper_GetChatHistory_FullMethodName = "/ai.AIHelper/GetChatHistory"
+	AIHelper_RenameChat_FullMethodName     = "/ai.AIHelper/RenameChat"
+	AIHelper_DeleteChat_FullMethodName     = "/ai.AIHelper/DeleteChat"
+	AIHelper_SearchChats_FullMethodName    = "/ai.AIHelper/SearchChats"
+	AIHelper_ExportChat_FullMethodName     = "/ai.AIHelper/ExportChat"
+	AIHelper_GetUsageStats_FullMethodName  = "/ai.AIHelper/GetUsageStats"
 	AIHelper_UploadDocument_FullMethodName = "/ai.AIHelper/UploadDocument"
 	AIHelper_AskQuestion_FullMethodName    = "/ai.AIHelper/AskQuestion"
+	AIHelper_SubmitFeedback_FullMethodName = "/ai.AIHelper/SubmitFeedback"
 	AIHelper_GetDocList_FullMethodName     = "/ai.AIHelper/GetDocList"
+	AIHelper_GetDocument_FullMethodName    = "/ai.AIHelper/GetDocument"
+	AIHelper_UpdateDocument_FullMethodName = "/ai.AIHelper/UpdateDocument"
+	AIHelper_DeleteDocument_FullMethodName = "/ai.AIHelper/DeleteDocument"
 )
 
 // AIHelperClient is the client API for AIHelper service.
@@ -40,12 +49,30 @@ type AIHelperClient interface {
 	GetChatList(ctx context.Context, in *GetChatListRequest, opts ...grpc.CallOption) (*GetChatListResponse, error)
 	// 获取具体会话历史
 	GetChatHistory(ctx context.Context, in *GetChatHistoryRequest, opts ...grpc.CallOption) (*GetChatHistoryResponse, error)
+	// 重命名会话
+	RenameChat(ctx context.Context, in *RenameChatRequest, opts ...grpc.CallOption) (*RenameChatResponse, error)
+	// 删除会话
+	DeleteChat(ctx context.Context, in *DeleteChatRequest, opts ...grpc.CallOption) (*DeleteChatResponse, error)
+	// 搜索历史问答
+	SearchChats(ctx context.Context, in *SearchChatsRequest, opts ...grpc.CallOption) (*SearchChatsResponse, error)
+	// 导出会话
+	ExportChat(ctx context.Context, in *ExportChatRequest, opts ...grpc.CallOption) (*ExportChatResponse, error)
+	// 查询 token 用量
+	GetUsageStats(ctx context.Context, in *GetUsageStatsRequest, opts ...grpc.CallOption) (*GetUsageStatsResponse, error)
 	// 上传文档到知识库
 	UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error)
 	// 用户提问，获取解答
 	AskQuestion(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AskQuestionRequest, AskQuestionResponse], error)
+	// 对回答提交反馈
+	SubmitFeedback(ctx context.Context, in *SubmitFeedbackRequest, opts ...grpc.CallOption) (*SubmitFeedbackResponse, error)
 	// 获取文档列表
 	GetDocList(ctx context.Context, in *GetDocListRequest, opts ...grpc.CallOption) (*GetDocListResponse, error)
+	// 获取文档详情
+	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error)
+	// 更新文档
+	UpdateDocument(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*UpdateDocumentResponse, error)
+	// 删除文档
+	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
 }
 
 type aIHelperClient struct {
@@ -86,6 +113,56 @@ func (c *aIHelperClient) GetChatHistory(ctx context.Context, in *GetChatHistoryR
 	return out, nil
 }
 
+func (c *aIHelperClient) RenameChat(ctx context.Context, in *RenameChatRequest, opts ...grpc.CallOption) (*RenameChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameChatResponse)
+	err := c.cc.Invoke(ctx, AIHelper_RenameChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIHelperClient) DeleteChat(ctx context.Context, in *DeleteChatRequest, opts ...grpc.CallOption) (*DeleteChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChatResponse)
+	err := c.cc.Invoke(ctx, AIHelper_DeleteChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIHelperClient) SearchChats(ctx context.Context, in *SearchChatsRequest, opts ...grpc.CallOption) (*SearchChatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchChatsResponse)
+	err := c.cc.Invoke(ctx, AIHelper_SearchChats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIHelperClient) ExportChat(ctx context.Context, in *ExportChatRequest, opts ...grpc.CallOption) (*ExportChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportChatResponse)
+	err := c.cc.Invoke(ctx, AIHelper_ExportChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIHelperClient) GetUsageStats(ctx context.Context, in *GetUsageStatsRequest, opts ...grpc.CallOption) (*GetUsageStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageStatsResponse)
+	err := c.cc.Invoke(ctx, AIHelper_GetUsageStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aIHelperClient) UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadDocumentResponse)
@@ -109,6 +186,16 @@ func (c *aIHelperClient) AskQuestion(ctx context.Context, opts ...grpc.CallOptio
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AIHelper_AskQuestionClient = grpc.BidiStreamingClient[AskQuestionRequest, AskQuestionResponse]
 
+func (c *aIHelperClient) SubmitFeedback(ctx context.Context, in *SubmitFeedbackRequest, opts ...grpc.CallOption) (*SubmitFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitFeedbackResponse)
+	err := c.cc.Invoke(ctx, AIHelper_SubmitFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aIHelperClient) GetDocList(ctx context.Context, in *GetDocListRequest, opts ...grpc.CallOption) (*GetDocListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDocListResponse)
@@ -119,6 +206,36 @@ func (c *aIHelperClient) GetDocList(ctx context.Context, in *GetDocListRequest,
 	return out, nil
 }
 
+func (c *aIHelperClient) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentResponse)
+	err := c.cc.Invoke(ctx, AIHelper_GetDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIHelperClient) UpdateDocument(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*UpdateDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDocumentResponse)
+	err := c.cc.Invoke(ctx, AIHelper_UpdateDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIHelperClient) DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, AIHelper_DeleteDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AIHelperServer is the server API for AIHelper service.
 // All implementations must embed UnimplementedAIHelperServer
 // for forward compatibility.
@@ -132,12 +249,30 @@ type AIHelperServer interface {
 	GetChatList(context.Context, *GetChatListRequest) (*GetChatListResponse, error)
 	// 获取具体会话历史
 	GetChatHistory(context.Context, *GetChatHistoryRequest) (*GetChatHistoryResponse, error)
+	// 重命名会话
+	RenameChat(context.Context, *RenameChatRequest) (*RenameChatResponse, error)
+	// 删除会话
+	DeleteChat(context.Context, *DeleteChatRequest) (*DeleteChatResponse, error)
+	// 搜索历史问答
+	SearchChats(context.Context, *SearchChatsRequest) (*SearchChatsResponse, error)
+	// 导出会话
+	ExportChat(context.Context, *ExportChatRequest) (*ExportChatResponse, error)
+	// 查询 token 用量
+	GetUsageStats(context.Context, *GetUsageStatsRequest) (*GetUsageStatsResponse, error)
 	// 上传文档到知识库
 	UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error)
 	// 用户提问，获取解答
 	AskQuestion(grpc.BidiStreamingServer[AskQuestionRequest, AskQuestionResponse]) error
+	// 对回答提交反馈
+	SubmitFeedback(context.Context, *SubmitFeedbackRequest) (*SubmitFeedbackResponse, error)
 	// 获取文档列表
 	GetDocList(context.Context, *GetDocListRequest) (*GetDocListResponse, error)
+	// 获取文档详情
+	GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
+	// 更新文档
+	UpdateDocument(context.Context, *UpdateDocumentRequest) (*UpdateDocumentResponse, error)
+	// 删除文档
+	DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
 	mustEmbedUnimplementedAIHelperServer()
 }
 
@@ -157,15 +292,42 @@ func (UnimplementedAIHelperServer) GetChatList(context.Context, *GetChatListRequ
 func (UnimplementedAIHelperServer) GetChatHistory(context.Context, *GetChatHistoryRequest) (*GetChatHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChatHistory not implemented")
 }
+func (UnimplementedAIHelperServer) RenameChat(context.Context, *RenameChatRequest) (*RenameChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameChat not implemented")
+}
+func (UnimplementedAIHelperServer) DeleteChat(context.Context, *DeleteChatRequest) (*DeleteChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChat not implemented")
+}
+func (UnimplementedAIHelperServer) SearchChats(context.Context, *SearchChatsRequest) (*SearchChatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchChats not implemented")
+}
+func (UnimplementedAIHelperServer) ExportChat(context.Context, *ExportChatRequest) (*ExportChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportChat not implemented")
+}
+func (UnimplementedAIHelperServer) GetUsageStats(context.Context, *GetUsageStatsRequest) (*GetUsageStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageStats not implemented")
+}
 func (UnimplementedAIHelperServer) UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadDocument not implemented")
 }
 func (UnimplementedAIHelperServer) AskQuestion(grpc.BidiStreamingServer[AskQuestionRequest, AskQuestionResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method AskQuestion not implemented")
 }
+func (UnimplementedAIHelperServer) SubmitFeedback(context.Context, *SubmitFeedbackRequest) (*SubmitFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeedback not implemented")
+}
 func (UnimplementedAIHelperServer) GetDocList(context.Context, *GetDocListRequest) (*GetDocListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDocList not implemented")
 }
+func (UnimplementedAIHelperServer) GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocument not implemented")
+}
+func (UnimplementedAIHelperServer) UpdateDocument(context.Context, *UpdateDocumentRequest) (*UpdateDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocument not implemented")
+}
+func (UnimplementedAIHelperServer) DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDocument not implemented")
+}
 func (UnimplementedAIHelperServer) mustEmbedUnimplementedAIHelperServer() {}
 func (UnimplementedAIHelperServer) testEmbeddedByValue()                  {}
 
@@ -241,6 +403,96 @@ func _AIHelper_GetChatHistory_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AIHelper_RenameChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).RenameChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_RenameChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).RenameChat(ctx, req.(*RenameChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIHelper_DeleteChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).DeleteChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_DeleteChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).DeleteChat(ctx, req.(*DeleteChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIHelper_SearchChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).SearchChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_SearchChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).SearchChats(ctx, req.(*SearchChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIHelper_ExportChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).ExportChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_ExportChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).ExportChat(ctx, req.(*ExportChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIHelper_GetUsageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).GetUsageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_GetUsageStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).GetUsageStats(ctx, req.(*GetUsageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AIHelper_UploadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadDocumentRequest)
 	if err := dec(in); err != nil {
@@ -266,6 +518,24 @@ func _AIHelper_AskQuestion_Handler(srv interface{}, stream grpc.ServerStream) er
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AIHelper_AskQuestionServer = grpc.BidiStreamingServer[AskQuestionRequest, AskQuestionResponse]
 
+func _AIHelper_SubmitFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).SubmitFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_SubmitFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).SubmitFeedback(ctx, req.(*SubmitFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AIHelper_GetDocList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDocListRequest)
 	if err := dec(in); err != nil {
@@ -284,6 +554,60 @@ func _AIHelper_GetDocList_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AIHelper_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).GetDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_GetDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).GetDocument(ctx, req.(*GetDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIHelper_UpdateDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).UpdateDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_UpdateDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).UpdateDocument(ctx, req.(*UpdateDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIHelper_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIHelperServer).DeleteDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIHelper_DeleteDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIHelperServer).DeleteDocument(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AIHelper_ServiceDesc is the grpc.ServiceDesc for AIHelper service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -303,14 +627,50 @@ var AIHelper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChatHistory",
 			Handler:    _AIHelper_GetChatHistory_Handler,
 		},
+		{
+			MethodName: "RenameChat",
+			Handler:    _AIHelper_RenameChat_Handler,
+		},
+		{
+			MethodName: "DeleteChat",
+			Handler:    _AIHelper_DeleteChat_Handler,
+		},
+		{
+			MethodName: "SearchChats",
+			Handler:    _AIHelper_SearchChats_Handler,
+		},
+		{
+			MethodName: "ExportChat",
+			Handler:    _AIHelper_ExportChat_Handler,
+		},
+		{
+			MethodName: "GetUsageStats",
+			Handler:    _AIHelper_GetUsageStats_Handler,
+		},
 		{
 			MethodName: "UploadDocument",
 			Handler:    _AIHelper_UploadDocument_Handler,
 		},
+		{
+			MethodName: "SubmitFeedback",
+			Handler:    _AIHelper_SubmitFeedback_Handler,
+		},
 		{
 			MethodName: "GetDocList",
 			Handler:    _AIHelper_GetDocList_Handler,
 		},
+		{
+			MethodName: "GetDocument",
+			Handler:    _AIHelper_GetDocument_Handler,
+		},
+		{
+			MethodName: "UpdateDocument",
+			Handler:    _AIHelper_UpdateDocument_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _AIHelper_DeleteDocument_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -432,7 +792,9 @@ var LogAnalysis_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	AutoFix_FixTask_FullMethodName = "/ai.AutoFix/FixTask"
+	AutoFix_FixTask_FullMethodName       = "/ai.AutoFix/FixTask"
+	AutoFix_GetFixRun_FullMethodName     = "/ai.AutoFix/GetFixRun"
+	AutoFix_ApproveFixRun_FullMethodName = "/ai.AutoFix/ApproveFixRun"
 )
 
 // AutoFixClient is the client API for AutoFix service.
@@ -441,8 +803,12 @@ const (
 //
 // 自动修复服务
 type AutoFixClient interface {
-	// 执行修复任务
+	// 提交修复任务，生成计划变更并等待审批
 	FixTask(ctx context.Context, in *FixTaskRequest, opts ...grpc.CallOption) (*FixTaskResponse, error)
+	// 查询修复任务执行状态
+	GetFixRun(ctx context.Context, in *GetFixRunRequest, opts ...grpc.CallOption) (*GetFixRunResponse, error)
+	// 审批修复任务，批准后异步执行
+	ApproveFixRun(ctx context.Context, in *ApproveFixRunRequest, opts ...grpc.CallOption) (*ApproveFixRunResponse, error)
 }
 
 type autoFixClient struct {
@@ -463,14 +829,38 @@ func (c *autoFixClient) FixTask(ctx context.Context, in *FixTaskRequest, opts ..
 	return out, nil
 }
 
+func (c *autoFixClient) GetFixRun(ctx context.Context, in *GetFixRunRequest, opts ...grpc.CallOption) (*GetFixRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFixRunResponse)
+	err := c.cc.Invoke(ctx, AutoFix_GetFixRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoFixClient) ApproveFixRun(ctx context.Context, in *ApproveFixRunRequest, opts ...grpc.CallOption) (*ApproveFixRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveFixRunResponse)
+	err := c.cc.Invoke(ctx, AutoFix_ApproveFixRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AutoFixServer is the server API for AutoFix service.
 // All implementations must embed UnimplementedAutoFixServer
 // for forward compatibility.
 //
 // 自动修复服务
 type AutoFixServer interface {
-	// 执行修复任务
+	// 提交修复任务，生成计划变更并等待审批
 	FixTask(context.Context, *FixTaskRequest) (*FixTaskResponse, error)
+	// 查询修复任务执行状态
+	GetFixRun(context.Context, *GetFixRunRequest) (*GetFixRunResponse, error)
+	// 审批修复任务，批准后异步执行
+	ApproveFixRun(context.Context, *ApproveFixRunRequest) (*ApproveFixRunResponse, error)
 	mustEmbedUnimplementedAutoFixServer()
 }
 
@@ -484,6 +874,12 @@ type UnimplementedAutoFixServer struct{}
 func (UnimplementedAutoFixServer) FixTask(context.Context, *FixTaskRequest) (*FixTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FixTask not implemented")
 }
+func (UnimplementedAutoFixServer) GetFixRun(context.Context, *GetFixRunRequest) (*GetFixRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFixRun not implemented")
+}
+func (UnimplementedAutoFixServer) ApproveFixRun(context.Context, *ApproveFixRunRequest) (*ApproveFixRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFixRun not implemented")
+}
 func (UnimplementedAutoFixServer) mustEmbedUnimplementedAutoFixServer() {}
 func (UnimplementedAutoFixServer) testEmbeddedByValue()                 {}
 
@@ -523,6 +919,42 @@ func _AutoFix_FixTask_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutoFix_GetFixRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFixRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoFixServer).GetFixRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutoFix_GetFixRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoFixServer).GetFixRun(ctx, req.(*GetFixRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoFix_ApproveFixRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFixRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoFixServer).ApproveFixRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutoFix_ApproveFixRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoFixServer).ApproveFixRun(ctx, req.(*ApproveFixRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AutoFix_ServiceDesc is the grpc.ServiceDesc for AutoFix service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -534,6 +966,766 @@ var AutoFix_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FixTask",
 			Handler:    _AutoFix_FixTask_Handler,
 		},
+		{
+			MethodName: "GetFixRun",
+			Handler:    _AutoFix_GetFixRun_Handler,
+		},
+		{
+			MethodName: "ApproveFixRun",
+			Handler:    _AutoFix_ApproveFixRun_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aicoreops_ai.proto",
+}
+
+const (
+	AlertRuleAssistant_GenerateAlertRule_FullMethodName = "/ai.AlertRuleAssistant/GenerateAlertRule"
+)
+
+// AlertRuleAssistantClient is the client API for AlertRuleAssistant service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 告警规则生成服务
+type AlertRuleAssistantClient interface {
+	// 根据自然语言描述生成告警规则
+	GenerateAlertRule(ctx context.Context, in *GenerateAlertRuleRequest, opts ...grpc.CallOption) (*GenerateAlertRuleResponse, error)
+}
+
+type alertRuleAssistantClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlertRuleAssistantClient(cc grpc.ClientConnInterface) AlertRuleAssistantClient {
+	return &alertRuleAssistantClient{cc}
+}
+
+func (c *alertRuleAssistantClient) GenerateAlertRule(ctx context.Context, in *GenerateAlertRuleRequest, opts ...grpc.CallOption) (*GenerateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, AlertRuleAssistant_GenerateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AlertRuleAssistantServer is the server API for AlertRuleAssistant service.
+// All implementations must embed UnimplementedAlertRuleAssistantServer
+// for forward compatibility.
+//
+// 告警规则生成服务
+type AlertRuleAssistantServer interface {
+	// 根据自然语言描述生成告警规则
+	GenerateAlertRule(context.Context, *GenerateAlertRuleRequest) (*GenerateAlertRuleResponse, error)
+	mustEmbedUnimplementedAlertRuleAssistantServer()
+}
+
+// UnimplementedAlertRuleAssistantServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAlertRuleAssistantServer struct{}
+
+func (UnimplementedAlertRuleAssistantServer) GenerateAlertRule(context.Context, *GenerateAlertRuleRequest) (*GenerateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateAlertRule not implemented")
+}
+func (UnimplementedAlertRuleAssistantServer) mustEmbedUnimplementedAlertRuleAssistantServer() {}
+func (UnimplementedAlertRuleAssistantServer) testEmbeddedByValue()                            {}
+
+// UnsafeAlertRuleAssistantServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlertRuleAssistantServer will
+// result in compilation errors.
+type UnsafeAlertRuleAssistantServer interface {
+	mustEmbedUnimplementedAlertRuleAssistantServer()
+}
+
+func RegisterAlertRuleAssistantServer(s grpc.ServiceRegistrar, srv AlertRuleAssistantServer) {
+	// If the following call pancis, it indicates UnimplementedAlertRuleAssistantServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AlertRuleAssistant_ServiceDesc, srv)
+}
+
+func _AlertRuleAssistant_GenerateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertRuleAssistantServer).GenerateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertRuleAssistant_GenerateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertRuleAssistantServer).GenerateAlertRule(ctx, req.(*GenerateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AlertRuleAssistant_ServiceDesc is the grpc.ServiceDesc for AlertRuleAssistant service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AlertRuleAssistant_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai.AlertRuleAssistant",
+	HandlerType: (*AlertRuleAssistantServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateAlertRule",
+			Handler:    _AlertRuleAssistant_GenerateAlertRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aicoreops_ai.proto",
+}
+
+const (
+	PromptTemplateService_CreatePromptTemplate_FullMethodName = "/ai.PromptTemplateService/CreatePromptTemplate"
+	PromptTemplateService_UpdatePromptTemplate_FullMethodName = "/ai.PromptTemplateService/UpdatePromptTemplate"
+	PromptTemplateService_GetPromptTemplate_FullMethodName    = "/ai.PromptTemplateService/GetPromptTemplate"
+	PromptTemplateService_ListPromptTemplates_FullMethodName  = "/ai.PromptTemplateService/ListPromptTemplates"
+	PromptTemplateService_DeletePromptTemplate_FullMethodName = "/ai.PromptTemplateService/DeletePromptTemplate"
+)
+
+// PromptTemplateServiceClient is the client API for PromptTemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 提示词模板管理服务
+type PromptTemplateServiceClient interface {
+	// 创建模板
+	CreatePromptTemplate(ctx context.Context, in *CreatePromptTemplateRequest, opts ...grpc.CallOption) (*CreatePromptTemplateResponse, error)
+	// 更新模板，生成新版本
+	UpdatePromptTemplate(ctx context.Context, in *UpdatePromptTemplateRequest, opts ...grpc.CallOption) (*UpdatePromptTemplateResponse, error)
+	// 获取模板
+	GetPromptTemplate(ctx context.Context, in *GetPromptTemplateRequest, opts ...grpc.CallOption) (*GetPromptTemplateResponse, error)
+	// 获取模板列表
+	ListPromptTemplates(ctx context.Context, in *ListPromptTemplatesRequest, opts ...grpc.CallOption) (*ListPromptTemplatesResponse, error)
+	// 删除模板
+	DeletePromptTemplate(ctx context.Context, in *DeletePromptTemplateRequest, opts ...grpc.CallOption) (*DeletePromptTemplateResponse, error)
+}
+
+type promptTemplateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromptTemplateServiceClient(cc grpc.ClientConnInterface) PromptTemplateServiceClient {
+	return &promptTemplateServiceClient{cc}
+}
+
+func (c *promptTemplateServiceClient) CreatePromptTemplate(ctx context.Context, in *CreatePromptTemplateRequest, opts ...grpc.CallOption) (*CreatePromptTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromptTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_CreatePromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) UpdatePromptTemplate(ctx context.Context, in *UpdatePromptTemplateRequest, opts ...grpc.CallOption) (*UpdatePromptTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePromptTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_UpdatePromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) GetPromptTemplate(ctx context.Context, in *GetPromptTemplateRequest, opts ...grpc.CallOption) (*GetPromptTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPromptTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_GetPromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) ListPromptTemplates(ctx context.Context, in *ListPromptTemplatesRequest, opts ...grpc.CallOption) (*ListPromptTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptTemplatesResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_ListPromptTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) DeletePromptTemplate(ctx context.Context, in *DeletePromptTemplateRequest, opts ...grpc.CallOption) (*DeletePromptTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePromptTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_DeletePromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromptTemplateServiceServer is the server API for PromptTemplateService service.
+// All implementations must embed UnimplementedPromptTemplateServiceServer
+// for forward compatibility.
+//
+// 提示词模板管理服务
+type PromptTemplateServiceServer interface {
+	// 创建模板
+	CreatePromptTemplate(context.Context, *CreatePromptTemplateRequest) (*CreatePromptTemplateResponse, error)
+	// 更新模板，生成新版本
+	UpdatePromptTemplate(context.Context, *UpdatePromptTemplateRequest) (*UpdatePromptTemplateResponse, error)
+	// 获取模板
+	GetPromptTemplate(context.Context, *GetPromptTemplateRequest) (*GetPromptTemplateResponse, error)
+	// 获取模板列表
+	ListPromptTemplates(context.Context, *ListPromptTemplatesRequest) (*ListPromptTemplatesResponse, error)
+	// 删除模板
+	DeletePromptTemplate(context.Context, *DeletePromptTemplateRequest) (*DeletePromptTemplateResponse, error)
+	mustEmbedUnimplementedPromptTemplateServiceServer()
+}
+
+// UnimplementedPromptTemplateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromptTemplateServiceServer struct{}
+
+func (UnimplementedPromptTemplateServiceServer) CreatePromptTemplate(context.Context, *CreatePromptTemplateRequest) (*CreatePromptTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromptTemplate not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) UpdatePromptTemplate(context.Context, *UpdatePromptTemplateRequest) (*UpdatePromptTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromptTemplate not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) GetPromptTemplate(context.Context, *GetPromptTemplateRequest) (*GetPromptTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromptTemplate not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) ListPromptTemplates(context.Context, *ListPromptTemplatesRequest) (*ListPromptTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromptTemplates not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) DeletePromptTemplate(context.Context, *DeletePromptTemplateRequest) (*DeletePromptTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromptTemplate not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) mustEmbedUnimplementedPromptTemplateServiceServer() {}
+func (UnimplementedPromptTemplateServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafePromptTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromptTemplateServiceServer will
+// result in compilation errors.
+type UnsafePromptTemplateServiceServer interface {
+	mustEmbedUnimplementedPromptTemplateServiceServer()
+}
+
+func RegisterPromptTemplateServiceServer(s grpc.ServiceRegistrar, srv PromptTemplateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPromptTemplateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromptTemplateService_ServiceDesc, srv)
+}
+
+func _PromptTemplateService_CreatePromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).CreatePromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_CreatePromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).CreatePromptTemplate(ctx, req.(*CreatePromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_UpdatePromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).UpdatePromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_UpdatePromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).UpdatePromptTemplate(ctx, req.(*UpdatePromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_GetPromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).GetPromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_GetPromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).GetPromptTemplate(ctx, req.(*GetPromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_ListPromptTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).ListPromptTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_ListPromptTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).ListPromptTemplates(ctx, req.(*ListPromptTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_DeletePromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).DeletePromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_DeletePromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).DeletePromptTemplate(ctx, req.(*DeletePromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromptTemplateService_ServiceDesc is the grpc.ServiceDesc for PromptTemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromptTemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai.PromptTemplateService",
+	HandlerType: (*PromptTemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePromptTemplate",
+			Handler:    _PromptTemplateService_CreatePromptTemplate_Handler,
+		},
+		{
+			MethodName: "UpdatePromptTemplate",
+			Handler:    _PromptTemplateService_UpdatePromptTemplate_Handler,
+		},
+		{
+			MethodName: "GetPromptTemplate",
+			Handler:    _PromptTemplateService_GetPromptTemplate_Handler,
+		},
+		{
+			MethodName: "ListPromptTemplates",
+			Handler:    _PromptTemplateService_ListPromptTemplates_Handler,
+		},
+		{
+			MethodName: "DeletePromptTemplate",
+			Handler:    _PromptTemplateService_DeletePromptTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aicoreops_ai.proto",
+}
+
+const (
+	KnowledgeBaseService_CreateKnowledgeBase_FullMethodName = "/ai.KnowledgeBaseService/CreateKnowledgeBase"
+	KnowledgeBaseService_UpdateKnowledgeBase_FullMethodName = "/ai.KnowledgeBaseService/UpdateKnowledgeBase"
+	KnowledgeBaseService_ListKnowledgeBases_FullMethodName  = "/ai.KnowledgeBaseService/ListKnowledgeBases"
+	KnowledgeBaseService_DeleteKnowledgeBase_FullMethodName = "/ai.KnowledgeBaseService/DeleteKnowledgeBase"
+)
+
+// KnowledgeBaseServiceClient is the client API for KnowledgeBaseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 知识库管理服务
+type KnowledgeBaseServiceClient interface {
+	// 创建知识库
+	CreateKnowledgeBase(ctx context.Context, in *CreateKnowledgeBaseRequest, opts ...grpc.CallOption) (*CreateKnowledgeBaseResponse, error)
+	// 更新知识库
+	UpdateKnowledgeBase(ctx context.Context, in *UpdateKnowledgeBaseRequest, opts ...grpc.CallOption) (*UpdateKnowledgeBaseResponse, error)
+	// 获取有权读取的知识库列表
+	ListKnowledgeBases(ctx context.Context, in *ListKnowledgeBasesRequest, opts ...grpc.CallOption) (*ListKnowledgeBasesResponse, error)
+	// 删除知识库
+	DeleteKnowledgeBase(ctx context.Context, in *DeleteKnowledgeBaseRequest, opts ...grpc.CallOption) (*DeleteKnowledgeBaseResponse, error)
+}
+
+type knowledgeBaseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKnowledgeBaseServiceClient(cc grpc.ClientConnInterface) KnowledgeBaseServiceClient {
+	return &knowledgeBaseServiceClient{cc}
+}
+
+func (c *knowledgeBaseServiceClient) CreateKnowledgeBase(ctx context.Context, in *CreateKnowledgeBaseRequest, opts ...grpc.CallOption) (*CreateKnowledgeBaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateKnowledgeBaseResponse)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_CreateKnowledgeBase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) UpdateKnowledgeBase(ctx context.Context, in *UpdateKnowledgeBaseRequest, opts ...grpc.CallOption) (*UpdateKnowledgeBaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateKnowledgeBaseResponse)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_UpdateKnowledgeBase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) ListKnowledgeBases(ctx context.Context, in *ListKnowledgeBasesRequest, opts ...grpc.CallOption) (*ListKnowledgeBasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKnowledgeBasesResponse)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_ListKnowledgeBases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) DeleteKnowledgeBase(ctx context.Context, in *DeleteKnowledgeBaseRequest, opts ...grpc.CallOption) (*DeleteKnowledgeBaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteKnowledgeBaseResponse)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_DeleteKnowledgeBase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KnowledgeBaseServiceServer is the server API for KnowledgeBaseService service.
+// All implementations must embed UnimplementedKnowledgeBaseServiceServer
+// for forward compatibility.
+//
+// 知识库管理服务
+type KnowledgeBaseServiceServer interface {
+	// 创建知识库
+	CreateKnowledgeBase(context.Context, *CreateKnowledgeBaseRequest) (*CreateKnowledgeBaseResponse, error)
+	// 更新知识库
+	UpdateKnowledgeBase(context.Context, *UpdateKnowledgeBaseRequest) (*UpdateKnowledgeBaseResponse, error)
+	// 获取有权读取的知识库列表
+	ListKnowledgeBases(context.Context, *ListKnowledgeBasesRequest) (*ListKnowledgeBasesResponse, error)
+	// 删除知识库
+	DeleteKnowledgeBase(context.Context, *DeleteKnowledgeBaseRequest) (*DeleteKnowledgeBaseResponse, error)
+	mustEmbedUnimplementedKnowledgeBaseServiceServer()
+}
+
+// UnimplementedKnowledgeBaseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKnowledgeBaseServiceServer struct{}
+
+func (UnimplementedKnowledgeBaseServiceServer) CreateKnowledgeBase(context.Context, *CreateKnowledgeBaseRequest) (*CreateKnowledgeBaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKnowledgeBase not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) UpdateKnowledgeBase(context.Context, *UpdateKnowledgeBaseRequest) (*UpdateKnowledgeBaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKnowledgeBase not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) ListKnowledgeBases(context.Context, *ListKnowledgeBasesRequest) (*ListKnowledgeBasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKnowledgeBases not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) DeleteKnowledgeBase(context.Context, *DeleteKnowledgeBaseRequest) (*DeleteKnowledgeBaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKnowledgeBase not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) mustEmbedUnimplementedKnowledgeBaseServiceServer() {}
+func (UnimplementedKnowledgeBaseServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeKnowledgeBaseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KnowledgeBaseServiceServer will
+// result in compilation errors.
+type UnsafeKnowledgeBaseServiceServer interface {
+	mustEmbedUnimplementedKnowledgeBaseServiceServer()
+}
+
+func RegisterKnowledgeBaseServiceServer(s grpc.ServiceRegistrar, srv KnowledgeBaseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedKnowledgeBaseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KnowledgeBaseService_ServiceDesc, srv)
+}
+
+func _KnowledgeBaseService_CreateKnowledgeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKnowledgeBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).CreateKnowledgeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_CreateKnowledgeBase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).CreateKnowledgeBase(ctx, req.(*CreateKnowledgeBaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_UpdateKnowledgeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKnowledgeBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).UpdateKnowledgeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_UpdateKnowledgeBase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).UpdateKnowledgeBase(ctx, req.(*UpdateKnowledgeBaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_ListKnowledgeBases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKnowledgeBasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).ListKnowledgeBases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_ListKnowledgeBases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).ListKnowledgeBases(ctx, req.(*ListKnowledgeBasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_DeleteKnowledgeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKnowledgeBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).DeleteKnowledgeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_DeleteKnowledgeBase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).DeleteKnowledgeBase(ctx, req.(*DeleteKnowledgeBaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KnowledgeBaseService_ServiceDesc is the grpc.ServiceDesc for KnowledgeBaseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KnowledgeBaseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai.KnowledgeBaseService",
+	HandlerType: (*KnowledgeBaseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateKnowledgeBase",
+			Handler:    _KnowledgeBaseService_CreateKnowledgeBase_Handler,
+		},
+		{
+			MethodName: "UpdateKnowledgeBase",
+			Handler:    _KnowledgeBaseService_UpdateKnowledgeBase_Handler,
+		},
+		{
+			MethodName: "ListKnowledgeBases",
+			Handler:    _KnowledgeBaseService_ListKnowledgeBases_Handler,
+		},
+		{
+			MethodName: "DeleteKnowledgeBase",
+			Handler:    _KnowledgeBaseService_DeleteKnowledgeBase_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aicoreops_ai.proto",
+}
+
+const (
+	VectorIndexService_StartReindex_FullMethodName     = "/ai.VectorIndexService/StartReindex"
+	VectorIndexService_GetReindexStatus_FullMethodName = "/ai.VectorIndexService/GetReindexStatus"
+)
+
+// VectorIndexServiceClient is the client API for VectorIndexService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 向量索引管理服务
+type VectorIndexServiceClient interface {
+	// 发起重建索引，在后台将文档重新向量化写入新集合后切换
+	StartReindex(ctx context.Context, in *StartReindexRequest, opts ...grpc.CallOption) (*StartReindexResponse, error)
+	// 查询启用集合与重建进度
+	GetReindexStatus(ctx context.Context, in *GetReindexStatusRequest, opts ...grpc.CallOption) (*GetReindexStatusResponse, error)
+}
+
+type vectorIndexServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVectorIndexServiceClient(cc grpc.ClientConnInterface) VectorIndexServiceClient {
+	return &vectorIndexServiceClient{cc}
+}
+
+func (c *vectorIndexServiceClient) StartReindex(ctx context.Context, in *StartReindexRequest, opts ...grpc.CallOption) (*StartReindexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartReindexResponse)
+	err := c.cc.Invoke(ctx, VectorIndexService_StartReindex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vectorIndexServiceClient) GetReindexStatus(ctx context.Context, in *GetReindexStatusRequest, opts ...grpc.CallOption) (*GetReindexStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReindexStatusResponse)
+	err := c.cc.Invoke(ctx, VectorIndexService_GetReindexStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VectorIndexServiceServer is the server API for VectorIndexService service.
+// All implementations must embed UnimplementedVectorIndexServiceServer
+// for forward compatibility.
+//
+// 向量索引管理服务
+type VectorIndexServiceServer interface {
+	// 发起重建索引，在后台将文档重新向量化写入新集合后切换
+	StartReindex(context.Context, *StartReindexRequest) (*StartReindexResponse, error)
+	// 查询启用集合与重建进度
+	GetReindexStatus(context.Context, *GetReindexStatusRequest) (*GetReindexStatusResponse, error)
+	mustEmbedUnimplementedVectorIndexServiceServer()
+}
+
+// UnimplementedVectorIndexServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVectorIndexServiceServer struct{}
+
+func (UnimplementedVectorIndexServiceServer) StartReindex(context.Context, *StartReindexRequest) (*StartReindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReindex not implemented")
+}
+func (UnimplementedVectorIndexServiceServer) GetReindexStatus(context.Context, *GetReindexStatusRequest) (*GetReindexStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReindexStatus not implemented")
+}
+func (UnimplementedVectorIndexServiceServer) mustEmbedUnimplementedVectorIndexServiceServer() {}
+func (UnimplementedVectorIndexServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeVectorIndexServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VectorIndexServiceServer will
+// result in compilation errors.
+type UnsafeVectorIndexServiceServer interface {
+	mustEmbedUnimplementedVectorIndexServiceServer()
+}
+
+func RegisterVectorIndexServiceServer(s grpc.ServiceRegistrar, srv VectorIndexServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVectorIndexServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VectorIndexService_ServiceDesc, srv)
+}
+
+func _VectorIndexService_StartReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorIndexServiceServer).StartReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VectorIndexService_StartReindex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorIndexServiceServer).StartReindex(ctx, req.(*StartReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VectorIndexService_GetReindexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReindexStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorIndexServiceServer).GetReindexStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VectorIndexService_GetReindexStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorIndexServiceServer).GetReindexStatus(ctx, req.(*GetReindexStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VectorIndexService_ServiceDesc is the grpc.ServiceDesc for VectorIndexService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VectorIndexService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ai.VectorIndexService",
+	HandlerType: (*VectorIndexServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartReindex",
+			Handler:    _VectorIndexService_StartReindex_Handler,
+		},
+		{
+			MethodName: "GetReindexStatus",
+			Handler:    _VectorIndexService_GetReindexStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aicoreops_ai.proto",
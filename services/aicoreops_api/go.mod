@@ -3,7 +3,7 @@ module github.com/GoSimplicity/AICoreOps/services/aicoreops_api
 go 1.22.8
 
 require (
-	github.com/GoSimplicity/AICoreOps/services/aicoreops_common v0.0.0-20250202065926-89bcc03d2b98
+	github.com/GoSimplicity/AICoreOps/services/aicoreops_common v0.0.0-00010101000000-000000000000
 	github.com/casbin/casbin/v2 v2.102.0
 	github.com/casbin/gorm-adapter/v3 v3.32.0
 	github.com/gorilla/websocket v1.5.0
@@ -125,3 +125,5 @@ require (
 	google.golang.org/protobuf v1.36.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
+
+replace github.com/GoSimplicity/AICoreOps/services/aicoreops_common => ../aicoreops_common
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_api/internal/logic"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_api/internal/svc"
@@ -99,6 +100,378 @@ func (h *AiHandler) GetChatHistory(w http.ResponseWriter, r *http.Request) {
 	httpx.OkJsonCtx(r.Context(), w, resp)
 }
 
+func (h *AiHandler) RenameChat(w http.ResponseWriter, r *http.Request) {
+	var req types.RenameChatRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.RenameChat(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) DeleteChat(w http.ResponseWriter, r *http.Request) {
+	var req types.DeleteChatRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.DeleteChat(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) SearchChats(w http.ResponseWriter, r *http.Request) {
+	var req types.SearchChatsRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.SearchChats(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) SubmitFeedback(w http.ResponseWriter, r *http.Request) {
+	var req types.SubmitFeedbackRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.SubmitFeedback(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) CreateKnowledgeBase(w http.ResponseWriter, r *http.Request) {
+	var req types.CreateKnowledgeBaseRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.CreateKnowledgeBase(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) UpdateKnowledgeBase(w http.ResponseWriter, r *http.Request) {
+	var req types.UpdateKnowledgeBaseRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.UpdateKnowledgeBase(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) ListKnowledgeBases(w http.ResponseWriter, r *http.Request) {
+	var req types.ListKnowledgeBasesRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.ListKnowledgeBases(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) DeleteKnowledgeBase(w http.ResponseWriter, r *http.Request) {
+	var req types.DeleteKnowledgeBaseRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.DeleteKnowledgeBase(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) GenerateAlertRule(w http.ResponseWriter, r *http.Request) {
+	var req types.GenerateAlertRuleRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.GenerateAlertRule(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) GetUsageStats(w http.ResponseWriter, r *http.Request) {
+	var req types.GetUsageStatsRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.GetUsageStats(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) CreatePromptTemplate(w http.ResponseWriter, r *http.Request) {
+	var req types.CreatePromptTemplateRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.CreatePromptTemplate(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) UpdatePromptTemplate(w http.ResponseWriter, r *http.Request) {
+	var req types.UpdatePromptTemplateRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.UpdatePromptTemplate(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) GetPromptTemplate(w http.ResponseWriter, r *http.Request) {
+	var req types.GetPromptTemplateRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.GetPromptTemplate(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) ListPromptTemplates(w http.ResponseWriter, r *http.Request) {
+	var req types.ListPromptTemplatesRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.ListPromptTemplates(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) DeletePromptTemplate(w http.ResponseWriter, r *http.Request) {
+	var req types.DeletePromptTemplateRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.DeletePromptTemplate(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+// ExportChat 以附件形式下载导出的会话
+func (h *AiHandler) ExportChat(w http.ResponseWriter, r *http.Request) {
+	var req types.ExportChatRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.ExportChat(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	data := resp.GetData()
+	w.Header().Set("Content-Type", data.GetContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", data.GetFilename()))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(data.GetContent()))
+}
+
 func (h *AiHandler) UploadDocument(w http.ResponseWriter, r *http.Request) {
 	var req types.UploadDocumentRequest
 	if err := httpx.Parse(r, &req); err != nil {
@@ -143,6 +516,18 @@ func (h *AiHandler) AskQuestion(w http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	// 检索模式、重排序、Agent 模式与提示词模板为可选参数
+	retrievalMode := r.URL.Query().Get("retrieval_mode")
+	rerank, _ := strconv.ParseBool(r.URL.Query().Get("rerank"))
+	agent, _ := strconv.ParseBool(r.URL.Query().Get("agent"))
+	promptTemplate := r.URL.Query().Get("prompt_template")
+	// 检索的知识库，逗号分隔，为空时检索所有有权读取的知识库
+	var knowledgeBases []string
+	for _, kb := range strings.Split(r.URL.Query().Get("knowledge_bases"), ",") {
+		if kb = strings.TrimSpace(kb); kb != "" {
+			knowledgeBases = append(knowledgeBases, kb)
+		}
+	}
 
 	// 检查是否为 ws 连接
 	if !websocket.IsWebSocketUpgrade(r) {
@@ -154,7 +539,7 @@ func (h *AiHandler) AskQuestion(w http.ResponseWriter, r *http.Request) {
 	}
 
 	l := logic.NewAiLogic(r.Context(), h.svcCtx)
-	resp, err := l.AskQuestion(w, r, sessionId, title, float32(scoreThresholdFloat), int32(topKInt))
+	resp, err := l.AskQuestion(w, r, sessionId, title, float32(scoreThresholdFloat), int32(topKInt), retrievalMode, rerank, agent, promptTemplate, knowledgeBases)
 	if err != nil {
 		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
 			Code:    http.StatusInternalServerError,
@@ -182,3 +567,49 @@ func (h *AiHandler) NewChat(w http.ResponseWriter, r *http.Request) {
 
 	httpx.OkJsonCtx(r.Context(), w, resp)
 }
+
+func (h *AiHandler) StartReindex(w http.ResponseWriter, r *http.Request) {
+	var req types.StartReindexRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.StartReindex(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
+
+func (h *AiHandler) GetReindexStatus(w http.ResponseWriter, r *http.Request) {
+	var req types.GetReindexStatusRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+	l := logic.NewAiLogic(r.Context(), h.svcCtx)
+	resp, err := l.GetReindexStatus(&req)
+	if err != nil {
+		httpx.OkJsonCtx(r.Context(), w, types.GeneralResponse{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+		return
+	}
+	resp.Code = http.StatusOK
+
+	httpx.OkJsonCtx(r.Context(), w, resp)
+}
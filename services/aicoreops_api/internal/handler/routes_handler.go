@@ -89,6 +89,24 @@ func RegisterHandlers(r *Routers, serverCtx *svc.ServiceContext) {
 	aiGroup.Use(authMiddleware.Handle) // , casbinMiddleware.Handle)
 	aiGroup.Get("/ai/list", ai.GetChatList)
 	aiGroup.Get("/ai/chat", ai.GetChatHistory)
+	aiGroup.Post("/ai/chat/rename", ai.RenameChat)
+	aiGroup.Delete("/ai/chat/delete", ai.DeleteChat)
+	aiGroup.Get("/ai/chat/search", ai.SearchChats)
+	aiGroup.Get("/ai/chat/export", ai.ExportChat)
+	aiGroup.Post("/ai/feedback", ai.SubmitFeedback)
+	aiGroup.Get("/ai/usage", ai.GetUsageStats)
+	aiGroup.Post("/ai/prompt/create", ai.CreatePromptTemplate)
+	aiGroup.Post("/ai/prompt/update", ai.UpdatePromptTemplate)
+	aiGroup.Get("/ai/prompt/get", ai.GetPromptTemplate)
+	aiGroup.Get("/ai/prompt/list", ai.ListPromptTemplates)
+	aiGroup.Delete("/ai/prompt/delete", ai.DeletePromptTemplate)
+	aiGroup.Post("/ai/alert_rule/generate", ai.GenerateAlertRule)
+	aiGroup.Post("/ai/kb/create", ai.CreateKnowledgeBase)
+	aiGroup.Post("/ai/kb/update", ai.UpdateKnowledgeBase)
+	aiGroup.Get("/ai/kb/list", ai.ListKnowledgeBases)
+	aiGroup.Delete("/ai/kb/delete", ai.DeleteKnowledgeBase)
+	aiGroup.Post("/ai/reindex/start", ai.StartReindex)
+	aiGroup.Get("/ai/reindex/status", ai.GetReindexStatus)
 	aiGroup.Post("/ai/upload", ai.UploadDocument)
 	aiGroup.Get("/ai/ask", ai.AskQuestion)
 	aiGroup.Post("/ai/newChat", ai.NewChat)
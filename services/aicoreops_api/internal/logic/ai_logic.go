@@ -20,6 +20,7 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -102,8 +103,15 @@ func (l *AiLogic) GetChatList(ctx context.Context, page int32, pageSize int32) (
 
 // GetChatHistory 获取聊天历史
 func (l *AiLogic) GetChatHistory(req *types.GetChatHistoryRequest) (*ai.GetChatHistoryResponse, error) {
-	resp, err := l.svcCtx.AiRpc.GetChatHistory(l.ctx, &ai.GetChatHistoryRequest{
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.AiRpc.GetChatHistory(newCtx, &ai.GetChatHistoryRequest{
 		SessionId: req.SessionId,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("获取聊天历史失败: %v", err)
@@ -111,11 +119,370 @@ func (l *AiLogic) GetChatHistory(req *types.GetChatHistoryRequest) (*ai.GetChatH
 	return resp, nil
 }
 
+// RenameChat 重命名会话
+func (l *AiLogic) RenameChat(req *types.RenameChatRequest) (*ai.RenameChatResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.AiRpc.RenameChat(newCtx, &ai.RenameChatRequest{
+		SessionId: req.SessionId,
+		Title:     req.Title,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("重命名会话失败: %v", err)
+	}
+	return resp, nil
+}
+
+// DeleteChat 删除会话
+func (l *AiLogic) DeleteChat(req *types.DeleteChatRequest) (*ai.DeleteChatResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.AiRpc.DeleteChat(newCtx, &ai.DeleteChatRequest{
+		SessionId: req.SessionId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("删除会话失败: %v", err)
+	}
+	return resp, nil
+}
+
+// SearchChats 搜索历史问答
+func (l *AiLogic) SearchChats(req *types.SearchChatsRequest) (*ai.SearchChatsResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.AiRpc.SearchChats(newCtx, &ai.SearchChatsRequest{
+		Keyword:  req.Keyword,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("搜索历史问答失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ExportChat 导出会话
+func (l *AiLogic) ExportChat(req *types.ExportChatRequest) (*ai.ExportChatResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.AiRpc.ExportChat(newCtx, &ai.ExportChatRequest{
+		SessionId: req.SessionId,
+		Format:    req.Format,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("导出会话失败: %v", err)
+	}
+	return resp, nil
+}
+
+// SubmitFeedback 对回答提交反馈
+func (l *AiLogic) SubmitFeedback(req *types.SubmitFeedbackRequest) (*ai.SubmitFeedbackResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.AiRpc.SubmitFeedback(newCtx, &ai.SubmitFeedbackRequest{
+		HistoryId: req.HistoryId,
+		Rating:    req.Rating,
+		Comment:   req.Comment,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("提交反馈失败: %v", err)
+	}
+	return resp, nil
+}
+
+// GetUsageStats 查询 token 用量
+func (l *AiLogic) GetUsageStats(req *types.GetUsageStatsRequest) (*ai.GetUsageStatsResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.AiRpc.GetUsageStats(newCtx, &ai.GetUsageStatsRequest{
+		UserId:    req.UserId,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("查询 token 用量失败: %v", err)
+	}
+	return resp, nil
+}
+
+// CreatePromptTemplate 创建提示词模板
+func (l *AiLogic) CreatePromptTemplate(req *types.CreatePromptTemplateRequest) (*ai.CreatePromptTemplateResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.PromptRpc.CreatePromptTemplate(newCtx, &ai.CreatePromptTemplateRequest{
+		Name:            req.Name,
+		Feature:         req.Feature,
+		Description:     req.Description,
+		SystemPrompt:    req.SystemPrompt,
+		ContextTemplate: req.ContextTemplate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建提示词模板失败: %v", err)
+	}
+	return resp, nil
+}
+
+// UpdatePromptTemplate 更新提示词模板
+func (l *AiLogic) UpdatePromptTemplate(req *types.UpdatePromptTemplateRequest) (*ai.UpdatePromptTemplateResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.PromptRpc.UpdatePromptTemplate(newCtx, &ai.UpdatePromptTemplateRequest{
+		Name:            req.Name,
+		Description:     req.Description,
+		SystemPrompt:    req.SystemPrompt,
+		ContextTemplate: req.ContextTemplate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("更新提示词模板失败: %v", err)
+	}
+	return resp, nil
+}
+
+// GetPromptTemplate 获取提示词模板
+func (l *AiLogic) GetPromptTemplate(req *types.GetPromptTemplateRequest) (*ai.GetPromptTemplateResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.PromptRpc.GetPromptTemplate(newCtx, &ai.GetPromptTemplateRequest{
+		Name:    req.Name,
+		Version: req.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取提示词模板失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ListPromptTemplates 获取提示词模板列表
+func (l *AiLogic) ListPromptTemplates(req *types.ListPromptTemplatesRequest) (*ai.ListPromptTemplatesResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.PromptRpc.ListPromptTemplates(newCtx, &ai.ListPromptTemplatesRequest{
+		Feature:  req.Feature,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取提示词模板列表失败: %v", err)
+	}
+	return resp, nil
+}
+
+// DeletePromptTemplate 删除提示词模板
+func (l *AiLogic) DeletePromptTemplate(req *types.DeletePromptTemplateRequest) (*ai.DeletePromptTemplateResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.PromptRpc.DeletePromptTemplate(newCtx, &ai.DeletePromptTemplateRequest{
+		Name: req.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("删除提示词模板失败: %v", err)
+	}
+	return resp, nil
+}
+
+// GenerateAlertRule 根据自然语言描述生成告警规则
+func (l *AiLogic) GenerateAlertRule(req *types.GenerateAlertRuleRequest) (*ai.GenerateAlertRuleResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.AlertRuleRpc.GenerateAlertRule(newCtx, &ai.GenerateAlertRuleRequest{
+		Description:    req.Description,
+		Name:           req.Name,
+		PoolId:         req.PoolId,
+		SendGroupId:    req.SendGroupId,
+		TreeNodeId:     req.TreeNodeId,
+		PromptTemplate: req.PromptTemplate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("生成告警规则失败: %v", err)
+	}
+	return resp, nil
+}
+
+// CreateKnowledgeBase 创建知识库
+func (l *AiLogic) CreateKnowledgeBase(req *types.CreateKnowledgeBaseRequest) (*ai.CreateKnowledgeBaseResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.KnowledgeBaseRpc.CreateKnowledgeBase(newCtx, &ai.CreateKnowledgeBaseRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerTeam:   req.OwnerTeam,
+		TreeNodeId:  req.TreeNodeId,
+		Public:      req.Public,
+		Readers:     req.Readers,
+		Writers:     req.Writers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建知识库失败: %v", err)
+	}
+	return resp, nil
+}
+
+// UpdateKnowledgeBase 更新知识库
+func (l *AiLogic) UpdateKnowledgeBase(req *types.UpdateKnowledgeBaseRequest) (*ai.UpdateKnowledgeBaseResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.KnowledgeBaseRpc.UpdateKnowledgeBase(newCtx, &ai.UpdateKnowledgeBaseRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerTeam:   req.OwnerTeam,
+		TreeNodeId:  req.TreeNodeId,
+		Public:      req.Public,
+		Readers:     req.Readers,
+		Writers:     req.Writers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("更新知识库失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ListKnowledgeBases 获取有权读取的知识库列表
+func (l *AiLogic) ListKnowledgeBases(req *types.ListKnowledgeBasesRequest) (*ai.ListKnowledgeBasesResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.KnowledgeBaseRpc.ListKnowledgeBases(newCtx, &ai.ListKnowledgeBasesRequest{
+		TreeNodeId: req.TreeNodeId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取知识库列表失败: %v", err)
+	}
+	return resp, nil
+}
+
+// DeleteKnowledgeBase 删除知识库
+func (l *AiLogic) DeleteKnowledgeBase(req *types.DeleteKnowledgeBaseRequest) (*ai.DeleteKnowledgeBaseResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.KnowledgeBaseRpc.DeleteKnowledgeBase(newCtx, &ai.DeleteKnowledgeBaseRequest{
+		Name: req.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("删除知识库失败: %v", err)
+	}
+	return resp, nil
+}
+
+// StartReindex 发起重建索引
+func (l *AiLogic) StartReindex(req *types.StartReindexRequest) (*ai.StartReindexResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.VectorIndexRpc.StartReindex(newCtx, &ai.StartReindexRequest{
+		Force: req.Force,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("发起重建索引失败: %v", err)
+	}
+	return resp, nil
+}
+
+// GetReindexStatus 查询重建索引进度
+func (l *AiLogic) GetReindexStatus(req *types.GetReindexStatusRequest) (*ai.GetReindexStatusResponse, error) {
+	newCtx, err := l.withUid()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.svcCtx.VectorIndexRpc.GetReindexStatus(newCtx, &ai.GetReindexStatusRequest{
+		JobId: req.JobId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("查询重建索引进度失败: %v", err)
+	}
+	return resp, nil
+}
+
+// withUid 将当前用户ID和角色写入 gRPC metadata
+func (l *AiLogic) withUid() (context.Context, error) {
+	uid, ok := l.ctx.Value(middleware.UidKey{}).(int64)
+	if !ok {
+		return nil, fmt.Errorf("无效的用户ID类型或未找到用户ID")
+	}
+
+	return metadata.NewOutgoingContext(l.ctx, l.identity(uid)), nil
+}
+
+// identity 构建携带用户ID和角色的 metadata，AI 服务按角色校验知识库权限
+func (l *AiLogic) identity(uid int64) metadata.MD {
+	user := strconv.FormatInt(uid, 10)
+	md := metadata.Pairs("uid", user)
+
+	roles, err := l.svcCtx.Enforcer.GetRolesForUser(user)
+	if err != nil {
+		// 查询角色失败时只按用户ID鉴权
+		l.Logger.Errorf("获取用户角色失败: %v", err)
+		return md
+	}
+	if len(roles) > 0 {
+		md.Append("roles", roles...)
+	}
+	return md
+}
+
 // UploadDocument 上传文档
 func (l *AiLogic) UploadDocument(req *types.UploadDocumentRequest) (*ai.UploadDocumentResponse, error) {
-	resp, err := l.svcCtx.AiRpc.UploadDocument(l.ctx, &ai.UploadDocumentRequest{
-		Title:   req.Title,
-		Content: req.Content,
+	uid, ok := l.ctx.Value(middleware.UidKey{}).(int64)
+	if !ok {
+		return nil, fmt.Errorf("无效的用户ID类型或未找到用户ID")
+	}
+
+	newCtx := metadata.NewOutgoingContext(l.ctx, l.identity(uid))
+
+	resp, err := l.svcCtx.AiRpc.UploadDocument(newCtx, &ai.UploadDocumentRequest{
+		Title:         req.Title,
+		Content:       req.Content,
+		Format:        req.Format,
+		KnowledgeBase: req.KnowledgeBase,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("上传文档失败: %v", err)
@@ -124,7 +491,7 @@ func (l *AiLogic) UploadDocument(req *types.UploadDocumentRequest) (*ai.UploadDo
 }
 
 // AskQuestion 提问
-func (l *AiLogic) AskQuestion(w http.ResponseWriter, r *http.Request, sessionId string, title string, scoreThreshold float32, topK int32) (*ai.AskQuestionResponse, error) {
+func (l *AiLogic) AskQuestion(w http.ResponseWriter, r *http.Request, sessionId string, title string, scoreThreshold float32, topK int32, retrievalMode string, rerank bool, agent bool, promptTemplate string, knowledgeBases []string) (*ai.AskQuestionResponse, error) {
 	// 1. 验证会话有效性
 	uidValue := l.ctx.Value(middleware.UidKey{})
 	uid, ok := uidValue.(int64)
@@ -149,7 +516,8 @@ func (l *AiLogic) AskQuestion(w http.ResponseWriter, r *http.Request, sessionId
 	})
 
 	// 3. 建立 stream 双向流 RPC
-	md := metadata.Pairs("uid", strconv.FormatInt(uid, 10), "sessionID", sessionId)
+	md := l.identity(uid)
+	md.Set("sessionID", sessionId)
 	ctx := metadata.NewOutgoingContext(l.ctx, md)
 
 	stream, err := l.svcCtx.AiRpc.AskQuestion(ctx)
@@ -178,7 +546,7 @@ func (l *AiLogic) AskQuestion(w http.ResponseWriter, r *http.Request, sessionId
 	// 4.2从 WebSocket 接收消息并发送到 gRPC
 	go func() {
 		defer wg.Done()
-		l.sendMessages(conn, stream, opCtx, timeout, sessionId, title, scoreThreshold, topK)
+		l.sendMessages(conn, stream, opCtx, timeout, sessionId, title, scoreThreshold, topK, retrievalMode, rerank, agent, promptTemplate, knowledgeBases)
 	}()
 
 	// 4.3监控超时
@@ -200,6 +568,41 @@ func (l *AiLogic) AskQuestion(w http.ResponseWriter, r *http.Request, sessionId
 	return nil, nil
 }
 
+// citationsFrame 回答结束时推送给前端的引用来源，history_id 用于提交反馈；
+// truncated 表示回答被停止，parent_id 非 0 时为重新生成的回答，值为原问答记录ID
+type citationsFrame struct {
+	Type      string         `json:"type"`
+	SessionId string         `json:"session_id"`
+	HistoryId int64          `json:"history_id"`
+	Citations []*ai.Citation `json:"citations"`
+	Truncated bool           `json:"truncated"`
+	ParentId  int64          `json:"parent_id"`
+}
+
+// controlMessage 前端发送的控制指令，如 {"action":"stop"} 停止生成、{"action":"regenerate","temperature":0.9} 重新生成，
+// 其他消息作为问题发送
+type controlMessage struct {
+	Action      string  `json:"action"`
+	Temperature float32 `json:"temperature"`
+	TopP        float32 `json:"top_p"`
+}
+
+// errorFrame 服务端返回的业务错误
+type errorFrame struct {
+	Type      string `json:"type"`
+	SessionId string `json:"session_id"`
+	Code      int32  `json:"code"`
+	Message   string `json:"message"`
+}
+
+// toolFrame Agent 模式下推送给前端的工具调用或工具结果
+type toolFrame struct {
+	Type       string         `json:"type"`
+	SessionId  string         `json:"session_id"`
+	ToolCall   *ai.ToolCall   `json:"tool_call,omitempty"`
+	ToolResult *ai.ToolResult `json:"tool_result,omitempty"`
+}
+
 func (l *AiLogic) receiveResponses(conn *websocket.Conn, stream ai.AIHelper_AskQuestionClient, cancel context.CancelFunc, sessionId string) {
 	defer func() {
 		l.Logger.Infof("sessionId: %s, 接收响应 goroutine 退出", sessionId)
@@ -218,15 +621,60 @@ func (l *AiLogic) receiveResponses(conn *websocket.Conn, stream ai.AIHelper_AskQ
 			return
 		}
 
-		message := fmt.Sprintf("收到回答: %s", resp.GetData().GetAnswer())
-		if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		message := []byte(fmt.Sprintf("收到回答: %s", resp.GetData().GetAnswer()))
+		switch {
+		// 配额用尽等业务错误，连接保持可用
+		case resp.GetCode() != 0:
+			message, err = json.Marshal(errorFrame{
+				Type:      "error",
+				SessionId: resp.GetData().GetSessionId(),
+				Code:      resp.GetCode(),
+				Message:   resp.GetMessage(),
+			})
+			if err != nil {
+				l.Logger.Errorf("sessionId: %s, 序列化错误信息失败: %v", sessionId, err)
+				continue
+			}
+		// Agent 工具调用与结果作为独立的帧透传
+		case resp.GetData().GetToolCall() != nil || resp.GetData().GetToolResult() != nil:
+			frame := toolFrame{
+				Type:       "tool_call",
+				SessionId:  resp.GetData().GetSessionId(),
+				ToolCall:   resp.GetData().GetToolCall(),
+				ToolResult: resp.GetData().GetToolResult(),
+			}
+			if frame.ToolResult != nil {
+				frame.Type = "tool_result"
+			}
+			message, err = json.Marshal(frame)
+			if err != nil {
+				l.Logger.Errorf("sessionId: %s, 序列化工具调用失败: %v", sessionId, err)
+				continue
+			}
+		// 最后一帧透传引用来源
+		case resp.GetData().GetFinished():
+			message, err = json.Marshal(citationsFrame{
+				Type:      "citations",
+				SessionId: resp.GetData().GetSessionId(),
+				HistoryId: resp.GetData().GetHistoryId(),
+				Citations: resp.GetData().GetCitations(),
+				Truncated: resp.GetData().GetTruncated(),
+				ParentId:  resp.GetData().GetParentId(),
+			})
+			if err != nil {
+				l.Logger.Errorf("sessionId: %s, 序列化引用来源失败: %v", sessionId, err)
+				continue
+			}
+		}
+
+		if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
 			l.Logger.Errorf("sessionId: %s, 发送 WebSocket 消息失败: %v", sessionId, err)
 			return
 		}
 	}
 }
 
-func (l *AiLogic) sendMessages(conn *websocket.Conn, stream ai.AIHelper_AskQuestionClient, ctx context.Context, timeout *time.Timer, sessionId string, title string, scoreThreshold float32, topK int32) {
+func (l *AiLogic) sendMessages(conn *websocket.Conn, stream ai.AIHelper_AskQuestionClient, ctx context.Context, timeout *time.Timer, sessionId string, title string, scoreThreshold float32, topK int32, retrievalMode string, rerank bool, agent bool, promptTemplate string, knowledgeBases []string) {
 	for {
 		select {
 		case <-ctx.Done():
@@ -261,13 +709,28 @@ func (l *AiLogic) sendMessages(conn *websocket.Conn, stream ai.AIHelper_AskQuest
 			timeout.Reset(timeoutDuration)
 			l.Logger.Debugf("sessionId: %s, 重置超时计时器", sessionId)
 
-			// 发送消息到 gRPC 流
+			// 发送消息到 gRPC 流，生成回答期间也会转发控制指令
+			question := string(message)
+			var control controlMessage
+			if json.Unmarshal(message, &control) != nil || control.Action == "" {
+				control = controlMessage{}
+			} else {
+				question = ""
+			}
 			req := &ai.AskQuestionRequest{
-				Question:       string(message),
+				Question:       question,
+				Action:         control.Action,
+				Temperature:    control.Temperature,
+				TopP:           control.TopP,
 				SessionId:      sessionId,
 				Title:          title,
 				ScoreThreshold: scoreThreshold,
 				TopK:           topK,
+				RetrievalMode:  retrievalMode,
+				Rerank:         rerank,
+				Agent:          agent,
+				PromptTemplate: promptTemplate,
+				KnowledgeBases: knowledgeBases,
 			}
 
 			if err := stream.Send(req); err != nil {
@@ -40,14 +40,18 @@ import (
 )
 
 type ServiceContext struct {
-	Config   config.Config
-	UserRpc  user.UserServiceClient
-	ApiRpc   api.ApiServiceClient
-	MenuRpc  menu.MenuServiceClient
-	RoleRpc  role.RoleServiceClient
-	AiRpc    ai.AIHelperClient
-	RDB      redis.Cmdable
-	Enforcer *casbin.Enforcer
+	Config           config.Config
+	UserRpc          user.UserServiceClient
+	ApiRpc           api.ApiServiceClient
+	MenuRpc          menu.MenuServiceClient
+	RoleRpc          role.RoleServiceClient
+	AiRpc            ai.AIHelperClient
+	PromptRpc        ai.PromptTemplateServiceClient
+	AlertRuleRpc     ai.AlertRuleAssistantClient
+	KnowledgeBaseRpc ai.KnowledgeBaseServiceClient
+	VectorIndexRpc   ai.VectorIndexServiceClient
+	RDB              redis.Cmdable
+	Enforcer         *casbin.Enforcer
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -68,7 +72,12 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	// apiRpc := api.NewApiServiceClient(zrpc.MustNewClient(c.ApiRpc).Conn())
 	// menuRpc := menu.NewMenuServiceClient(zrpc.MustNewClient(c.MenuRpc).Conn())
 	// roleRpc := role.NewRoleServiceClient(zrpc.MustNewClient(c.RoleRpc).Conn())
-	aiRpc := ai.NewAIHelperClient(zrpc.MustNewClient(c.AiRpc).Conn())
+	aiConn := zrpc.MustNewClient(c.AiRpc).Conn()
+	aiRpc := ai.NewAIHelperClient(aiConn)
+	promptRpc := ai.NewPromptTemplateServiceClient(aiConn)
+	alertRuleRpc := ai.NewAlertRuleAssistantClient(aiConn)
+	knowledgeBaseRpc := ai.NewKnowledgeBaseServiceClient(aiConn)
+	vectorIndexRpc := ai.NewVectorIndexServiceClient(aiConn)
 
 	// 初始化数据库连接
 	db, err := gorm.Open(mysql.Open(c.Mysql.Addr), &gorm.Config{
@@ -101,8 +110,12 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		// ApiRpc:   apiRpc,
 		// MenuRpc:  menuRpc,
 		// RoleRpc:  roleRpc,
-		AiRpc:    aiRpc,
-		RDB:      rdb,
-		Enforcer: enforcer,
+		AiRpc:            aiRpc,
+		PromptRpc:        promptRpc,
+		AlertRuleRpc:     alertRuleRpc,
+		KnowledgeBaseRpc: knowledgeBaseRpc,
+		VectorIndexRpc:   vectorIndexRpc,
+		RDB:              rdb,
+		Enforcer:         enforcer,
 	}
 }
@@ -207,16 +207,128 @@ type GetHistoryListResponse struct {
 }
 
 type GetChatHistoryRequest struct {
-	SessionId string `json:"session_id"`
+	SessionId string `json:"session_id,optional" form:"session_id,optional"`
+	Page      int32  `json:"page,optional" form:"page,optional"`
+	PageSize  int32  `json:"page_size,optional" form:"page_size,optional"`
 }
 
 type GetChatHistoryResponse struct {
 	// History []string `json:"history"`
 }
 
+type RenameChatRequest struct {
+	SessionId string `json:"session_id"`
+	Title     string `json:"title"`
+}
+
+type DeleteChatRequest struct {
+	SessionId string `json:"session_id"`
+}
+
+type SearchChatsRequest struct {
+	Keyword  string `form:"keyword"`
+	Page     int32  `form:"page,default=1"`
+	PageSize int32  `form:"page_size,default=20"`
+}
+
+type ExportChatRequest struct {
+	SessionId string `form:"session_id"`
+	Format    string `form:"format,optional"`
+}
+
+type SubmitFeedbackRequest struct {
+	HistoryId int64  `json:"history_id"`
+	Rating    int32  `json:"rating"`
+	Comment   string `json:"comment,optional"`
+}
+
+type GetUsageStatsRequest struct {
+	UserId    int64  `form:"user_id,optional"`
+	StartDate string `form:"start_date,optional"`
+	EndDate   string `form:"end_date,optional"`
+}
+
+type CreatePromptTemplateRequest struct {
+	Name            string `json:"name"`
+	Feature         string `json:"feature"`
+	Description     string `json:"description,optional"`
+	SystemPrompt    string `json:"system_prompt"`
+	ContextTemplate string `json:"context_template,optional"`
+}
+
+type UpdatePromptTemplateRequest struct {
+	Name            string `json:"name"`
+	Description     string `json:"description,optional"`
+	SystemPrompt    string `json:"system_prompt"`
+	ContextTemplate string `json:"context_template,optional"`
+}
+
+type GetPromptTemplateRequest struct {
+	Name    string `form:"name"`
+	Version int32  `form:"version,optional"`
+}
+
+type ListPromptTemplatesRequest struct {
+	Feature  string `form:"feature,optional"`
+	Page     int32  `form:"page,default=1"`
+	PageSize int32  `form:"page_size,default=20"`
+}
+
+type DeletePromptTemplateRequest struct {
+	Name string `json:"name"`
+}
+
+type GenerateAlertRuleRequest struct {
+	Description    string `json:"description"`
+	Name           string `json:"name,optional"`
+	PoolId         int64  `json:"pool_id,optional"`
+	SendGroupId    int64  `json:"send_group_id,optional"`
+	TreeNodeId     int64  `json:"tree_node_id,optional"`
+	PromptTemplate string `json:"prompt_template,optional"`
+}
+
+type CreateKnowledgeBaseRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,optional"`
+	OwnerTeam   string   `json:"owner_team,optional"`
+	TreeNodeId  int64    `json:"tree_node_id,optional"`
+	Public      bool     `json:"public,optional"`
+	Readers     []string `json:"readers,optional"`
+	Writers     []string `json:"writers,optional"`
+}
+
+type UpdateKnowledgeBaseRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,optional"`
+	OwnerTeam   string   `json:"owner_team,optional"`
+	TreeNodeId  int64    `json:"tree_node_id,optional"`
+	Public      bool     `json:"public,optional"`
+	Readers     []string `json:"readers,optional"`
+	Writers     []string `json:"writers,optional"`
+}
+
+type ListKnowledgeBasesRequest struct {
+	TreeNodeId int64 `form:"tree_node_id,optional"`
+}
+
+type DeleteKnowledgeBaseRequest struct {
+	Name string `json:"name"`
+}
+
+type StartReindexRequest struct {
+	Force bool `json:"force,optional"`
+}
+
+type GetReindexStatusRequest struct {
+	JobId int64 `form:"job_id,optional"`
+}
+
 type UploadDocumentRequest struct {
 	Title   string `json:"title"`
 	Content string `json:"content"`
+	Format  string `json:"format,omitempty"`
+	// KnowledgeBase 写入的知识库，为空时写入公共知识库
+	KnowledgeBase string `json:"knowledge_base,optional"`
 }
 
 type UploadDocumentResponse struct {
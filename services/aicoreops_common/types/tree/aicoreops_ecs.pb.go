@@ -0,0 +1,1254 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: aicoreops_ecs.proto
+
+package tree
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ECS实例基础信息
+type EcsInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId   string            `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`                                                            // ECS实例ID
+	InstanceName string            `protobuf:"bytes,2,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`                                                      // 实例名称
+	InstanceType string            `protobuf:"bytes,3,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`                                                      // 实例规格
+	Status       string            `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                                                                      // 实例状态
+	PrivateIp    string            `protobuf:"bytes,5,opt,name=private_ip,json=privateIp,proto3" json:"private_ip,omitempty"`                                                               // 私网IP
+	PublicIp     string            `protobuf:"bytes,6,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`                                                                  // 公网IP
+	RegionId     string            `protobuf:"bytes,7,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`                                                                  // 地域ID
+	ZoneId       string            `protobuf:"bytes,8,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`                                                                        // 可用区ID
+	VpcId        string            `protobuf:"bytes,9,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`                                                                           // VPC ID
+	OsType       string            `protobuf:"bytes,10,opt,name=os_type,json=osType,proto3" json:"os_type,omitempty"`                                                                       // 操作系统类型
+	OsName       string            `protobuf:"bytes,11,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`                                                                       // 操作系统名称
+	CreateTime   int64             `protobuf:"varint,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                                                          // 创建时间
+	ExpiredTime  int64             `protobuf:"varint,13,opt,name=expired_time,json=expiredTime,proto3" json:"expired_time,omitempty"`                                                       // 过期时间
+	Tags         map[string]string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 标签
+}
+
+func (x *EcsInstance) Reset() {
+	*x = EcsInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EcsInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EcsInstance) ProtoMessage() {}
+
+func (x *EcsInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EcsInstance.ProtoReflect.Descriptor instead.
+func (*EcsInstance) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EcsInstance) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetPrivateIp() string {
+	if x != nil {
+		return x.PrivateIp
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetVpcId() string {
+	if x != nil {
+		return x.VpcId
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetOsType() string {
+	if x != nil {
+		return x.OsType
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetOsName() string {
+	if x != nil {
+		return x.OsName
+	}
+	return ""
+}
+
+func (x *EcsInstance) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *EcsInstance) GetExpiredTime() int64 {
+	if x != nil {
+		return x.ExpiredTime
+	}
+	return 0
+}
+
+func (x *EcsInstance) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 获取未绑定的ECS列表请求
+type GetEcsUnbindListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize     int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`            // 每页数量
+	PageNum      int32  `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`               // 页码
+	RegionId     string `protobuf:"bytes,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`             // 按地域筛选
+	InstanceType string `protobuf:"bytes,4,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"` // 按实例规格筛选
+	Keyword      string `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`                               // 搜索关键字(实例ID/名称/IP)
+}
+
+func (x *GetEcsUnbindListRequest) Reset() {
+	*x = GetEcsUnbindListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEcsUnbindListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEcsUnbindListRequest) ProtoMessage() {}
+
+func (x *GetEcsUnbindListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEcsUnbindListRequest.ProtoReflect.Descriptor instead.
+func (*GetEcsUnbindListRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetEcsUnbindListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetEcsUnbindListRequest) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetEcsUnbindListRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *GetEcsUnbindListRequest) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *GetEcsUnbindListRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+type GetEcsUnbindListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instances []*EcsInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"` // ECS实例列表
+	Total     int32          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`        // 总数量
+}
+
+func (x *GetEcsUnbindListResponse) Reset() {
+	*x = GetEcsUnbindListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEcsUnbindListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEcsUnbindListResponse) ProtoMessage() {}
+
+func (x *GetEcsUnbindListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEcsUnbindListResponse.ProtoReflect.Descriptor instead.
+func (*GetEcsUnbindListResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetEcsUnbindListResponse) GetInstances() []*EcsInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *GetEcsUnbindListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取已绑定的ECS列表请求
+type GetEcsListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	PageNum  int32  `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`    // 页码
+	NodeId   int64  `protobuf:"varint,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`       // 资源树节点ID
+	RegionId string `protobuf:"bytes,4,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`  // 按地域筛选
+	Status   string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                      // 按状态筛选
+	Keyword  string `protobuf:"bytes,6,opt,name=keyword,proto3" json:"keyword,omitempty"`                    // 搜索关键字
+}
+
+func (x *GetEcsListRequest) Reset() {
+	*x = GetEcsListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEcsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEcsListRequest) ProtoMessage() {}
+
+func (x *GetEcsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEcsListRequest.ProtoReflect.Descriptor instead.
+func (*GetEcsListRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetEcsListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetEcsListRequest) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *GetEcsListRequest) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *GetEcsListRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *GetEcsListRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetEcsListRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+type GetEcsListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instances []*EcsInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"` // ECS实例列表
+	Total     int32          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`        // 总数量
+}
+
+func (x *GetEcsListResponse) Reset() {
+	*x = GetEcsListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEcsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEcsListResponse) ProtoMessage() {}
+
+func (x *GetEcsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEcsListResponse.ProtoReflect.Descriptor instead.
+func (*GetEcsListResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetEcsListResponse) GetInstances() []*EcsInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *GetEcsListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 绑定ECS请求
+type BindEcsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId      int64             `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                                                                                                 // 资源树节点ID
+	InstanceIds []string          `protobuf:"bytes,2,rep,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty"`                                                                                   // ECS实例ID列表
+	Operator    string            `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`                                                                                                            // 操作人
+	BindAttrs   map[string]string `protobuf:"bytes,4,rep,name=bind_attrs,json=bindAttrs,proto3" json:"bind_attrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 绑定时附加的属性
+}
+
+func (x *BindEcsRequest) Reset() {
+	*x = BindEcsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindEcsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindEcsRequest) ProtoMessage() {}
+
+func (x *BindEcsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindEcsRequest.ProtoReflect.Descriptor instead.
+func (*BindEcsRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BindEcsRequest) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *BindEcsRequest) GetInstanceIds() []string {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+func (x *BindEcsRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *BindEcsRequest) GetBindAttrs() map[string]string {
+	if x != nil {
+		return x.BindAttrs
+	}
+	return nil
+}
+
+type BindEcsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                     // 是否绑定成功
+	Message   string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                      // 绑定结果信息
+	FailedIds []string `protobuf:"bytes,3,rep,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"` // 绑定失败的实例ID列表
+}
+
+func (x *BindEcsResponse) Reset() {
+	*x = BindEcsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindEcsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindEcsResponse) ProtoMessage() {}
+
+func (x *BindEcsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindEcsResponse.ProtoReflect.Descriptor instead.
+func (*BindEcsResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BindEcsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BindEcsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BindEcsResponse) GetFailedIds() []string {
+	if x != nil {
+		return x.FailedIds
+	}
+	return nil
+}
+
+// 解绑ECS请求
+type UnBindEcsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId      int64    `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`               // 资源树节点ID
+	InstanceIds []string `protobuf:"bytes,2,rep,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty"` // ECS实例ID列表
+	Operator    string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`                          // 操作人
+	Reason      string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                              // 解绑原因
+}
+
+func (x *UnBindEcsRequest) Reset() {
+	*x = UnBindEcsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnBindEcsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnBindEcsRequest) ProtoMessage() {}
+
+func (x *UnBindEcsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnBindEcsRequest.ProtoReflect.Descriptor instead.
+func (*UnBindEcsRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnBindEcsRequest) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *UnBindEcsRequest) GetInstanceIds() []string {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+func (x *UnBindEcsRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *UnBindEcsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnBindEcsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                     // 是否解绑成功
+	Message   string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                      // 解绑结果信息
+	FailedIds []string `protobuf:"bytes,3,rep,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"` // 解绑失败的实例ID列表
+}
+
+func (x *UnBindEcsResponse) Reset() {
+	*x = UnBindEcsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnBindEcsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnBindEcsResponse) ProtoMessage() {}
+
+func (x *UnBindEcsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnBindEcsResponse.ProtoReflect.Descriptor instead.
+func (*UnBindEcsResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnBindEcsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnBindEcsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnBindEcsResponse) GetFailedIds() []string {
+	if x != nil {
+		return x.FailedIds
+	}
+	return nil
+}
+
+// 批量操作ECS请求
+type BatchOperateEcsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceIds []string          `protobuf:"bytes,1,rep,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty"`                                                              // ECS实例ID列表
+	Operation   string            `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`                                                                                     // 操作类型(start/stop/restart)
+	Operator    string            `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`                                                                                       // 操作人
+	Force       bool              `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`                                                                                            // 是否强制操作
+	Options     map[string]string `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 操作选项
+}
+
+func (x *BatchOperateEcsRequest) Reset() {
+	*x = BatchOperateEcsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchOperateEcsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperateEcsRequest) ProtoMessage() {}
+
+func (x *BatchOperateEcsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperateEcsRequest.ProtoReflect.Descriptor instead.
+func (*BatchOperateEcsRequest) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchOperateEcsRequest) GetInstanceIds() []string {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+func (x *BatchOperateEcsRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *BatchOperateEcsRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *BatchOperateEcsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *BatchOperateEcsRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type BatchOperateEcsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                                                                        // 是否操作成功
+	Message   string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                                                                         // 操作结果信息
+	FailedIds []string          `protobuf:"bytes,3,rep,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"`                                                                    // 操作失败的实例ID列表
+	Results   map[string]string `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 详细操作结果
+}
+
+func (x *BatchOperateEcsResponse) Reset() {
+	*x = BatchOperateEcsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aicoreops_ecs_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchOperateEcsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperateEcsResponse) ProtoMessage() {}
+
+func (x *BatchOperateEcsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aicoreops_ecs_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperateEcsResponse.ProtoReflect.Descriptor instead.
+func (*BatchOperateEcsResponse) Descriptor() ([]byte, []int) {
+	return file_aicoreops_ecs_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchOperateEcsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchOperateEcsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchOperateEcsResponse) GetFailedIds() []string {
+	if x != nil {
+		return x.FailedIds
+	}
+	return nil
+}
+
+func (x *BatchOperateEcsResponse) GetResults() map[string]string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_aicoreops_ecs_proto protoreflect.FileDescriptor
+
+var file_aicoreops_ecs_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x61, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x6f, 0x70, 0x73, 0x5f, 0x65, 0x63, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x22,
+	0xfd, 0x03, 0x0a, 0x0b, 0x45, 0x63, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49,
+	0x70, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x70, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x7a,
+	0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x7a, 0x6f,
+	0x6e, 0x65, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6f,
+	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x63, 0x73, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xad, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x63, 0x73, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65,
+	0x4e, 0x75, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0x65, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x63, 0x73, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x63, 0x73, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x63,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x67,
+	0x65, 0x4e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5f, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x45, 0x63, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x63, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xee, 0x01,
+	0x0a, 0x0e, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x62, 0x69, 0x6e, 0x64,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74,
+	0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x72, 0x73,
+	0x1a, 0x3c, 0x0a, 0x0e, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64,
+	0x0a, 0x0f, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x49, 0x64, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x55, 0x6e, 0x42, 0x69, 0x6e, 0x64, 0x45,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x11, 0x55, 0x6e, 0x42,
+	0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x64,
+	0x73, 0x22, 0x90, 0x02, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x45, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x47, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xf2, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x49, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x8e, 0x03, 0x0a, 0x0a, 0x45, 0x63,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45,
+	0x63, 0x73, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x74,
+	0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x63, 0x73, 0x55, 0x6e,
+	0x62, 0x69, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x63,
+	0x73, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x63, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x63, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x63, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07,
+	0x42, 0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x6e,
+	0x64, 0x45, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09,
+	0x55, 0x6e, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x72, 0x65, 0x65,
+	0x5f, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x6e, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x45, 0x63, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_aicoreops_ecs_proto_rawDescOnce sync.Once
+	file_aicoreops_ecs_proto_rawDescData = file_aicoreops_ecs_proto_rawDesc
+)
+
+func file_aicoreops_ecs_proto_rawDescGZIP() []byte {
+	file_aicoreops_ecs_proto_rawDescOnce.Do(func() {
+		file_aicoreops_ecs_proto_rawDescData = protoimpl.X.CompressGZIP(file_aicoreops_ecs_proto_rawDescData)
+	})
+	return file_aicoreops_ecs_proto_rawDescData
+}
+
+var file_aicoreops_ecs_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_aicoreops_ecs_proto_goTypes = []any{
+	(*EcsInstance)(nil),              // 0: tree_rpc.EcsInstance
+	(*GetEcsUnbindListRequest)(nil),  // 1: tree_rpc.GetEcsUnbindListRequest
+	(*GetEcsUnbindListResponse)(nil), // 2: tree_rpc.GetEcsUnbindListResponse
+	(*GetEcsListRequest)(nil),        // 3: tree_rpc.GetEcsListRequest
+	(*GetEcsListResponse)(nil),       // 4: tree_rpc.GetEcsListResponse
+	(*BindEcsRequest)(nil),           // 5: tree_rpc.BindEcsRequest
+	(*BindEcsResponse)(nil),          // 6: tree_rpc.BindEcsResponse
+	(*UnBindEcsRequest)(nil),         // 7: tree_rpc.UnBindEcsRequest
+	(*UnBindEcsResponse)(nil),        // 8: tree_rpc.UnBindEcsResponse
+	(*BatchOperateEcsRequest)(nil),   // 9: tree_rpc.BatchOperateEcsRequest
+	(*BatchOperateEcsResponse)(nil),  // 10: tree_rpc.BatchOperateEcsResponse
+	nil,                              // 11: tree_rpc.EcsInstance.TagsEntry
+	nil,                              // 12: tree_rpc.BindEcsRequest.BindAttrsEntry
+	nil,                              // 13: tree_rpc.BatchOperateEcsRequest.OptionsEntry
+	nil,                              // 14: tree_rpc.BatchOperateEcsResponse.ResultsEntry
+}
+var file_aicoreops_ecs_proto_depIdxs = []int32{
+	11, // 0: tree_rpc.EcsInstance.tags:type_name -> tree_rpc.EcsInstance.TagsEntry
+	0,  // 1: tree_rpc.GetEcsUnbindListResponse.instances:type_name -> tree_rpc.EcsInstance
+	0,  // 2: tree_rpc.GetEcsListResponse.instances:type_name -> tree_rpc.EcsInstance
+	12, // 3: tree_rpc.BindEcsRequest.bind_attrs:type_name -> tree_rpc.BindEcsRequest.BindAttrsEntry
+	13, // 4: tree_rpc.BatchOperateEcsRequest.options:type_name -> tree_rpc.BatchOperateEcsRequest.OptionsEntry
+	14, // 5: tree_rpc.BatchOperateEcsResponse.results:type_name -> tree_rpc.BatchOperateEcsResponse.ResultsEntry
+	1,  // 6: tree_rpc.EcsService.GetEcsUnbindList:input_type -> tree_rpc.GetEcsUnbindListRequest
+	3,  // 7: tree_rpc.EcsService.GetEcsList:input_type -> tree_rpc.GetEcsListRequest
+	5,  // 8: tree_rpc.EcsService.BindEcs:input_type -> tree_rpc.BindEcsRequest
+	7,  // 9: tree_rpc.EcsService.UnBindEcs:input_type -> tree_rpc.UnBindEcsRequest
+	9,  // 10: tree_rpc.EcsService.BatchOperateEcs:input_type -> tree_rpc.BatchOperateEcsRequest
+	2,  // 11: tree_rpc.EcsService.GetEcsUnbindList:output_type -> tree_rpc.GetEcsUnbindListResponse
+	4,  // 12: tree_rpc.EcsService.GetEcsList:output_type -> tree_rpc.GetEcsListResponse
+	6,  // 13: tree_rpc.EcsService.BindEcs:output_type -> tree_rpc.BindEcsResponse
+	8,  // 14: tree_rpc.EcsService.UnBindEcs:output_type -> tree_rpc.UnBindEcsResponse
+	10, // 15: tree_rpc.EcsService.BatchOperateEcs:output_type -> tree_rpc.BatchOperateEcsResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_aicoreops_ecs_proto_init() }
+func file_aicoreops_ecs_proto_init() {
+	if File_aicoreops_ecs_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_aicoreops_ecs_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*EcsInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEcsUnbindListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEcsUnbindListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEcsListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEcsListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*BindEcsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*BindEcsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*UnBindEcsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*UnBindEcsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchOperateEcsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aicoreops_ecs_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchOperateEcsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_aicoreops_ecs_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_aicoreops_ecs_proto_goTypes,
+		DependencyIndexes: file_aicoreops_ecs_proto_depIdxs,
+		MessageInfos:      file_aicoreops_ecs_proto_msgTypes,
+	}.Build()
+	File_aicoreops_ecs_proto = out.File
+	file_aicoreops_ecs_proto_rawDesc = nil
+	file_aicoreops_ecs_proto_goTypes = nil
+	file_aicoreops_ecs_proto_depIdxs = nil
+}
@@ -20,7 +20,7 @@ import (
 	pm "github.com/prometheus/common/model"
 )
 
-const alertSendGroupKey = "alert_send_group"
+const alertSendGroupKey = model.AlertSendGroupLabel
 const defaultConfigTimeout = "5s"
 
 type AlertConfigCache interface {
@@ -2,17 +2,28 @@ package cache
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/config"
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
 	"gorm.io/gorm"
 )
 
 type MonitorCache interface {
 	// MonitorCacheManager 更新缓存
 	MonitorCacheManager(ctx context.Context) error
+	// GetPrometheusMainConfigByIP 根据IP地址获取Prometheus的主配置
+	GetPrometheusMainConfigByIP(ip string) string
+	// GetPrometheusAlertRuleConfigYamlByIp 根据IP地址获取Prometheus的告警规则配置
+	GetPrometheusAlertRuleConfigYamlByIp(ip string) string
+	// GetPrometheusRecordRuleConfigYamlByIp 根据IP地址获取Prometheus的预聚合规则配置
+	GetPrometheusRecordRuleConfigYamlByIp(ip string) string
+	// GetAlertManagerMainConfigYamlByIP 根据IP地址获取AlertManager的主配置
+	GetAlertManagerMainConfigYamlByIP(ip string) string
 }
 
 type monitorCache struct {
@@ -33,38 +44,60 @@ func NewMonitorCache(ctx context.Context, db *gorm.DB, config *config.Config) Mo
 	}
 }
 
-// MonitorCacheManager 定期更新缓存并监听退出信号
+// MonitorCacheManager 并发重新生成所有监控配置，等待全部任务结束，返回各任务错误的合并
 func (mc *monitorCache) MonitorCacheManager(ctx context.Context) error {
 	mc.Logger.Info("开始更新所有监控缓存配置")
 
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
 	defer cancel()
 
-	var wg sync.WaitGroup
-	wg.Add(4)
-
-	// 创建一个通道来收集错误
-	errChan := make(chan error, 1)
+	tasks := []struct {
+		name string
+		fn   func(context.Context) error
+	}{
+		{"生成 Prometheus 配置", mc.PrometheusMainConfig.GeneratePrometheusMainConfig},
+		{"生成 AlertManager 配置", mc.AlertConfigCache.GenerateAlertManagerMainConfig},
+		{"生成 AlertRule 配置", mc.RuleConfigCache.GenerateAlertRuleConfigYaml},
+		{"生成 RecordRule 配置", mc.RecordConfigCache.GenerateRecordRuleConfigYaml},
+	}
 
-	// 定义一个辅助函数来执行任务
-	executeTask := func(taskName string, taskFunc func(context.Context) error) {
-		defer wg.Done()
-		mc.Logger.Info("开始执行任务：%s", taskName)
-		err := taskFunc(ctx)
-		if err != nil {
-			mc.Logger.Error("任务执行失败：%s, error: %v", taskName, err)
-			errChan <- err
-			return
-		}
-		mc.Logger.Info("任务执行成功：%s", taskName)
+	var wg sync.WaitGroup
+	errs := make([]error, len(tasks))
+	for i, task := range tasks {
+		wg.Add(1)
+		threading.GoSafe(func() {
+			defer wg.Done()
+			mc.Logger.Infof("开始执行任务：%s", task.name)
+			if err := task.fn(ctx); err != nil {
+				mc.Logger.Errorf("任务执行失败：%s, error: %v", task.name, err)
+				errs[i] = fmt.Errorf("%s: %w", task.name, err)
+				return
+			}
+			mc.Logger.Infof("任务执行成功：%s", task.name)
+		})
 	}
+	wg.Wait()
 
-	// 并发执行任务
-	go executeTask("生成 Prometheus 配置", mc.PrometheusMainConfig.GeneratePrometheusMainConfig)
-	go executeTask("生成 AlertManager 配置", mc.AlertConfigCache.GenerateAlertManagerMainConfig)
-	go executeTask("生成 AlertRule 配置", mc.RuleConfigCache.GenerateAlertRuleConfigYaml)
-	go executeTask("生成 RecordRule 配置", mc.RecordConfigCache.GenerateRecordRuleConfigYaml)
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
 
 	mc.Logger.Info("更新所有监控缓存配置完成")
 	return nil
 }
+
+func (mc *monitorCache) GetPrometheusMainConfigByIP(ip string) string {
+	return mc.PrometheusMainConfig.GetPrometheusMainConfigByIP(ip)
+}
+
+func (mc *monitorCache) GetPrometheusAlertRuleConfigYamlByIp(ip string) string {
+	return mc.RuleConfigCache.GetPrometheusAlertRuleConfigYamlByIp(ip)
+}
+
+func (mc *monitorCache) GetPrometheusRecordRuleConfigYamlByIp(ip string) string {
+	return mc.RecordConfigCache.GetPrometheusRecordRuleConfigYamlByIp(ip)
+}
+
+func (mc *monitorCache) GetAlertManagerMainConfigYamlByIP(ip string) string {
+	return mc.AlertConfigCache.GetAlertManagerMainConfigYamlByIP(ip)
+}
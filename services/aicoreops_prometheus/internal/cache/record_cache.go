@@ -77,7 +77,8 @@ func (r *recordConfigCache) GenerateRecordRuleConfigYaml(ctx context.Context) er
 		return nil
 	}
 
-	recordConfigMap := r.RecordRuleMap
+	// 每次生成新的映射，避免读写同一个 map 以及残留已删除采集池的配置
+	recordConfigMap := make(map[string]string)
 
 	// 遍历每个采集池生成对应的预聚合规则配置
 	for _, pool := range pools {
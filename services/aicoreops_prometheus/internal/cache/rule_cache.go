@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"sync"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/config"
@@ -80,8 +81,8 @@ func (r *ruleConfigCache) GenerateAlertRuleConfigYaml(ctx context.Context) error
 		return nil
 	}
 
-	// 过滤出支持告警的采集池
-	ruleConfigMap := r.AlertRuleMap
+	// 过滤出支持告警的采集池，每次生成新的映射，避免读写同一个 map 以及残留已删除采集池的配置
+	ruleConfigMap := make(map[string]string)
 	for _, pool := range pools {
 		if pool.SupportAlert == 1 { // 1表示支持告警
 			oneMap := r.GeneratePrometheusAlertRuleConfigYamlOnePool(ctx, pool)
@@ -125,6 +126,12 @@ func (r *ruleConfigCache) GeneratePrometheusAlertRuleConfigYamlOnePool(ctx conte
 			ft, _ = pm.ParseDuration("5s")
 		}
 		labels := pkg.FromSliceTuMap(rule.Labels)
+		// 附加规则ID、发送组和级别，AlertManager 按发送组路由，webhook 据此关联告警事件
+		labels[model.AlertRuleIDLabel] = strconv.FormatInt(rule.ID, 10)
+		labels[model.AlertSendGroupLabel] = strconv.FormatInt(rule.SendGroupID, 10)
+		if rule.Severity != "" {
+			labels[model.AlertSeverityLabel] = rule.Severity
+		}
 		annotations := pkg.FromSliceTuMap(rule.Annotations)
 
 		oneRule := rulefmt.Rule{
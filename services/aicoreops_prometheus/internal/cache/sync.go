@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ConfigSyncStatus 配置同步状态，时间为 Unix 秒，0 表示尚未发生
+type ConfigSyncStatus struct {
+	LastRunAt     int64
+	LastSuccessAt int64
+	LastErrorAt   int64
+	// LastError 最近一次失败的原因，LastSuccessAt 晚于 LastErrorAt 时表示已恢复
+	LastError string
+	// LastTrigger 最近一次同步的触发原因
+	LastTrigger string
+	// LastDuration 最近一次同步耗时
+	LastDuration time.Duration
+	// Pending 是否有等待合并执行的变更事件
+	Pending bool
+}
+
+// ConfigSyncer 按固定间隔重新生成监控配置，收到变更事件时在防抖时间后重新生成，
+// 防抖时间内的多次变更合并为一次。实现 service.Service，随服务启动和停止
+type ConfigSyncer struct {
+	logx.Logger
+	cache    MonitorCache
+	interval time.Duration
+	debounce time.Duration
+	// timeout 单次生成的超时时间
+	timeout time.Duration
+
+	events chan string
+	done   chan struct{}
+	stop   sync.Once
+
+	mu      sync.RWMutex
+	status  ConfigSyncStatus
+	trigger string
+}
+
+func NewConfigSyncer(cache MonitorCache, interval, debounce time.Duration) *ConfigSyncer {
+	return &ConfigSyncer{
+		Logger:   logx.WithContext(context.Background()),
+		cache:    cache,
+		interval: interval,
+		debounce: debounce,
+		timeout:  10 * time.Minute,
+		events:   make(chan string, 1),
+		done:     make(chan struct{}),
+	}
+}
+
+// Notify 通知配置发生变更，不阻塞调用方
+func (s *ConfigSyncer) Notify(reason string) {
+	s.mu.Lock()
+	s.status.Pending = true
+	s.trigger = reason
+	s.mu.Unlock()
+
+	select {
+	case s.events <- reason:
+	default:
+		// 已有未处理的事件，同一批变更合并执行
+	}
+}
+
+// Status 获取最近一次同步的状态
+func (s *ConfigSyncer) Status() ConfigSyncStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status
+}
+
+// Start 启动时立即生成一次配置，之后按间隔和变更事件重新生成，阻塞直到 Stop
+func (s *ConfigSyncer) Start() {
+	s.run("启动")
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// 防抖计时器，收到变更事件时启动，期间的事件会重置计时
+	debounce := time.NewTimer(s.debounce)
+	debounce.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			debounce.Stop()
+			return
+		case <-ticker.C:
+			s.run("定时")
+		case reason := <-s.events:
+			s.Logger.Infof("收到配置变更事件: %s，%v 后重新生成配置", reason, s.debounce)
+			debounce.Reset(s.debounce)
+		case <-debounce.C:
+			s.mu.RLock()
+			trigger := s.trigger
+			s.mu.RUnlock()
+			s.run(trigger)
+		}
+	}
+}
+
+// Stop 停止同步，正在执行的生成会继续到结束
+func (s *ConfigSyncer) Stop() {
+	s.stop.Do(func() {
+		close(s.done)
+	})
+}
+
+// run 重新生成所有配置并记录结果
+func (s *ConfigSyncer) run(trigger string) {
+	s.mu.Lock()
+	s.status.Pending = false
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := s.cache.MonitorCacheManager(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status.LastRunAt = start.Unix()
+	s.status.LastTrigger = trigger
+	s.status.LastDuration = time.Since(start)
+	if err != nil {
+		s.Logger.Errorf("同步监控配置失败(%s): %v", trigger, err)
+		s.status.LastErrorAt = start.Unix()
+		s.status.LastError = err.Error()
+		return
+	}
+	s.status.LastSuccessAt = start.Unix()
+}
@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingCache 记录生成次数，err 不为空时生成失败
+type countingCache struct {
+	MonitorCache
+	runs atomic.Int32
+	err  atomic.Value
+}
+
+func (c *countingCache) MonitorCacheManager(ctx context.Context) error {
+	c.runs.Add(1)
+	if err, ok := c.err.Load().(error); ok {
+		return err
+	}
+	return nil
+}
+
+func TestConfigSyncerDebounce(t *testing.T) {
+	cache := &countingCache{}
+	syncer := NewConfigSyncer(cache, time.Hour, 50*time.Millisecond)
+	go syncer.Start()
+	defer syncer.Stop()
+
+	waitRuns := func(want int32) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for cache.runs.Load() < want && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+		}
+		if got := cache.runs.Load(); got != want {
+			t.Fatalf("runs = %d, want %d", got, want)
+		}
+	}
+
+	// 启动时立即生成一次
+	waitRuns(1)
+
+	// 连续的变更合并为一次生成
+	for i := 0; i < 5; i++ {
+		syncer.Notify("更新告警规则")
+		time.Sleep(5 * time.Millisecond)
+	}
+	if status := syncer.Status(); !status.Pending {
+		t.Errorf("status should be pending before debounce fires")
+	}
+	waitRuns(2)
+	time.Sleep(100 * time.Millisecond)
+	if got := cache.runs.Load(); got != 2 {
+		t.Errorf("runs after burst = %d, want 2", got)
+	}
+
+	status := syncer.Status()
+	if status.Pending || status.LastTrigger != "更新告警规则" || status.LastSuccessAt == 0 || status.LastErrorAt != 0 {
+		t.Errorf("status = %+v", status)
+	}
+
+	// 失败时记录错误，之前的成功时间保留
+	cache.err.Store(errors.New("生成 AlertRule 配置: mysql down"))
+	syncer.Notify("删除采集池")
+	waitRuns(3)
+	time.Sleep(10 * time.Millisecond)
+	status = syncer.Status()
+	if status.LastErrorAt == 0 || status.LastError != "生成 AlertRule 配置: mysql down" || status.LastSuccessAt == 0 {
+		t.Errorf("status after failure = %+v", status)
+	}
+}
@@ -1,13 +1,19 @@
 package config
 
-import "github.com/zeromicro/go-zero/zrpc"
+import (
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
+)
 
 type Config struct {
 	zrpc.RpcServerConf
+	// HttpServer 配置下发接口，Prometheus、AlertManager 实例按IP拉取生成的配置
+	HttpServer         rest.RestConf
 	Mysql              string
 	XRedis             string
 	PrometheusConfig   PrometheusConfig
 	AlertManagerConfig AlertManagerConfig
+	ConfigSync         ConfigSyncConfig
 }
 
 type PrometheusConfig struct {
@@ -19,3 +25,11 @@ type AlertManagerConfig struct {
 	LocalYamlDir     string
 	AlertWebhookAddr string
 }
+
+// ConfigSyncConfig 监控配置同步，单位为秒
+type ConfigSyncConfig struct {
+	// Interval 定时重新生成配置的间隔
+	Interval int `json:",default=300"`
+	// Debounce 收到变更事件后等待的时间，期间的多次变更合并为一次生成
+	Debounce int `json:",default=5"`
+}
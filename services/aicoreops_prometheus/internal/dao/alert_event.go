@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/repo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AlertEventDAO struct {
+	db *gorm.DB
+}
+
+func NewAlertEventDAO(db *gorm.DB) *AlertEventDAO {
+	return &AlertEventDAO{
+		db: db,
+	}
+}
+
+// ApplyAlertEvent 锁定并修改指纹对应的告警事件，同一告警的并发通知依次处理
+func (d *AlertEventDAO) ApplyAlertEvent(ctx context.Context, fingerprint string, apply func(event *model.MonitorAlertEvent) bool) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var event model.MonitorAlertEvent
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("fingerprint = ?", fingerprint).First(&event).Error
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			event = model.MonitorAlertEvent{Fingerprint: fingerprint}
+		}
+
+		if !apply(&event) {
+			return nil
+		}
+
+		// 并发创建同一指纹时唯一索引冲突，返回错误由 AlertManager 重试
+		if event.ID == 0 {
+			return tx.Create(&event).Error
+		}
+		return tx.Save(&event).Error
+	})
+}
+
+// ListAlertEvents 按条件分页获取告警事件，按触发时间倒序
+func (d *AlertEventDAO) ListAlertEvents(ctx context.Context, filter repo.AlertEventFilter) ([]*model.MonitorAlertEvent, int64, error) {
+	query := d.db.WithContext(ctx).Model(&model.MonitorAlertEvent{}).Where("is_deleted = 0")
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Severity != "" {
+		query = query.Where("severity = ?", filter.Severity)
+	}
+	if filter.SendGroupID > 0 {
+		query = query.Where("send_group_id = ?", filter.SendGroupID)
+	}
+	if filter.TreeNodeID > 0 {
+		query = query.Where("tree_node_id = ?", filter.TreeNodeID)
+	}
+	if filter.OnDutyUserID > 0 {
+		query = query.Where("on_duty_user_id = ?", filter.OnDutyUserID)
+	}
+	if filter.StartTime > 0 {
+		query = query.Where("starts_at >= ?", filter.StartTime)
+	}
+	if filter.EndTime > 0 {
+		query = query.Where("starts_at <= ?", filter.EndTime)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var events []*model.MonitorAlertEvent
+	if err := query.Order("starts_at DESC, id DESC").Offset(filter.Offset).Limit(filter.Limit).Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+// GetAlertEventById 获取指定ID的告警事件
+func (d *AlertEventDAO) GetAlertEventById(ctx context.Context, id int) (*model.MonitorAlertEvent, error) {
+	var event model.MonitorAlertEvent
+	if err := d.db.WithContext(ctx).Where("id = ? AND is_deleted = 0", id).First(&event).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// UpdateAlertEvent 在同一事务中更新告警事件并写入处理记录
+func (d *AlertEventDAO) UpdateAlertEvent(ctx context.Context, id int, fields map[string]any, timeline *model.MonitorAlertEventTimeline) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.MonitorAlertEvent{}).Where("id = ?", id).Updates(fields).Error; err != nil {
+			return err
+		}
+		return tx.Create(timeline).Error
+	})
+}
+
+// ListAlertEventTimeline 获取告警事件的处理记录，按时间顺序
+func (d *AlertEventDAO) ListAlertEventTimeline(ctx context.Context, eventID int) ([]*model.MonitorAlertEventTimeline, error) {
+	var timeline []*model.MonitorAlertEventTimeline
+	if err := d.db.WithContext(ctx).Where("event_id = ?", eventID).Order("id").Find(&timeline).Error; err != nil {
+		return nil, err
+	}
+	return timeline, nil
+}
@@ -36,6 +36,15 @@ func (d *AlertManagerPoolDAO) GetMonitorAlertManagerPoolList(ctx context.Context
 	return pools, nil
 }
 
+// GetMonitorAlertManagerPoolById 获取指定ID的AlertManager池
+func (d *AlertManagerPoolDAO) GetMonitorAlertManagerPoolById(ctx context.Context, id int64) (*model.MonitorAlertManagerPool, error) {
+	var pool model.MonitorAlertManagerPool
+	if err := d.db.WithContext(ctx).Where("id = ?", id).First(&pool).Error; err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
 // SearchMonitorAlertManagerPoolByName 根据名称搜索AlertManager池
 func (d *AlertManagerPoolDAO) SearchMonitorAlertManagerPoolByName(ctx context.Context, name string) ([]*model.MonitorAlertManagerPool, error) {
 	var pools []*model.MonitorAlertManagerPool
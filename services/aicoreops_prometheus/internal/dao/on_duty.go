@@ -0,0 +1,125 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+	"gorm.io/gorm"
+)
+
+type OnDutyDAO struct {
+	db *gorm.DB
+}
+
+func NewOnDutyDAO(db *gorm.DB) *OnDutyDAO {
+	return &OnDutyDAO{db: db}
+}
+
+// CreateOnDutyGroup 创建值班组
+func (d *OnDutyDAO) CreateOnDutyGroup(ctx context.Context, group *model.MonitorOnDutyGroup) error {
+	if group == nil {
+		return errors.New("group 不能为空")
+	}
+
+	return d.db.WithContext(ctx).Create(group).Error
+}
+
+// UpdateOnDutyGroup 更新值班组
+func (d *OnDutyDAO) UpdateOnDutyGroup(ctx context.Context, group *model.MonitorOnDutyGroup) error {
+	if group == nil {
+		return errors.New("group 不能为空")
+	}
+
+	if group.ID <= 0 {
+		return fmt.Errorf("无效的 groupId：%d", group.ID)
+	}
+
+	return d.db.WithContext(ctx).Model(&model.MonitorOnDutyGroup{}).Where("id = ?", group.ID).Updates(group).Error
+}
+
+// DeleteOnDutyGroup 删除值班组及其替班记录
+func (d *OnDutyDAO) DeleteOnDutyGroup(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("无效的 groupId：%d", id)
+	}
+
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_id = ?", id).Delete(&model.MonitorOnDutyOverride{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.MonitorOnDutyGroup{}, id).Error
+	})
+}
+
+// GetOnDutyGroupById 获取指定ID的值班组
+func (d *OnDutyDAO) GetOnDutyGroupById(ctx context.Context, id int64) (*model.MonitorOnDutyGroup, error) {
+	var group model.MonitorOnDutyGroup
+	if err := d.db.WithContext(ctx).Where("id = ?", id).First(&group).Error; err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GetOnDutyGroupList 获取所有值班组
+func (d *OnDutyDAO) GetOnDutyGroupList(ctx context.Context) ([]*model.MonitorOnDutyGroup, error) {
+	var groups []*model.MonitorOnDutyGroup
+	if err := d.db.WithContext(ctx).Find(&groups).Error; err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// SearchOnDutyGroupByName 根据名称模糊搜索值班组
+func (d *OnDutyDAO) SearchOnDutyGroupByName(ctx context.Context, name string) ([]*model.MonitorOnDutyGroup, error) {
+	var groups []*model.MonitorOnDutyGroup
+	if err := d.db.WithContext(ctx).
+		Where("LOWER(name) LIKE ?", "%"+strings.ToLower(name)+"%").
+		Find(&groups).Error; err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// CheckOnDutyGroupExists 检查值班组名称是否存在
+func (d *OnDutyDAO) CheckOnDutyGroupExists(ctx context.Context, name string) (bool, error) {
+	var count int64
+	if err := d.db.WithContext(ctx).Model(&model.MonitorOnDutyGroup{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CreateOnDutyOverrides 在一个事务中创建替班记录
+func (d *OnDutyDAO) CreateOnDutyOverrides(ctx context.Context, overrides ...*model.MonitorOnDutyOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Create(overrides).Error
+	})
+}
+
+// DeleteOnDutyOverride 删除替班记录
+func (d *OnDutyDAO) DeleteOnDutyOverride(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("无效的 overrideId：%d", id)
+	}
+
+	return d.db.WithContext(ctx).Delete(&model.MonitorOnDutyOverride{}, id).Error
+}
+
+// ListOnDutyOverrides 获取与 [start, end) 有重叠的替班记录，按创建顺序排列
+func (d *OnDutyDAO) ListOnDutyOverrides(ctx context.Context, groupID, start, end int64) ([]*model.MonitorOnDutyOverride, error) {
+	var overrides []*model.MonitorOnDutyOverride
+	if err := d.db.WithContext(ctx).
+		Where("group_id = ? AND start_time < ? AND end_time > ?", groupID, end, start).
+		Order("id").
+		Find(&overrides).Error; err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
@@ -0,0 +1,485 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/types"
+	"github.com/prometheus/alertmanager/template"
+	pm "github.com/prometheus/common/model"
+	"github.com/zeromicro/go-zero/core/logx"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type AlertEventDomain struct {
+	repo          repo.AlertEventRepo
+	ruleRepo      repo.AlertRuleRepo
+	sendGroupRepo repo.SendGroupRepo
+	poolRepo      repo.MonitorAlterManagerPoolRepo
+	onDuty        *OnDutyDomain
+}
+
+func NewAlertEventDomain(svcCtx *svc.ServiceContext) *AlertEventDomain {
+	return &AlertEventDomain{
+		repo:          dao.NewAlertEventDAO(svcCtx.DB),
+		ruleRepo:      dao.NewAlertRuleDAO(svcCtx.DB),
+		sendGroupRepo: dao.NewSendGroupDAO(svcCtx.DB),
+		poolRepo:      dao.NewAlertManagerPoolDAO(svcCtx.DB),
+		onDuty:        NewOnDutyDomain(svcCtx),
+	}
+}
+
+// IngestResult 一次 webhook 通知的处理结果
+type IngestResult struct {
+	// Changed 新增或状态变化的告警事件数
+	Changed int
+	// Skipped 重复通知、过期通知等未修改的告警数
+	Skipped int
+}
+
+// IngestAlerts 按指纹写入 webhook 通知中的告警；sendGroupID 为 webhook URL 中的发送组，告警标签中的发送组优先。
+// 新的触发记录发送组值班组当前的值班人作为通知接收人；本服务不直接投递通知，
+// 接收人只记录在告警事件上，由通知渠道或值班人按 on_duty_user_id 查询告警事件获取。
+// 部分告警写入失败时返回合并的错误，AlertManager 重试时已写入的告警会被识别为重复通知
+func (d *AlertEventDomain) IngestAlerts(ctx context.Context, data *template.Data, sendGroupID int) (IngestResult, error) {
+	var (
+		res     IngestResult
+		errs    []error
+		rules   = make(map[int]*model.AlertRule)
+		onCalls = make(map[int]int64)
+		now     = time.Now()
+	)
+	for _, alert := range data.Alerts {
+		if alert.Fingerprint == "" {
+			errs = append(errs, fmt.Errorf("告警 %s 缺少指纹", alert.Labels["alertname"]))
+			continue
+		}
+
+		rule, err := d.getRule(ctx, rules, labelInt(alert.Labels, model.AlertRuleIDLabel))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("获取告警 %s 的告警规则失败: %w", alert.Fingerprint, err))
+			continue
+		}
+
+		var onCall int64
+		if alert.Status != string(pm.AlertResolved) {
+			onCall = d.getOnCall(ctx, onCalls, alertSendGroupID(alert, sendGroupID), now)
+		}
+
+		changed := false
+		err = d.repo.ApplyAlertEvent(ctx, alert.Fingerprint, func(event *model.MonitorAlertEvent) bool {
+			changed = ApplyAlert(event, alert, sendGroupID, rule)
+			if changed && event.Status == model.AlertEventStatusFiring {
+				event.OnDutyUserID = onCall
+			}
+			return changed
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("写入告警事件 %s 失败: %w", alert.Fingerprint, err))
+			continue
+		}
+
+		if changed {
+			res.Changed++
+		} else {
+			res.Skipped++
+		}
+	}
+
+	return res, errors.Join(errs...)
+}
+
+// getRule 获取告警关联的规则，同一次通知中的告警共用查询结果；规则已删除时返回 nil
+func (d *AlertEventDomain) getRule(ctx context.Context, rules map[int]*model.AlertRule, id int) (*model.AlertRule, error) {
+	if id <= 0 {
+		return nil, nil
+	}
+	if rule, ok := rules[id]; ok {
+		return rule, nil
+	}
+
+	rule, err := d.ruleRepo.GetAlertRuleById(ctx, int64(id))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		rule = nil
+	}
+	rules[id] = rule
+
+	return rule, nil
+}
+
+// getOnCall 获取发送组当前的值班人，同一次通知中的告警共用查询结果。
+// 查询失败只记录日志，不影响告警事件写入
+func (d *AlertEventDomain) getOnCall(ctx context.Context, onCalls map[int]int64, sendGroupID int, now time.Time) int64 {
+	if sendGroupID <= 0 {
+		return 0
+	}
+	if userID, ok := onCalls[sendGroupID]; ok {
+		return userID
+	}
+
+	userID, err := d.onDuty.GetSendGroupOnCall(ctx, int64(sendGroupID), now)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("获取发送组 %d 的值班人失败: %v", sendGroupID, err)
+	}
+	onCalls[sendGroupID] = userID
+
+	return userID
+}
+
+// ApplyAlert 将一条告警通知合并到告警事件，返回事件是否需要写入。
+// 同一指纹每次新的触发（开始时间更新）计一次 EventTimes；重复通知、重试以及晚于当前触发到达的旧通知不修改事件，
+// 因此 AlertManager 重试是幂等的。恢复通知将事件置为已恢复，新的触发将事件重新置为告警中并清除认领人
+func ApplyAlert(event *model.MonitorAlertEvent, alert template.Alert, sendGroupID int, rule *model.AlertRule) bool {
+	startsAt := alert.StartsAt.Unix()
+	fresh := event.ID == 0
+
+	if !fresh && startsAt < event.StartsAt {
+		// 上一次触发的通知晚到
+		return false
+	}
+
+	if alert.Status == string(pm.AlertResolved) {
+		if !fresh && event.Status == model.AlertEventStatusResolved && startsAt == event.StartsAt {
+			return false
+		}
+		if fresh || startsAt > event.StartsAt {
+			// 没有收到过本次触发的告警通知
+			event.EventTimes++
+		}
+		event.Status = model.AlertEventStatusResolved
+		event.EndsAt = alert.EndsAt.Unix()
+	} else {
+		if !fresh && startsAt == event.StartsAt {
+			// 同一次触发的重复通知；本次触发已恢复时为恢复前通知的重试
+			return false
+		}
+		event.EventTimes++
+		event.Status = model.AlertEventStatusFiring
+		event.EndsAt = 0
+		event.RenLingUserID = 0
+		event.IsDeleted = 0
+	}
+	event.StartsAt = startsAt
+
+	event.AlertName = alert.Labels["alertname"]
+	event.Labels = toStringList(alert.Labels)
+	event.Annotations = toStringList(alert.Annotations)
+	event.Severity = alert.Labels[model.AlertSeverityLabel]
+	event.RuleID = labelInt(alert.Labels, model.AlertRuleIDLabel)
+	event.SendGroupID = alertSendGroupID(alert, sendGroupID)
+	if rule != nil {
+		event.TreeNodeID = rule.TreeNodeID
+		if event.Severity == "" {
+			event.Severity = rule.Severity
+		}
+	}
+
+	return true
+}
+
+// alertSendGroupID 告警所属的发送组，告警标签中的发送组优先于 webhook URL 中的发送组
+func alertSendGroupID(alert template.Alert, sendGroupID int) int {
+	if id := labelInt(alert.Labels, model.AlertSendGroupLabel); id > 0 {
+		return id
+	}
+	return sendGroupID
+}
+
+// labelInt 解析整数标签，不存在或格式错误时返回 0
+func labelInt(labels template.KV, name string) int {
+	v, err := strconv.Atoi(labels[name])
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// toStringList 按键排序转换为 key=v 格式
+func toStringList(kv template.KV) model.StringList {
+	var list model.StringList
+	for _, pair := range kv.SortedPairs() {
+		list = append(list, pair.Name+"="+pair.Value)
+	}
+	return list
+}
+
+var (
+	ErrAlertEventResolved    = errors.New("告警已恢复")
+	ErrAlertEventSilenced    = errors.New("告警已屏蔽")
+	ErrAlertEventNotSilenced = errors.New("告警未屏蔽")
+)
+
+// SilenceOptions 屏蔽告警的参数
+type SilenceOptions struct {
+	// UseName 为 true 时按 alertname 屏蔽同名的所有告警，否则按完整标签只屏蔽该告警
+	UseName  bool
+	Duration time.Duration
+	Comment  string
+}
+
+// BatchSilenceResult 批量屏蔽中单个告警事件的结果
+type BatchSilenceResult struct {
+	ID        int
+	SilenceID string
+	Err       error
+}
+
+func (d *AlertEventDomain) ListAlertEvents(ctx context.Context, filter repo.AlertEventFilter) ([]*model.MonitorAlertEvent, int64, error) {
+	return d.repo.ListAlertEvents(ctx, filter)
+}
+
+// ClaimAlertEvent 认领告警，已屏蔽的告警保持已屏蔽状态，只记录认领人
+func (d *AlertEventDomain) ClaimAlertEvent(ctx context.Context, id int, userID int64) error {
+	event, err := d.repo.GetAlertEventById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if event.Status == model.AlertEventStatusResolved {
+		return ErrAlertEventResolved
+	}
+
+	fields := map[string]any{"ren_ling_user_id": userID}
+	if event.Status == model.AlertEventStatusFiring {
+		fields["status"] = model.AlertEventStatusClaimed
+	}
+
+	return d.repo.UpdateAlertEvent(ctx, id, fields, &model.MonitorAlertEventTimeline{
+		EventID: id,
+		Action:  model.AlertEventActionClaim,
+		UserID:  userID,
+	})
+}
+
+// SilenceAlertEvent 在告警所属发送组的 AlertManager 上创建静默，返回静默ID
+func (d *AlertEventDomain) SilenceAlertEvent(ctx context.Context, id int, userID int64, opts SilenceOptions) (string, error) {
+	if opts.Duration <= 0 {
+		return "", errors.New("屏蔽时长必须大于0")
+	}
+
+	event, err := d.repo.GetAlertEventById(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	switch event.Status {
+	case model.AlertEventStatusResolved:
+		return "", ErrAlertEventResolved
+	case model.AlertEventStatusSilenced:
+		return "", ErrAlertEventSilenced
+	}
+
+	silenceID, err := d.silence(ctx, event, userID, opts)
+	if err != nil {
+		return "", err
+	}
+
+	err = d.repo.UpdateAlertEvent(ctx, id, map[string]any{
+		"status":     model.AlertEventStatusSilenced,
+		"silence_id": silenceID,
+	}, &model.MonitorAlertEventTimeline{
+		EventID: id,
+		Action:  model.AlertEventActionSilence,
+		UserID:  userID,
+		Detail:  fmt.Sprintf("silence_id=%s, duration=%s, use_name=%v, comment=%s", silenceID, opts.Duration, opts.UseName, opts.Comment),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return silenceID, nil
+}
+
+// BatchSilence 依次屏蔽多个告警事件，单个失败不影响其他事件
+func (d *AlertEventDomain) BatchSilence(ctx context.Context, ids []int, userID int64, opts SilenceOptions) []BatchSilenceResult {
+	results := make([]BatchSilenceResult, 0, len(ids))
+	for _, id := range ids {
+		silenceID, err := d.SilenceAlertEvent(ctx, id, userID, opts)
+		results = append(results, BatchSilenceResult{ID: id, SilenceID: silenceID, Err: err})
+	}
+	return results
+}
+
+// UnsilenceAlertEvent 使告警的静默失效，告警恢复为屏蔽前的状态
+func (d *AlertEventDomain) UnsilenceAlertEvent(ctx context.Context, id int, userID int64) error {
+	event, err := d.repo.GetAlertEventById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if event.SilenceID == "" {
+		return ErrAlertEventNotSilenced
+	}
+
+	urls, err := d.alertManagerURLs(ctx, event.SendGroupID)
+	if err != nil {
+		return err
+	}
+	// 静默在 AlertManager 集群内同步，任一实例成功即可
+	for _, u := range urls {
+		if err = pkg.DeleteSilenceRequest(ctx, zap.L(), u+"/api/v2/silence/"+event.SilenceID); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("解除静默失败: %w", err)
+	}
+
+	status := event.Status
+	if status == model.AlertEventStatusSilenced {
+		status = model.AlertEventStatusFiring
+		if event.RenLingUserID > 0 {
+			status = model.AlertEventStatusClaimed
+		}
+	}
+
+	return d.repo.UpdateAlertEvent(ctx, id, map[string]any{
+		"status":     status,
+		"silence_id": "",
+	}, &model.MonitorAlertEventTimeline{
+		EventID: id,
+		Action:  model.AlertEventActionUnsilence,
+		UserID:  userID,
+		Detail:  "silence_id=" + event.SilenceID,
+	})
+}
+
+// ResolveAlertEvent 手动将告警置为已恢复，如告警规则已删除不会再收到恢复通知时；之后收到新的触发会重新置为告警中
+func (d *AlertEventDomain) ResolveAlertEvent(ctx context.Context, id int, userID int64, comment string) error {
+	event, err := d.repo.GetAlertEventById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if event.Status == model.AlertEventStatusResolved {
+		return ErrAlertEventResolved
+	}
+
+	return d.repo.UpdateAlertEvent(ctx, id, map[string]any{
+		"status":  model.AlertEventStatusResolved,
+		"ends_at": time.Now().Unix(),
+	}, &model.MonitorAlertEventTimeline{
+		EventID: id,
+		Action:  model.AlertEventActionResolve,
+		UserID:  userID,
+		Detail:  comment,
+	})
+}
+
+func (d *AlertEventDomain) GetAlertEventTimeline(ctx context.Context, id int) ([]*model.MonitorAlertEventTimeline, error) {
+	return d.repo.ListAlertEventTimeline(ctx, id)
+}
+
+// silence 构建静默并发送到 AlertManager，任一实例成功即返回
+func (d *AlertEventDomain) silence(ctx context.Context, event *model.MonitorAlertEvent, userID int64, opts SilenceOptions) (string, error) {
+	event.LabelsMatcher = pkg.FromSliceTuMap(event.Labels)
+	matchers, err := pkg.BuildMatchers(event, zap.L(), opts.UseName)
+	if err != nil {
+		return "", err
+	}
+
+	comment := opts.Comment
+	if comment == "" {
+		comment = "屏蔽告警 " + event.AlertName
+	}
+	now := time.Now()
+	data, err := json.Marshal(pkg.Silence{
+		Matchers:  matchers,
+		StartsAt:  now,
+		EndsAt:    now.Add(opts.Duration),
+		CreatedBy: strconv.FormatInt(userID, 10),
+		Comment:   comment,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	urls, err := d.alertManagerURLs(ctx, event.SendGroupID)
+	if err != nil {
+		return "", err
+	}
+	var silenceID string
+	for _, u := range urls {
+		if silenceID, err = pkg.SendSilenceRequest(ctx, zap.L(), u+"/api/v2/silences", data); err == nil {
+			return silenceID, nil
+		}
+	}
+
+	return "", fmt.Errorf("创建静默失败: %w", err)
+}
+
+// alertManagerURLs 获取告警所属发送组的 AlertManager 实例地址
+func (d *AlertEventDomain) alertManagerURLs(ctx context.Context, sendGroupID int) ([]string, error) {
+	if sendGroupID <= 0 {
+		return nil, errors.New("告警未关联发送组，无法确定 AlertManager")
+	}
+
+	group, err := d.sendGroupRepo.GetMonitorSendGroupById(ctx, int64(sendGroupID))
+	if err != nil {
+		return nil, fmt.Errorf("获取发送组失败: %w", err)
+	}
+	pool, err := d.poolRepo.GetMonitorAlertManagerPoolById(ctx, group.PoolID)
+	if err != nil {
+		return nil, fmt.Errorf("获取 AlertManager 池失败: %w", err)
+	}
+	if len(pool.AlertManagerInstances) == 0 {
+		return nil, fmt.Errorf("AlertManager 池 %s 没有实例", pool.Name)
+	}
+
+	urls := make([]string, 0, len(pool.AlertManagerInstances))
+	for _, instance := range pool.AlertManagerInstances {
+		urls = append(urls, pkg.AlertManagerURL(instance))
+	}
+	return urls, nil
+}
+
+func (d *AlertEventDomain) BuildAlertEventRespModel(events []*model.MonitorAlertEvent) []*types.AlertEvent {
+	list := make([]*types.AlertEvent, 0, len(events))
+	for _, event := range events {
+		list = append(list, &types.AlertEvent{
+			Id:            int64(event.ID),
+			AlertName:     event.AlertName,
+			Fingerprint:   event.Fingerprint,
+			Status:        event.Status,
+			RuleId:        int64(event.RuleID),
+			SendGroupId:   int64(event.SendGroupID),
+			EventTimes:    int32(event.EventTimes),
+			SilenceId:     event.SilenceID,
+			RenLingUserId: int64(event.RenLingUserID),
+			Labels:        event.Labels,
+			Annotations:   event.Annotations,
+			Severity:      event.Severity,
+			TreeNodeId:    event.TreeNodeID,
+			StartsAt:      event.StartsAt,
+			EndsAt:        event.EndsAt,
+			OnDutyUserId:  event.OnDutyUserID,
+			CreateTime:    event.CreateTime,
+			UpdateTime:    event.UpdateTime,
+		})
+	}
+	return list
+}
+
+func (d *AlertEventDomain) BuildAlertEventTimelineRespModel(timeline []*model.MonitorAlertEventTimeline) []*types.AlertEventTimeline {
+	list := make([]*types.AlertEventTimeline, 0, len(timeline))
+	for _, t := range timeline {
+		list = append(list, &types.AlertEventTimeline{
+			Id:         t.ID,
+			EventId:    int64(t.EventID),
+			Action:     t.Action,
+			UserId:     t.UserID,
+			Detail:     t.Detail,
+			CreateTime: t.CreateTime,
+		})
+	}
+	return list
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+	"github.com/prometheus/alertmanager/template"
+)
+
+func TestApplyAlert(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	alert := func(status string, startsAt time.Time) template.Alert {
+		return template.Alert{
+			Status: status,
+			Labels: template.KV{
+				"alertname":               "HighCPU",
+				"instance":                "10.0.0.1:9100",
+				model.AlertRuleIDLabel:    "7",
+				model.AlertSendGroupLabel: "3",
+				model.AlertSeverityLabel:  "critical",
+			},
+			StartsAt:    startsAt,
+			EndsAt:      startsAt.Add(10 * time.Minute),
+			Fingerprint: "abc",
+		}
+	}
+	rule := &model.AlertRule{ID: 7, TreeNodeID: 12, Severity: "warning"}
+
+	event := &model.MonitorAlertEvent{Fingerprint: "abc"}
+	if !ApplyAlert(event, alert("firing", start), 0, rule) {
+		t.Fatal("first firing should be written")
+	}
+	event.ID = 1
+	if event.Status != model.AlertEventStatusFiring || event.EventTimes != 1 || event.RuleID != 7 || event.SendGroupID != 3 || event.TreeNodeID != 12 || event.Severity != "critical" {
+		t.Fatalf("event after firing = %+v", event)
+	}
+	// SortedPairs 将 alertname 排在最前，其余按标签名排序
+	if want := (model.StringList{"alertname=HighCPU", "alert_rule_id=7", "alert_send_group=3", "instance=10.0.0.1:9100", "severity=critical"}); !slices.Equal(event.Labels, want) {
+		t.Errorf("labels = %v, want %v", event.Labels, want)
+	}
+
+	// 重复通知和重试不修改事件
+	event.RenLingUserID = 5
+	if ApplyAlert(event, alert("firing", start), 0, rule) {
+		t.Error("repeated firing should be skipped")
+	}
+
+	if !ApplyAlert(event, alert("resolved", start), 0, rule) || event.Status != model.AlertEventStatusResolved || event.EndsAt == 0 {
+		t.Fatalf("event after resolved = %+v", event)
+	}
+	if ApplyAlert(event, alert("resolved", start), 0, rule) || ApplyAlert(event, alert("firing", start), 0, rule) {
+		t.Error("retried notifications of a resolved firing should be skipped")
+	}
+
+	// 再次触发计数并清除认领人
+	again := start.Add(time.Hour)
+	if !ApplyAlert(event, alert("firing", again), 0, rule) {
+		t.Fatal("new firing should be written")
+	}
+	if event.Status != model.AlertEventStatusFiring || event.EventTimes != 2 || event.RenLingUserID != 0 || event.EndsAt != 0 {
+		t.Errorf("event after new firing = %+v", event)
+	}
+
+	// 上一次触发的通知晚到
+	if ApplyAlert(event, alert("resolved", start), 0, rule) {
+		t.Error("stale resolved should be skipped")
+	}
+}
@@ -0,0 +1,509 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/dao"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/pkg"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/types"
+)
+
+const (
+	onDutyDateLayout     = "2006-01-02"
+	onDutyHandoverLayout = "15:04"
+	// maxOnDutyShiftsRange 值班表单次最多查询的时间范围
+	maxOnDutyShiftsRange = 92 * 24 * time.Hour
+)
+
+// OnDutyShift 值班表中的一段，时间为 [Start, End)
+type OnDutyShift struct {
+	Start  time.Time
+	End    time.Time
+	UserID int64
+	// OriginUserID 替班时为被替班的轮换值班人，否则为 0
+	OriginUserID int64
+	// OverrideID 生效的替班记录，非替班时为 0
+	OverrideID int64
+}
+
+// OnDutySchedule 根据值班组的轮换规则和替班记录计算值班人。
+// 班次按日历日计算，交接班时间在 loc 时区，夏令时切换不影响交接时刻
+type OnDutySchedule struct {
+	members   []int64
+	overrides []*model.MonitorOnDutyOverride
+	loc       *time.Location
+	// start 第一个班次的开始时间
+	start time.Time
+	// days 每个班次的天数
+	days int
+}
+
+// ValidateOnDutyGroup 检查值班组的成员和轮换规则
+func ValidateOnDutyGroup(group *model.MonitorOnDutyGroup) error {
+	if group.Name == "" {
+		return errors.New("值班组名称不能为空")
+	}
+	if len(group.Members) == 0 {
+		return errors.New("值班组成员不能为空")
+	}
+	seen := make(map[int64]bool, len(group.Members))
+	for _, member := range group.Members {
+		if member <= 0 {
+			return fmt.Errorf("无效的值班成员：%d", member)
+		}
+		if seen[member] {
+			return fmt.Errorf("值班成员 %d 重复", member)
+		}
+		seen[member] = true
+	}
+	if group.RotationPeriod != model.OnDutyRotationDaily && group.RotationPeriod != model.OnDutyRotationWeekly {
+		return fmt.Errorf("不支持的轮换周期：%s", group.RotationPeriod)
+	}
+	if _, err := time.Parse(onDutyDateLayout, group.StartDate); err != nil {
+		return fmt.Errorf("开始日期 %s 格式错误，应为 %s", group.StartDate, onDutyDateLayout)
+	}
+	if _, err := time.Parse(onDutyHandoverLayout, group.HandoverTime); err != nil {
+		return fmt.Errorf("交接班时间 %s 格式错误，应为 %s", group.HandoverTime, onDutyHandoverLayout)
+	}
+	return nil
+}
+
+func NewOnDutySchedule(group *model.MonitorOnDutyGroup, overrides []*model.MonitorOnDutyOverride, loc *time.Location) (*OnDutySchedule, error) {
+	if err := ValidateOnDutyGroup(group); err != nil {
+		return nil, err
+	}
+
+	date, _ := time.Parse(onDutyDateLayout, group.StartDate)
+	handover, _ := time.Parse(onDutyHandoverLayout, group.HandoverTime)
+	days := 1
+	if group.RotationPeriod == model.OnDutyRotationWeekly {
+		days = 7
+	}
+
+	return &OnDutySchedule{
+		members:   group.Members,
+		overrides: overrides,
+		loc:       loc,
+		start:     time.Date(date.Year(), date.Month(), date.Day(), handover.Hour(), handover.Minute(), 0, 0, loc),
+		days:      days,
+	}, nil
+}
+
+// Rotation 获取 t 所在的轮换班次，不考虑替班；t 早于第一个班次时返回 false
+func (s *OnDutySchedule) Rotation(t time.Time) (OnDutyShift, bool) {
+	index := s.rotationIndex(t)
+	if index < 0 {
+		return OnDutyShift{}, false
+	}
+
+	return OnDutyShift{
+		Start:  s.shiftStart(index),
+		End:    s.shiftStart(index + 1),
+		UserID: s.members[index%len(s.members)],
+	}, true
+}
+
+// At 获取 t 时的值班人及其连续值班的时间段
+func (s *OnDutySchedule) At(t time.Time) (OnDutyShift, bool) {
+	from, to := s.span(t)
+	for _, shift := range s.Shifts(from, to) {
+		if !t.Before(shift.Start) && t.Before(shift.End) {
+			return shift, true
+		}
+	}
+	return OnDutyShift{}, false
+}
+
+// Shifts 列出 [start, end) 的值班表，轮换班次被替班切分，相邻的同一值班人合并为一段
+func (s *OnDutySchedule) Shifts(start, end time.Time) []OnDutyShift {
+	if !start.Before(end) {
+		return nil
+	}
+
+	// 值班人只会在交接班和替班的起止时刻变化
+	points := []time.Time{start, end}
+	for i := max(s.rotationIndex(start)+1, 0); ; i++ {
+		t := s.shiftStart(i)
+		if !t.Before(end) {
+			break
+		}
+		if t.After(start) {
+			points = append(points, t)
+		}
+	}
+	for _, o := range s.overrides {
+		for _, t := range []time.Time{time.Unix(o.StartTime, 0), time.Unix(o.EndTime, 0)} {
+			if t.After(start) && t.Before(end) {
+				points = append(points, t)
+			}
+		}
+	}
+	slices.SortFunc(points, func(a, b time.Time) int { return a.Compare(b) })
+	points = slices.CompactFunc(points, func(a, b time.Time) bool { return a.Equal(b) })
+
+	var shifts []OnDutyShift
+	for i := 0; i+1 < len(points); i++ {
+		shift, ok := s.at(points[i])
+		if !ok {
+			continue
+		}
+		shift.Start, shift.End = points[i], points[i+1]
+
+		if n := len(shifts); n > 0 {
+			last := &shifts[n-1]
+			if last.End.Equal(shift.Start) && last.UserID == shift.UserID &&
+				last.OriginUserID == shift.OriginUserID && last.OverrideID == shift.OverrideID {
+				last.End = shift.End
+				continue
+			}
+		}
+		shifts = append(shifts, shift)
+	}
+	return shifts
+}
+
+// span 计算 t 所在连续值班时间段的范围，有替班覆盖 t 时扩展到替班的起止时间
+func (s *OnDutySchedule) span(t time.Time) (time.Time, time.Time) {
+	from, to := t, t.Add(time.Second)
+	if rotation, ok := s.Rotation(t); ok {
+		from, to = rotation.Start, rotation.End
+	}
+	if o := s.override(t); o != nil {
+		from = minTime(from, time.Unix(o.StartTime, 0))
+		to = maxTime(to, time.Unix(o.EndTime, 0))
+	}
+	return from, to
+}
+
+// at 获取 t 时的值班人，替班优先于轮换，不含时间段
+func (s *OnDutySchedule) at(t time.Time) (OnDutyShift, bool) {
+	rotation, ok := s.Rotation(t)
+	if o := s.override(t); o != nil {
+		return OnDutyShift{UserID: o.UserID, OriginUserID: rotation.UserID, OverrideID: o.ID}, true
+	}
+	return OnDutyShift{UserID: rotation.UserID}, ok
+}
+
+// override 获取覆盖 t 的替班记录，多条重叠时最后创建的生效
+func (s *OnDutySchedule) override(t time.Time) *model.MonitorOnDutyOverride {
+	var found *model.MonitorOnDutyOverride
+	unix := t.Unix()
+	for _, o := range s.overrides {
+		if o.StartTime <= unix && unix < o.EndTime && (found == nil || o.ID > found.ID) {
+			found = o
+		}
+	}
+	return found
+}
+
+// rotationIndex 获取 t 所在轮换班次的序号，第一个班次为 0，早于第一个班次时为负数
+func (s *OnDutySchedule) rotationIndex(t time.Time) int {
+	local := t.In(s.loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), s.start.Hour(), s.start.Minute(), 0, 0, s.loc)
+	if local.Before(day) {
+		// 当天交接班之前属于前一天的班次
+		day = day.AddDate(0, 0, -1)
+	}
+	return floorDiv(civilDays(day)-civilDays(s.start), s.days)
+}
+
+func (s *OnDutySchedule) shiftStart(index int) time.Time {
+	return time.Date(s.start.Year(), s.start.Month(), s.start.Day()+index*s.days, s.start.Hour(), s.start.Minute(), 0, 0, s.loc)
+}
+
+// civilDays 日期距 1970-01-01 的天数，忽略时区和夏令时
+func civilDays(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+type OnDutyDomain struct {
+	repo          repo.OnDutyRepo
+	sendGroupRepo repo.SendGroupRepo
+	// loc 交接班时间所在的时区
+	loc *time.Location
+}
+
+func NewOnDutyDomain(svcCtx *svc.ServiceContext) *OnDutyDomain {
+	return &OnDutyDomain{
+		repo:          dao.NewOnDutyDAO(svcCtx.DB),
+		sendGroupRepo: dao.NewSendGroupDAO(svcCtx.DB),
+		loc:           time.Local,
+	}
+}
+
+func (d *OnDutyDomain) GetOnDutyGroupList(ctx context.Context, searchName *string) ([]*model.MonitorOnDutyGroup, error) {
+	return pkg.HandleList(ctx, searchName,
+		d.repo.SearchOnDutyGroupByName, // 搜索函数
+		d.repo.GetOnDutyGroupList)      // 获取所有函数
+}
+
+func (d *OnDutyDomain) CreateOnDutyGroup(ctx context.Context, group *model.MonitorOnDutyGroup) error {
+	if err := ValidateOnDutyGroup(group); err != nil {
+		return err
+	}
+
+	exist, err := d.repo.CheckOnDutyGroupExists(ctx, group.Name)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return fmt.Errorf("值班组 %s 已存在", group.Name)
+	}
+
+	return d.repo.CreateOnDutyGroup(ctx, group)
+}
+
+// UpdateOnDutyGroup 更新值班组，修改成员或轮换规则后已有的替班记录保持不变
+func (d *OnDutyDomain) UpdateOnDutyGroup(ctx context.Context, group *model.MonitorOnDutyGroup) error {
+	if err := ValidateOnDutyGroup(group); err != nil {
+		return err
+	}
+
+	old, err := d.repo.GetOnDutyGroupById(ctx, group.ID)
+	if err != nil {
+		return fmt.Errorf("值班组 %d 不存在: %w", group.ID, err)
+	}
+	if old.Name != group.Name {
+		exist, err := d.repo.CheckOnDutyGroupExists(ctx, group.Name)
+		if err != nil {
+			return err
+		}
+		if exist {
+			return fmt.Errorf("值班组 %s 已存在", group.Name)
+		}
+	}
+
+	return d.repo.UpdateOnDutyGroup(ctx, group)
+}
+
+// DeleteOnDutyGroup 删除值班组，仍被发送组使用时不允许删除
+func (d *OnDutyDomain) DeleteOnDutyGroup(ctx context.Context, id int64) error {
+	sendGroups, err := d.sendGroupRepo.GetMonitorSendGroupByOnDutyGroupId(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(sendGroups) > 0 {
+		return fmt.Errorf("值班组 %d 正在被发送组 %s 使用", id, sendGroups[0].Name)
+	}
+
+	return d.repo.DeleteOnDutyGroup(ctx, id)
+}
+
+func (d *OnDutyDomain) CreateOnDutyOverride(ctx context.Context, override *model.MonitorOnDutyOverride) error {
+	if override.UserID <= 0 {
+		return errors.New("替班人不能为空")
+	}
+	if override.EndTime <= override.StartTime {
+		return errors.New("替班结束时间必须晚于开始时间")
+	}
+	if _, err := d.repo.GetOnDutyGroupById(ctx, override.GroupID); err != nil {
+		return fmt.Errorf("值班组 %d 不存在: %w", override.GroupID, err)
+	}
+
+	return d.repo.CreateOnDutyOverrides(ctx, override)
+}
+
+func (d *OnDutyDomain) DeleteOnDutyOverride(ctx context.Context, id int64) error {
+	return d.repo.DeleteOnDutyOverride(ctx, id)
+}
+
+// SwapOnDutyShifts 交换 first、second 所在的两个轮换班次，生成两条覆盖整个班次的替班记录
+func (d *OnDutyDomain) SwapOnDutyShifts(ctx context.Context, groupID int64, first, second time.Time, userID int64, reason string) ([]*model.MonitorOnDutyOverride, error) {
+	group, err := d.repo.GetOnDutyGroupById(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("值班组 %d 不存在: %w", groupID, err)
+	}
+	schedule, err := NewOnDutySchedule(group, nil, d.loc)
+	if err != nil {
+		return nil, err
+	}
+
+	a, okA := schedule.Rotation(first)
+	b, okB := schedule.Rotation(second)
+	if !okA || !okB {
+		return nil, errors.New("换班时间早于值班组的第一个班次")
+	}
+	if a.UserID == b.UserID {
+		return nil, errors.New("两个班次的值班人相同，无需换班")
+	}
+
+	if reason == "" {
+		reason = "换班"
+	}
+	overrides := []*model.MonitorOnDutyOverride{
+		{GroupID: groupID, UserID: b.UserID, OriginUserID: a.UserID, StartTime: a.Start.Unix(), EndTime: a.End.Unix(), Reason: reason, CreateUserID: userID},
+		{GroupID: groupID, UserID: a.UserID, OriginUserID: b.UserID, StartTime: b.Start.Unix(), EndTime: b.End.Unix(), Reason: reason, CreateUserID: userID},
+	}
+	if err := d.repo.CreateOnDutyOverrides(ctx, overrides...); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// GetOnCall 获取值班组在 t 时的值班人
+func (d *OnDutyDomain) GetOnCall(ctx context.Context, groupID int64, t time.Time) (OnDutyShift, bool, error) {
+	group, err := d.repo.GetOnDutyGroupById(ctx, groupID)
+	if err != nil {
+		return OnDutyShift{}, false, fmt.Errorf("值班组 %d 不存在: %w", groupID, err)
+	}
+	schedule, err := NewOnDutySchedule(group, nil, d.loc)
+	if err != nil {
+		return OnDutyShift{}, false, err
+	}
+
+	// 覆盖 t 的替班可能超出所在轮换班次，先加载轮换班次内的替班找到它，
+	// 再加载扩展后范围内的全部替班记录，以计算连续值班的时间段
+	for range 2 {
+		from, to := schedule.span(t)
+		schedule.overrides, err = d.repo.ListOnDutyOverrides(ctx, groupID, from.Unix(), to.Unix())
+		if err != nil {
+			return OnDutyShift{}, false, err
+		}
+	}
+
+	shift, ok := schedule.At(t)
+	return shift, ok, nil
+}
+
+// ListOnDutyShifts 列出值班组在 [start, end) 的值班表
+func (d *OnDutyDomain) ListOnDutyShifts(ctx context.Context, groupID int64, start, end time.Time) ([]OnDutyShift, error) {
+	if !start.Before(end) {
+		return nil, errors.New("结束时间必须晚于开始时间")
+	}
+	if end.Sub(start) > maxOnDutyShiftsRange {
+		return nil, fmt.Errorf("值班表最多查询 %d 天", int(maxOnDutyShiftsRange/(24*time.Hour)))
+	}
+
+	group, err := d.repo.GetOnDutyGroupById(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("值班组 %d 不存在: %w", groupID, err)
+	}
+	overrides, err := d.repo.ListOnDutyOverrides(ctx, groupID, start.Unix(), end.Unix())
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := NewOnDutySchedule(group, overrides, d.loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule.Shifts(start, end), nil
+}
+
+// GetSendGroupOnCall 获取发送组在 t 时的告警接收人；发送组未关联值班组或尚无人值班时返回 0
+func (d *OnDutyDomain) GetSendGroupOnCall(ctx context.Context, sendGroupID int64, t time.Time) (int64, error) {
+	sendGroup, err := d.sendGroupRepo.GetMonitorSendGroupById(ctx, sendGroupID)
+	if err != nil {
+		return 0, fmt.Errorf("获取发送组 %d 失败: %w", sendGroupID, err)
+	}
+	if sendGroup.OnDutyGroupID <= 0 {
+		return 0, nil
+	}
+
+	shift, ok, err := d.GetOnCall(ctx, sendGroup.OnDutyGroupID, t)
+	if err != nil || !ok {
+		return 0, err
+	}
+	return shift.UserID, nil
+}
+
+func (d *OnDutyDomain) BuildOnDutyGroupModel(group *types.OnDutyGroup) *model.MonitorOnDutyGroup {
+	return &model.MonitorOnDutyGroup{
+		ID:             group.Id,
+		Name:           group.Name,
+		UserID:         group.UserId,
+		Members:        group.Members,
+		RotationPeriod: group.RotationPeriod,
+		StartDate:      group.StartDate,
+		HandoverTime:   group.HandoverTime,
+	}
+}
+
+func (d *OnDutyDomain) BuildOnDutyGroupRespModel(groups []*model.MonitorOnDutyGroup) []*types.OnDutyGroup {
+	list := make([]*types.OnDutyGroup, 0, len(groups))
+	for _, group := range groups {
+		list = append(list, &types.OnDutyGroup{
+			Id:             group.ID,
+			Name:           group.Name,
+			UserId:         group.UserID,
+			Members:        group.Members,
+			RotationPeriod: group.RotationPeriod,
+			StartDate:      group.StartDate,
+			HandoverTime:   group.HandoverTime,
+			CreateTime:     group.CreateTime,
+			UpdateTime:     group.UpdateTime,
+		})
+	}
+	return list
+}
+
+func (d *OnDutyDomain) BuildOnDutyOverrideModel(override *types.OnDutyOverride) *model.MonitorOnDutyOverride {
+	return &model.MonitorOnDutyOverride{
+		GroupID:      override.GroupId,
+		UserID:       override.UserId,
+		OriginUserID: override.OriginUserId,
+		StartTime:    override.StartTime,
+		EndTime:      override.EndTime,
+		Reason:       override.Reason,
+		CreateUserID: override.CreateUserId,
+	}
+}
+
+func (d *OnDutyDomain) BuildOnDutyOverrideRespModel(overrides []*model.MonitorOnDutyOverride) []*types.OnDutyOverride {
+	list := make([]*types.OnDutyOverride, 0, len(overrides))
+	for _, o := range overrides {
+		list = append(list, &types.OnDutyOverride{
+			Id:           o.ID,
+			GroupId:      o.GroupID,
+			UserId:       o.UserID,
+			OriginUserId: o.OriginUserID,
+			StartTime:    o.StartTime,
+			EndTime:      o.EndTime,
+			Reason:       o.Reason,
+			CreateUserId: o.CreateUserID,
+			CreateTime:   o.CreateTime,
+		})
+	}
+	return list
+}
+
+func (d *OnDutyDomain) BuildOnDutyShiftRespModel(shift OnDutyShift) *types.OnDutyShift {
+	return &types.OnDutyShift{
+		UserId:       shift.UserID,
+		StartTime:    shift.Start.Unix(),
+		EndTime:      shift.End.Unix(),
+		OriginUserId: shift.OriginUserID,
+		OverrideId:   shift.OverrideID,
+	}
+}
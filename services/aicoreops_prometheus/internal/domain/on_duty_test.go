@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/repo"
+)
+
+func TestOnDutySchedule(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	at := func(day, clock string) time.Time {
+		ts, err := time.ParseInLocation("2006-01-02 15:04", day+" "+clock, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ts
+	}
+
+	daily := &model.MonitorOnDutyGroup{
+		Name:           "sre",
+		Members:        model.Int64List{1, 2, 3},
+		RotationPeriod: model.OnDutyRotationDaily,
+		StartDate:      "2026-03-01",
+		HandoverTime:   "09:00",
+	}
+	schedule, err := NewOnDutySchedule(daily, nil, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := schedule.Rotation(at("2026-03-01", "08:59")); ok {
+		t.Error("no one should be on call before the first shift")
+	}
+	for _, c := range []struct {
+		day, clock string
+		want       int64
+	}{
+		{"2026-03-01", "09:00", 1},
+		{"2026-03-02", "08:59", 1},
+		{"2026-03-02", "09:00", 2},
+		{"2026-03-03", "23:00", 3},
+		{"2026-03-04", "10:00", 1},
+	} {
+		shift, ok := schedule.Rotation(at(c.day, c.clock))
+		if !ok || shift.UserID != c.want {
+			t.Errorf("rotation at %s %s = %d, want %d", c.day, c.clock, shift.UserID, c.want)
+		}
+	}
+
+	weekly := *daily
+	weekly.Members = model.Int64List{1, 2}
+	weekly.RotationPeriod = model.OnDutyRotationWeekly
+	weekly.StartDate = "2026-03-02"
+	weekly.HandoverTime = "10:00"
+	weeklySchedule, err := NewOnDutySchedule(&weekly, nil, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shift, _ := weeklySchedule.Rotation(at("2026-03-09", "09:59")); shift.UserID != 1 || !shift.Start.Equal(at("2026-03-02", "10:00")) || !shift.End.Equal(at("2026-03-09", "10:00")) {
+		t.Errorf("weekly shift = %+v", shift)
+	}
+	if shift, _ := weeklySchedule.Rotation(at("2026-03-16", "10:00")); shift.UserID != 1 {
+		t.Errorf("weekly rotation should wrap around, got %d", shift.UserID)
+	}
+
+	// 替班覆盖轮换，重叠时后创建的生效
+	schedule.overrides = []*model.MonitorOnDutyOverride{
+		{ID: 1, UserID: 9, StartTime: at("2026-03-02", "12:00").Unix(), EndTime: at("2026-03-02", "18:00").Unix()},
+		{ID: 2, UserID: 8, StartTime: at("2026-03-02", "15:00").Unix(), EndTime: at("2026-03-02", "16:00").Unix()},
+	}
+	if shift, ok := schedule.At(at("2026-03-02", "13:00")); !ok || shift.UserID != 9 || shift.OriginUserID != 2 ||
+		!shift.Start.Equal(at("2026-03-02", "12:00")) || !shift.End.Equal(at("2026-03-02", "15:00")) {
+		t.Errorf("override at 13:00 = %+v", shift)
+	}
+	if shift, _ := schedule.At(at("2026-03-02", "10:00")); shift.UserID != 2 || !shift.End.Equal(at("2026-03-02", "12:00")) {
+		t.Errorf("rotation at 10:00 = %+v", shift)
+	}
+
+	want := []struct {
+		start, end string
+		user       int64
+	}{
+		{"2026-03-02 00:00", "2026-03-02 09:00", 1},
+		{"2026-03-02 09:00", "2026-03-02 12:00", 2},
+		{"2026-03-02 12:00", "2026-03-02 15:00", 9},
+		{"2026-03-02 15:00", "2026-03-02 16:00", 8},
+		{"2026-03-02 16:00", "2026-03-02 18:00", 9},
+		{"2026-03-02 18:00", "2026-03-03 09:00", 2},
+		{"2026-03-03 09:00", "2026-03-03 12:00", 3},
+	}
+	shifts := schedule.Shifts(at("2026-03-02", "00:00"), at("2026-03-03", "12:00"))
+	if len(shifts) != len(want) {
+		t.Fatalf("shifts = %+v, want %d segments", shifts, len(want))
+	}
+	for i, w := range want {
+		got := shifts[i]
+		if got.UserID != w.user || got.Start.In(loc).Format("2006-01-02 15:04") != w.start || got.End.In(loc).Format("2006-01-02 15:04") != w.end {
+			t.Errorf("shift %d = %d [%s, %s), want %d [%s, %s)", i, got.UserID, got.Start.In(loc), got.End.In(loc), w.user, w.start, w.end)
+		}
+	}
+}
+
+// fakeOnDutyRepo 只实现值班表计算用到的查询，其余方法未实现
+type fakeOnDutyRepo struct {
+	repo.OnDutyRepo
+	group     *model.MonitorOnDutyGroup
+	overrides []*model.MonitorOnDutyOverride
+}
+
+func (f *fakeOnDutyRepo) GetOnDutyGroupById(ctx context.Context, id int64) (*model.MonitorOnDutyGroup, error) {
+	return f.group, nil
+}
+
+func (f *fakeOnDutyRepo) ListOnDutyOverrides(ctx context.Context, groupID, start, end int64) ([]*model.MonitorOnDutyOverride, error) {
+	var res []*model.MonitorOnDutyOverride
+	for _, o := range f.overrides {
+		if o.StartTime < end && start < o.EndTime {
+			res = append(res, o)
+		}
+	}
+	return res, nil
+}
+
+func TestGetOnCall(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	at := func(day, clock string) time.Time {
+		ts, err := time.ParseInLocation("2006-01-02 15:04", day+" "+clock, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ts
+	}
+
+	// 只有一个成员时替班跨过交接班仍是连续的一段；替班 1 超出所在轮换班次，替班 2 只与扩展出的部分重叠
+	d := &OnDutyDomain{loc: loc, repo: &fakeOnDutyRepo{
+		group: &model.MonitorOnDutyGroup{
+			ID:             1,
+			Name:           "sre",
+			Members:        model.Int64List{1},
+			RotationPeriod: model.OnDutyRotationDaily,
+			StartDate:      "2026-03-01",
+			HandoverTime:   "09:00",
+		},
+		overrides: []*model.MonitorOnDutyOverride{
+			{ID: 1, GroupID: 1, UserID: 9, StartTime: at("2026-03-02", "12:00").Unix(), EndTime: at("2026-03-03", "12:00").Unix()},
+			{ID: 2, GroupID: 1, UserID: 8, StartTime: at("2026-03-03", "10:00").Unix(), EndTime: at("2026-03-03", "11:00").Unix()},
+		},
+	}}
+
+	shift, ok, err := d.GetOnCall(context.Background(), 1, at("2026-03-02", "20:00"))
+	if err != nil || !ok {
+		t.Fatalf("GetOnCall = %v, %v", ok, err)
+	}
+	if shift.UserID != 9 || !shift.Start.Equal(at("2026-03-02", "12:00")) || !shift.End.Equal(at("2026-03-03", "10:00")) {
+		t.Errorf("shift = %+v, want user 9 until the later override starts", shift)
+	}
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/logic"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AlertWebhookHandler 接收 AlertManager webhook 通知，地址为 AlertManagerConfig.AlertWebhookAddr
+type AlertWebhookHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewAlertWebhookHandler(svcCtx *svc.ServiceContext) *AlertWebhookHandler {
+	return &AlertWebhookHandler{
+		svcCtx: svcCtx,
+	}
+}
+
+// RegisterRoutes 注册 webhook 路由
+func (h *AlertWebhookHandler) RegisterRoutes(server *rest.Server) {
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/alerts/webhook",
+				Handler: h.Receive,
+			},
+		},
+		rest.WithPrefix("/api/v1"),
+	)
+}
+
+// Receive 写入失败时返回 500，由 AlertManager 重试
+func (h *AlertWebhookHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	var data template.Data
+	if err := httpx.ParseJsonBody(r, &data); err != nil {
+		http.Error(w, "解析告警通知失败: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// 发送组在 webhook URL 中：?alert_send_group=<id>
+	sendGroupID, _ := strconv.Atoi(r.URL.Query().Get(model.AlertSendGroupLabel))
+
+	res, err := logic.NewAlertEventLogic(r.Context(), h.svcCtx).ReceiveWebhook(r.Context(), &data, sendGroupID)
+	if err != nil {
+		http.Error(w, "写入告警事件失败: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.OkJsonCtx(r.Context(), w, map[string]any{
+		"changed": res.Changed,
+		"skipped": res.Skipped,
+	})
+}
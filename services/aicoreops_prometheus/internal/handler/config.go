@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+)
+
+// ConfigHandler 配置下发接口，Prometheus、AlertManager 实例（或其 sidecar）按IP轮询生成的配置，
+// 响应携带内容哈希作为 ETag，请求携带 If-None-Match 且配置未变化时返回 304，sidecar 只在变化时 reload
+type ConfigHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewConfigHandler(svcCtx *svc.ServiceContext) *ConfigHandler {
+	return &ConfigHandler{
+		svcCtx: svcCtx,
+	}
+}
+
+// RegisterRoutes 注册配置下发路由
+func (h *ConfigHandler) RegisterRoutes(server *rest.Server) {
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodGet,
+				Path:    "/prometheus/:ip/config",
+				Handler: h.serve(h.svcCtx.MonitorCache.GetPrometheusMainConfigByIP),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/prometheus/:ip/alert_rules",
+				Handler: h.serve(h.svcCtx.MonitorCache.GetPrometheusAlertRuleConfigYamlByIp),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/prometheus/:ip/record_rules",
+				Handler: h.serve(h.svcCtx.MonitorCache.GetPrometheusRecordRuleConfigYamlByIp),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/alertmanager/:ip/config",
+				Handler: h.serve(h.svcCtx.MonitorCache.GetAlertManagerMainConfigYamlByIP),
+			},
+		},
+		rest.WithPrefix("/api/v1/configs"),
+	)
+}
+
+// serve 返回按IP获取配置的处理函数，IP没有对应配置时返回 404
+func (h *ConfigHandler) serve(get func(ip string) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ip := pathvar.Vars(r)["ip"]
+		content := get(ip)
+		if content == "" {
+			http.Error(w, "没有找到该实例的配置", http.StatusNotFound)
+			return
+		}
+
+		etag := ContentETag(content)
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "no-cache")
+		if MatchETag(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/yaml; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(content))
+	}
+}
+
+// ContentETag 计算配置内容的强 ETag
+func ContentETag(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// MatchETag 判断 If-None-Match 是否命中，支持多个 ETag、弱比较（W/ 前缀）和 *
+func MatchETag(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+)
+
+// fakeMonitorCache 只返回固定IP的 Prometheus 主配置
+type fakeMonitorCache struct {
+	configs map[string]string
+}
+
+func (f *fakeMonitorCache) MonitorCacheManager(ctx context.Context) error { return nil }
+
+func (f *fakeMonitorCache) GetPrometheusMainConfigByIP(ip string) string { return f.configs[ip] }
+
+func (f *fakeMonitorCache) GetPrometheusAlertRuleConfigYamlByIp(ip string) string { return "" }
+
+func (f *fakeMonitorCache) GetPrometheusRecordRuleConfigYamlByIp(ip string) string { return "" }
+
+func (f *fakeMonitorCache) GetAlertManagerMainConfigYamlByIP(ip string) string { return "" }
+
+func TestConfigHandlerETag(t *testing.T) {
+	cache := &fakeMonitorCache{configs: map[string]string{"10.0.0.1": "global:\n  scrape_interval: 15s\n"}}
+	h := NewConfigHandler(&svc.ServiceContext{MonitorCache: cache})
+	serve := h.serve(cache.GetPrometheusMainConfigByIP)
+
+	request := func(ip, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/configs/prometheus/"+ip+"/config", nil)
+		r = pathvar.WithVars(r, map[string]string{"ip": ip})
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		serve(w, r)
+		return w
+	}
+
+	w := request("10.0.0.1", "")
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" || w.Body.String() != cache.configs["10.0.0.1"] {
+		t.Fatalf("first fetch = %d %q etag=%q", w.Code, w.Body.String(), etag)
+	}
+
+	// 配置未变化时不返回内容
+	if w = request("10.0.0.1", `"other", W/`+etag); w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("unchanged fetch = %d, want 304 without body", w.Code)
+	}
+
+	// 配置变化后 ETag 随之变化
+	cache.configs["10.0.0.1"] = "global:\n  scrape_interval: 30s\n"
+	if w = request("10.0.0.1", etag); w.Code != http.StatusOK || w.Header().Get("ETag") == etag {
+		t.Errorf("changed fetch = %d etag=%q, want 200 with new etag", w.Code, w.Header().Get("ETag"))
+	}
+
+	if w = request("10.0.0.2", ""); w.Code != http.StatusNotFound {
+		t.Errorf("unknown ip = %d, want 404", w.Code)
+	}
+}
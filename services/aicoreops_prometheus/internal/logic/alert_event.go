@@ -0,0 +1,189 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/repo"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/types"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	defaultAlertEventPageSize = 20
+	maxAlertEventPageSize     = 100
+)
+
+type AlertEventLogic struct {
+	ctx    context.Context
+	domain *domain.AlertEventDomain
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAlertEventLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AlertEventLogic {
+	return &AlertEventLogic{
+		ctx:    ctx,
+		domain: domain.NewAlertEventDomain(svcCtx),
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReceiveWebhook 处理 AlertManager webhook 通知，写入告警事件
+func (a *AlertEventLogic) ReceiveWebhook(ctx context.Context, data *template.Data, sendGroupID int) (domain.IngestResult, error) {
+	res, err := a.domain.IngestAlerts(ctx, data, sendGroupID)
+	if err != nil {
+		a.Logger.Errorf("写入告警事件失败: %v", err)
+		return res, err
+	}
+
+	a.Logger.Infof("接收告警通知成功: receiver=%s, status=%s, 更新 %d 条, 跳过 %d 条", data.Receiver, data.Status, res.Changed, res.Skipped)
+
+	return res, nil
+}
+
+// ListAlertEvents 分页获取告警事件，按触发开始时间倒序
+func (a *AlertEventLogic) ListAlertEvents(ctx context.Context, req *types.ListAlertEventsRequest) (*types.ListAlertEventsResponse, error) {
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultAlertEventPageSize
+	}
+	if pageSize > maxAlertEventPageSize {
+		pageSize = maxAlertEventPageSize
+	}
+
+	events, total, err := a.domain.ListAlertEvents(ctx, repo.AlertEventFilter{
+		Status:       req.Status,
+		Severity:     req.Severity,
+		SendGroupID:  req.SendGroupId,
+		TreeNodeID:   req.TreeNodeId,
+		OnDutyUserID: req.OnDutyUserId,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Offset:       (page - 1) * pageSize,
+		Limit:        pageSize,
+	})
+	if err != nil {
+		a.Logger.Errorf("获取告警事件列表失败: %v", err)
+		return nil, err
+	}
+
+	return &types.ListAlertEventsResponse{
+		Code:    0,
+		Message: "获取告警事件列表成功",
+		Data:    a.domain.BuildAlertEventRespModel(events),
+		Total:   total,
+	}, nil
+}
+
+func (a *AlertEventLogic) ClaimAlertEvent(ctx context.Context, req *types.ClaimAlertEventRequest) (*types.ClaimAlertEventResponse, error) {
+	if err := a.domain.ClaimAlertEvent(ctx, int(req.Id), req.UserId); err != nil {
+		a.Logger.Errorf("认领告警事件失败: %v", err)
+		return nil, err
+	}
+
+	return &types.ClaimAlertEventResponse{
+		Code:    0,
+		Message: "认领告警事件成功",
+	}, nil
+}
+
+func (a *AlertEventLogic) SilenceAlertEvent(ctx context.Context, req *types.SilenceAlertEventRequest) (*types.SilenceAlertEventResponse, error) {
+	silenceID, err := a.domain.SilenceAlertEvent(ctx, int(req.Id), req.UserId, domain.SilenceOptions{
+		UseName:  req.UseName,
+		Duration: time.Duration(req.DurationSeconds) * time.Second,
+		Comment:  req.Comment,
+	})
+	if err != nil {
+		a.Logger.Errorf("屏蔽告警事件失败: %v", err)
+		return nil, err
+	}
+
+	return &types.SilenceAlertEventResponse{
+		Code:      0,
+		Message:   "屏蔽告警事件成功",
+		SilenceId: silenceID,
+	}, nil
+}
+
+func (a *AlertEventLogic) UnsilenceAlertEvent(ctx context.Context, req *types.UnsilenceAlertEventRequest) (*types.UnsilenceAlertEventResponse, error) {
+	if err := a.domain.UnsilenceAlertEvent(ctx, int(req.Id), req.UserId); err != nil {
+		a.Logger.Errorf("解除屏蔽告警事件失败: %v", err)
+		return nil, err
+	}
+
+	return &types.UnsilenceAlertEventResponse{
+		Code:    0,
+		Message: "解除屏蔽告警事件成功",
+	}, nil
+}
+
+// BatchSilence 批量屏蔽告警事件，单个事件的失败原因在结果中返回
+func (a *AlertEventLogic) BatchSilence(ctx context.Context, req *types.BatchSilenceRequest) (*types.BatchSilenceResponse, error) {
+	ids := make([]int, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		ids = append(ids, int(id))
+	}
+
+	results := a.domain.BatchSilence(ctx, ids, req.UserId, domain.SilenceOptions{
+		UseName:  req.UseName,
+		Duration: time.Duration(req.DurationSeconds) * time.Second,
+		Comment:  req.Comment,
+	})
+
+	data := make([]*types.BatchSilenceResult, 0, len(results))
+	failed := 0
+	for _, res := range results {
+		item := &types.BatchSilenceResult{Id: int64(res.ID), SilenceId: res.SilenceID}
+		if res.Err != nil {
+			a.Logger.Errorf("屏蔽告警事件 %d 失败: %v", res.ID, res.Err)
+			item.Error = res.Err.Error()
+			failed++
+		}
+		data = append(data, item)
+	}
+
+	message := "批量屏蔽告警事件成功"
+	if failed > 0 {
+		message = "批量屏蔽告警事件部分失败"
+	}
+
+	return &types.BatchSilenceResponse{
+		Code:    0,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *AlertEventLogic) ResolveAlertEvent(ctx context.Context, req *types.ResolveAlertEventRequest) (*types.ResolveAlertEventResponse, error) {
+	if err := a.domain.ResolveAlertEvent(ctx, int(req.Id), req.UserId, req.Comment); err != nil {
+		a.Logger.Errorf("恢复告警事件失败: %v", err)
+		return nil, err
+	}
+
+	return &types.ResolveAlertEventResponse{
+		Code:    0,
+		Message: "恢复告警事件成功",
+	}, nil
+}
+
+func (a *AlertEventLogic) GetAlertEventTimeline(ctx context.Context, req *types.GetAlertEventTimelineRequest) (*types.GetAlertEventTimelineResponse, error) {
+	timeline, err := a.domain.GetAlertEventTimeline(ctx, int(req.Id))
+	if err != nil {
+		a.Logger.Errorf("获取告警事件处理记录失败: %v", err)
+		return nil, err
+	}
+
+	return &types.GetAlertEventTimelineResponse{
+		Code:    0,
+		Message: "获取告警事件处理记录成功",
+		Data:    a.domain.BuildAlertEventTimelineRespModel(timeline),
+	}, nil
+}
@@ -48,7 +48,8 @@ func (a *AlertManagerPoolLogic) CreateMonitorAlertManagerPool(ctx context.Contex
 		return nil, err
 	}
 
-	// TODO 更新实例缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("创建 Alertmanager 集群池")
 
 	a.Logger.Infof("创建 Alertmanager 集群池成功: %+v", pool)
 
@@ -67,7 +68,8 @@ func (a *AlertManagerPoolLogic) UpdateMonitorAlertManagerPool(ctx context.Contex
 		return nil, err
 	}
 
-	// TODO 更新实例缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("更新 Alertmanager 集群池")
 
 	a.Logger.Infof("更新 Alertmanager 集群池成功: %+v", pool)
 
@@ -87,7 +89,8 @@ func (a *AlertManagerPoolLogic) DeleteMonitorAlertManagerPool(ctx context.Contex
 		return nil, err
 	}
 
-	// TODO 更新实例缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("删除 Alertmanager 集群池")
 
 	a.Logger.Infof("删除 Alertmanager 集群池成功: %+v", req.Id)
 
@@ -47,7 +47,8 @@ func (a *AlertRuleLogic) CreateAlertRule(ctx context.Context, req *types.CreateA
 		return nil, err
 	}
 
-	// TODO 更新缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("创建告警规则")
 
 	return &types.CreateAlertRuleResponse{
 		Code:    0,
@@ -63,7 +64,8 @@ func (a *AlertRuleLogic) UpdateAlertRule(ctx context.Context, req *types.UpdateA
 		return nil, err
 	}
 
-	// TODO 更新缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("更新告警规则")
 
 	return &types.UpdateAlertRuleResponse{
 		Code:    0,
@@ -78,7 +80,8 @@ func (a *AlertRuleLogic) DeleteAlertRule(ctx context.Context, req *types.DeleteA
 		return nil, err
 	}
 
-	// TODO 更新缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("删除告警规则")
 
 	return &types.DeleteAlertRuleResponse{
 		Code:    0,
@@ -93,7 +96,8 @@ func (a *AlertRuleLogic) BatchDeleteAlertRule(ctx context.Context, req *types.Ba
 		return nil, err
 	}
 
-	// TODO 更新缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("批量删除告警规则")
 
 	return &types.BatchDeleteAlertRuleResponse{
 		Code:    0,
@@ -108,7 +112,8 @@ func (a *AlertRuleLogic) EnableSwitchAlertRule(ctx context.Context, req *types.E
 		return nil, err
 	}
 
-	// TODO 更新缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("启用或禁用告警规则")
 
 	return &types.EnableSwitchAlertRuleResponse{
 		Code:    0,
@@ -123,7 +128,8 @@ func (a *AlertRuleLogic) BatchEnableSwitchAlertRule(ctx context.Context, req *ty
 		return nil, err
 	}
 
-	// TODO 更新缓存
+	// 通知重新生成监控配置
+	a.svcCtx.ConfigSyncer.Notify("批量启用或禁用告警规则")
 
 	return &types.BatchEnableSwitchAlertRuleResponse{
 		Code:    0,
@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfigSyncLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewConfigSyncLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfigSyncLogic {
+	return &ConfigSyncLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (c *ConfigSyncLogic) GetConfigSyncStatus(ctx context.Context) (*types.GetConfigSyncStatusResponse, error) {
+	status := c.svcCtx.ConfigSyncer.Status()
+
+	return &types.GetConfigSyncStatusResponse{
+		Code:    0,
+		Message: "获取配置同步状态成功",
+		Data: &types.ConfigSyncStatus{
+			LastRunAt:      status.LastRunAt,
+			LastSuccessAt:  status.LastSuccessAt,
+			LastErrorAt:    status.LastErrorAt,
+			LastError:      status.LastError,
+			LastTrigger:    status.LastTrigger,
+			LastDurationMs: status.LastDuration.Milliseconds(),
+			Pending:        status.Pending,
+		},
+	}, nil
+}
@@ -0,0 +1,172 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/domain"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type OnDutyLogic struct {
+	ctx    context.Context
+	domain *domain.OnDutyDomain
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewOnDutyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OnDutyLogic {
+	return &OnDutyLogic{
+		ctx:    ctx,
+		domain: domain.NewOnDutyDomain(svcCtx),
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (o *OnDutyLogic) GetOnDutyGroupList(ctx context.Context, req *types.GetOnDutyGroupListRequest) (*types.GetOnDutyGroupListResponse, error) {
+	groups, err := o.domain.GetOnDutyGroupList(ctx, &req.Name)
+	if err != nil {
+		o.Logger.Errorf("获取值班组列表失败: %v", err)
+		return nil, err
+	}
+
+	return &types.GetOnDutyGroupListResponse{
+		Code:    0,
+		Message: "获取值班组列表成功",
+		Data:    o.domain.BuildOnDutyGroupRespModel(groups),
+	}, nil
+}
+
+func (o *OnDutyLogic) CreateOnDutyGroup(ctx context.Context, req *types.CreateOnDutyGroupRequest) (*types.CreateOnDutyGroupResponse, error) {
+	group := o.domain.BuildOnDutyGroupModel(req.Group)
+	if err := o.domain.CreateOnDutyGroup(ctx, group); err != nil {
+		o.Logger.Errorf("创建值班组失败: %v", err)
+		return nil, err
+	}
+
+	o.Logger.Infof("创建值班组成功: %+v", group)
+
+	return &types.CreateOnDutyGroupResponse{
+		Code:    0,
+		Message: "创建值班组成功",
+	}, nil
+}
+
+func (o *OnDutyLogic) UpdateOnDutyGroup(ctx context.Context, req *types.UpdateOnDutyGroupRequest) (*types.UpdateOnDutyGroupResponse, error) {
+	group := o.domain.BuildOnDutyGroupModel(req.Group)
+	if err := o.domain.UpdateOnDutyGroup(ctx, group); err != nil {
+		o.Logger.Errorf("更新值班组失败: %v", err)
+		return nil, err
+	}
+
+	o.Logger.Infof("更新值班组成功: %+v", group)
+
+	return &types.UpdateOnDutyGroupResponse{
+		Code:    0,
+		Message: "更新值班组成功",
+	}, nil
+}
+
+func (o *OnDutyLogic) DeleteOnDutyGroup(ctx context.Context, req *types.DeleteOnDutyGroupRequest) (*types.DeleteOnDutyGroupResponse, error) {
+	if err := o.domain.DeleteOnDutyGroup(ctx, req.Id); err != nil {
+		o.Logger.Errorf("删除值班组失败: %v", err)
+		return nil, err
+	}
+
+	o.Logger.Infof("删除值班组成功: %d", req.Id)
+
+	return &types.DeleteOnDutyGroupResponse{
+		Code:    0,
+		Message: "删除值班组成功",
+	}, nil
+}
+
+func (o *OnDutyLogic) CreateOnDutyOverride(ctx context.Context, req *types.CreateOnDutyOverrideRequest) (*types.CreateOnDutyOverrideResponse, error) {
+	override := o.domain.BuildOnDutyOverrideModel(req.Override)
+	if err := o.domain.CreateOnDutyOverride(ctx, override); err != nil {
+		o.Logger.Errorf("创建替班记录失败: %v", err)
+		return nil, err
+	}
+
+	o.Logger.Infof("创建替班记录成功: %+v", override)
+
+	return &types.CreateOnDutyOverrideResponse{
+		Code:    0,
+		Message: "创建替班记录成功",
+	}, nil
+}
+
+func (o *OnDutyLogic) DeleteOnDutyOverride(ctx context.Context, req *types.DeleteOnDutyOverrideRequest) (*types.DeleteOnDutyOverrideResponse, error) {
+	if err := o.domain.DeleteOnDutyOverride(ctx, req.Id); err != nil {
+		o.Logger.Errorf("删除替班记录失败: %v", err)
+		return nil, err
+	}
+
+	o.Logger.Infof("删除替班记录成功: %d", req.Id)
+
+	return &types.DeleteOnDutyOverrideResponse{
+		Code:    0,
+		Message: "删除替班记录成功",
+	}, nil
+}
+
+func (o *OnDutyLogic) SwapOnDutyShifts(ctx context.Context, req *types.SwapOnDutyShiftsRequest) (*types.SwapOnDutyShiftsResponse, error) {
+	overrides, err := o.domain.SwapOnDutyShifts(ctx, req.GroupId, time.Unix(req.FirstTime, 0), time.Unix(req.SecondTime, 0), req.UserId, req.Reason)
+	if err != nil {
+		o.Logger.Errorf("换班失败: %v", err)
+		return nil, err
+	}
+
+	o.Logger.Infof("换班成功: group=%d, first=%d, second=%d", req.GroupId, req.FirstTime, req.SecondTime)
+
+	return &types.SwapOnDutyShiftsResponse{
+		Code:    0,
+		Message: "换班成功",
+		Data:    o.domain.BuildOnDutyOverrideRespModel(overrides),
+	}, nil
+}
+
+// GetOnCallUser 获取值班组在指定时间的值班人，无人值班时 data 为空
+func (o *OnDutyLogic) GetOnCallUser(ctx context.Context, req *types.GetOnCallUserRequest) (*types.GetOnCallUserResponse, error) {
+	t := time.Now()
+	if req.Time > 0 {
+		t = time.Unix(req.Time, 0)
+	}
+
+	shift, ok, err := o.domain.GetOnCall(ctx, req.GroupId, t)
+	if err != nil {
+		o.Logger.Errorf("获取值班人失败: %v", err)
+		return nil, err
+	}
+
+	resp := &types.GetOnCallUserResponse{
+		Code:    0,
+		Message: "获取值班人成功",
+	}
+	if ok {
+		resp.Data = o.domain.BuildOnDutyShiftRespModel(shift)
+	}
+	return resp, nil
+}
+
+func (o *OnDutyLogic) ListOnDutyShifts(ctx context.Context, req *types.ListOnDutyShiftsRequest) (*types.ListOnDutyShiftsResponse, error) {
+	shifts, err := o.domain.ListOnDutyShifts(ctx, req.GroupId, time.Unix(req.StartTime, 0), time.Unix(req.EndTime, 0))
+	if err != nil {
+		o.Logger.Errorf("获取值班表失败: %v", err)
+		return nil, err
+	}
+
+	data := make([]*types.OnDutyShift, 0, len(shifts))
+	for _, shift := range shifts {
+		data = append(data, o.domain.BuildOnDutyShiftRespModel(shift))
+	}
+
+	return &types.ListOnDutyShiftsResponse{
+		Code:    0,
+		Message: "获取值班表成功",
+		Data:    data,
+	}, nil
+}
@@ -47,6 +47,9 @@ func (r *RecordRuleLogic) CreateRecordRule(ctx context.Context, req *types.Creat
 		return nil, err
 	}
 
+	// 通知重新生成监控配置
+	r.svcCtx.ConfigSyncer.Notify("创建预聚合规则")
+
 	return &types.CreateRecordRuleResponse{
 		Code:    0,
 		Message: "创建预聚合规则成功",
@@ -61,6 +64,9 @@ func (r *RecordRuleLogic) UpdateRecordRule(ctx context.Context, req *types.Updat
 		return nil, err
 	}
 
+	// 通知重新生成监控配置
+	r.svcCtx.ConfigSyncer.Notify("更新预聚合规则")
+
 	return &types.UpdateRecordRuleResponse{
 		Code:    0,
 		Message: "更新预聚合规则成功",
@@ -74,6 +80,9 @@ func (r *RecordRuleLogic) DeleteRecordRule(ctx context.Context, req *types.Delet
 		return nil, err
 	}
 
+	// 通知重新生成监控配置
+	r.svcCtx.ConfigSyncer.Notify("删除预聚合规则")
+
 	return &types.DeleteRecordRuleResponse{
 		Code:    0,
 		Message: "删除预聚合规则成功",
@@ -87,6 +96,9 @@ func (r *RecordRuleLogic) BatchDeleteRecordRule(ctx context.Context, req *types.
 		return nil, err
 	}
 
+	// 通知重新生成监控配置
+	r.svcCtx.ConfigSyncer.Notify("批量删除预聚合规则")
+
 	return &types.BatchDeleteRecordRuleResponse{
 		Code:    0,
 		Message: "批量删除预聚合规则成功",
@@ -100,6 +112,9 @@ func (r *RecordRuleLogic) EnableSwitchRecordRule(ctx context.Context, req *types
 		return nil, err
 	}
 
+	// 通知重新生成监控配置
+	r.svcCtx.ConfigSyncer.Notify("启用或禁用预聚合规则")
+
 	return &types.EnableSwitchRecordRuleResponse{
 		Code:    0,
 		Message: "启用或禁用预聚合规则成功",
@@ -113,6 +128,9 @@ func (r *RecordRuleLogic) BatchEnableSwitchRecordRule(ctx context.Context, req *
 		return nil, err
 	}
 
+	// 通知重新生成监控配置
+	r.svcCtx.ConfigSyncer.Notify("批量启用或禁用预聚合规则")
+
 	return &types.BatchEnableSwitchRecordRuleResponse{
 		Code:    0,
 		Message: "批量启用或禁用预聚合规则成功",
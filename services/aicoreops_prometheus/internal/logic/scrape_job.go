@@ -47,7 +47,8 @@ func (s *ScrapeJobLogic) CreateMonitorScrapeJob(ctx context.Context, req *types.
 		return nil, err
 	}
 
-	// TODO: 更新 Prometheus 配置
+	// 通知重新生成监控配置
+	s.svcCtx.ConfigSyncer.Notify("创建 ScrapeJob ")
 
 	s.Logger.Infof("创建 ScrapeJob 成功: %+v", job)
 
@@ -65,7 +66,8 @@ func (s *ScrapeJobLogic) UpdateMonitorScrapeJob(ctx context.Context, req *types.
 		return nil, err
 	}
 
-	// TODO: 更新 Prometheus 配置
+	// 通知重新生成监控配置
+	s.svcCtx.ConfigSyncer.Notify("更新 ScrapeJob ")
 
 	s.Logger.Infof("更新 ScrapeJob 成功: %+v", job)
 
@@ -82,7 +84,8 @@ func (s *ScrapeJobLogic) DeleteMonitorScrapeJob(ctx context.Context, req *types.
 		return nil, err
 	}
 
-	// TODO: 更新 Prometheus 配置
+	// 通知重新生成监控配置
+	s.svcCtx.ConfigSyncer.Notify("删除 ScrapeJob ")
 
 	s.Logger.Infof("删除 ScrapeJob 成功: %d", req.Id)
 
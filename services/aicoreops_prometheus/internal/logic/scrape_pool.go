@@ -49,7 +49,8 @@ func (s *ScrapePoolLogic) CreateMonitorScrapePool(req *types.CreateMonitorScrape
 		return nil, err
 	}
 
-	// 更新缓存
+	// 通知重新生成监控配置
+	s.svcCtx.ConfigSyncer.Notify("创建采集池")
 
 	s.Logger.Infof("创建采集池成功: %v", pool)
 
@@ -67,7 +68,8 @@ func (s *ScrapePoolLogic) UpdateMonitorScrapePool(req *types.UpdateMonitorScrape
 		return nil, err
 	}
 
-	// 更新缓存
+	// 通知重新生成监控配置
+	s.svcCtx.ConfigSyncer.Notify("更新采集池")
 
 	s.Logger.Infof("更新采集池成功: %v", pool)
 
@@ -84,7 +86,8 @@ func (s *ScrapePoolLogic) DeleteMonitorScrapePool(req *types.DeleteMonitorScrape
 		return nil, err
 	}
 
-	// 更新缓存
+	// 通知重新生成监控配置
+	s.svcCtx.ConfigSyncer.Notify("删除采集池")
 
 	s.Logger.Infof("删除采集池成功: %v", req.Id)
 
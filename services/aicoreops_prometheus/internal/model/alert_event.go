@@ -2,6 +2,21 @@ package model
 
 import "github.com/prometheus/alertmanager/template"
 
+// 告警事件状态
+const (
+	AlertEventStatusFiring   = "firing"   // 告警中
+	AlertEventStatusSilenced = "silenced" // 已屏蔽
+	AlertEventStatusClaimed  = "claimed"  // 已认领
+	AlertEventStatusResolved = "resolved" // 已恢复
+)
+
+// 生成告警规则时附加的标签，AlertManager 按发送组路由，webhook 据此关联告警规则和发送组
+const (
+	AlertRuleIDLabel    = "alert_rule_id"
+	AlertSendGroupLabel = "alert_send_group"
+	AlertSeverityLabel  = "severity"
+)
+
 // MonitorAlertEvent 告警事件与相关实体的关系
 type MonitorAlertEvent struct {
 	ID            int        `json:"id" gorm:"primaryKey;autoIncrement;comment:告警事件ID"`
@@ -14,6 +29,12 @@ type MonitorAlertEvent struct {
 	SilenceID     string     `json:"silenceId,omitempty" gorm:"size:100;comment:AlertManager返回的静默ID"`
 	RenLingUserID int        `json:"renLingUserId" gorm:"comment:认领告警的用户ID"`
 	Labels        StringList `json:"labels,omitempty" gorm:"type:text;comment:标签组，格式为 key=v"`
+	Annotations   StringList `json:"annotations,omitempty" gorm:"type:text;comment:注解，格式为 key=v"`
+	Severity      string     `json:"severity,omitempty" gorm:"size:50;index;comment:告警级别"`
+	TreeNodeID    int64      `json:"treeNodeId" gorm:"index;comment:告警规则绑定的树节点ID"`
+	StartsAt      int64      `json:"startsAt" gorm:"index;comment:本次触发的开始时间"`
+	EndsAt        int64      `json:"endsAt" gorm:"comment:本次触发的恢复时间，未恢复为0"`
+	OnDutyUserID  int64      `json:"onDutyUserId" gorm:"index;comment:本次触发时发送组值班组的值班人，即告警通知的接收人"`
 	CreateTime    int64      `gorm:"column:create_time;type:int;autoCreateTime" json:"create_time"` // 创建时间
 	UpdateTime    int64      `gorm:"column:update_time;type:int;autoUpdateTime" json:"update_time"` // 更新时间
 	IsDeleted     int        `gorm:"column:is_deleted;type:tinyint;default:0" json:"is_deleted"`    // 软删除标志（0:否, 1:是）
@@ -33,3 +54,25 @@ type MonitorAlertEvent struct {
 func (MonitorAlertEvent) TableName() string {
 	return "monitor_alert_event"
 }
+
+// 告警事件处理动作
+const (
+	AlertEventActionClaim     = "claim"
+	AlertEventActionSilence   = "silence"
+	AlertEventActionUnsilence = "unsilence"
+	AlertEventActionResolve   = "resolve"
+)
+
+// MonitorAlertEventTimeline 告警事件的处理记录，认领、屏蔽等操作每个事件一条
+type MonitorAlertEventTimeline struct {
+	ID         int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:记录ID"`
+	EventID    int    `json:"eventId" gorm:"index;comment:告警事件ID"`
+	Action     string `json:"action" gorm:"size:50;comment:处理动作，如claim、silence、unsilence、resolve"`
+	UserID     int64  `json:"userId" gorm:"comment:操作人ID"`
+	Detail     string `json:"detail,omitempty" gorm:"type:text;comment:操作详情，如静默ID、时长、备注"`
+	CreateTime int64  `gorm:"column:create_time;type:int;autoCreateTime" json:"create_time"` // 创建时间
+}
+
+func (MonitorAlertEventTimeline) TableName() string {
+	return "monitor_alert_event_timeline"
+}
@@ -2,6 +2,8 @@ package model
 
 import (
 	"database/sql/driver"
+	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -19,3 +21,34 @@ func (m StringList) Value() (driver.Value, error) {
 	str := strings.Join(m, "|")
 	return str, nil
 }
+
+// Int64List 封装了 []int64 类型，用于与数据库中的逗号分隔字符串进行转换
+type Int64List []int64
+
+func (m *Int64List) Scan(val interface{}) error {
+	s, ok := val.([]uint8)
+	if !ok {
+		return fmt.Errorf("Int64List 不支持的类型: %T", val)
+	}
+
+	*m = nil
+	if len(s) == 0 {
+		return nil
+	}
+	for _, item := range strings.Split(string(s), ",") {
+		n, err := strconv.ParseInt(item, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Int64List 解析 %q 失败: %w", item, err)
+		}
+		*m = append(*m, n)
+	}
+	return nil
+}
+
+func (m Int64List) Value() (driver.Value, error) {
+	items := make([]string, 0, len(m))
+	for _, n := range m {
+		items = append(items, strconv.FormatInt(n, 10))
+	}
+	return strings.Join(items, ","), nil
+}
@@ -0,0 +1,42 @@
+package model
+
+// 值班轮换周期
+const (
+	OnDutyRotationDaily  = "daily"  // 每天交接班
+	OnDutyRotationWeekly = "weekly" // 每周交接班
+)
+
+// MonitorOnDutyGroup 值班组，成员按顺序轮流值班，班次在交接班时间切换
+type MonitorOnDutyGroup struct {
+	ID             int64     `json:"id" gorm:"primaryKey;autoIncrement;comment:值班组ID"`
+	Name           string    `json:"name" binding:"required,min=1,max=50" gorm:"uniqueIndex;size:100;comment:值班组名称"`
+	UserID         int64     `json:"userId" gorm:"comment:创建该值班组的用户ID"`
+	Members        Int64List `json:"members" gorm:"type:text;comment:值班成员用户ID，按轮换顺序排列"`
+	RotationPeriod string    `json:"rotationPeriod" gorm:"size:20;comment:轮换周期：daily、weekly"`
+	StartDate      string    `json:"startDate" gorm:"size:20;comment:第一个班次的日期，格式为 2006-01-02，由第一个成员值班"`
+	HandoverTime   string    `json:"handoverTime" gorm:"size:10;comment:交接班时间，格式为 15:04，使用服务所在时区"`
+	CreateTime     int64     `gorm:"column:create_time;type:int;autoCreateTime" json:"create_time"` // 创建时间
+	UpdateTime     int64     `gorm:"column:update_time;type:int;autoUpdateTime" json:"update_time"` // 更新时间
+	IsDeleted      int       `gorm:"column:is_deleted;type:tinyint;default:0" json:"is_deleted"`    // 软删除标志（0:否, 1:是）
+}
+
+func (MonitorOnDutyGroup) TableName() string {
+	return "monitor_on_duty_group"
+}
+
+// MonitorOnDutyOverride 临时替班，[StartTime, EndTime) 内由 UserID 代替轮换值班人值班；换班记录为两条替班
+type MonitorOnDutyOverride struct {
+	ID           int64  `json:"id" gorm:"primaryKey;autoIncrement;comment:替班记录ID"`
+	GroupID      int64  `json:"groupId" gorm:"index;comment:值班组ID"`
+	UserID       int64  `json:"userId" gorm:"comment:替班人用户ID"`
+	OriginUserID int64  `json:"originUserId" gorm:"comment:被替班的值班人用户ID，仅用于展示"`
+	StartTime    int64  `json:"startTime" gorm:"index;comment:替班开始时间"`
+	EndTime      int64  `json:"endTime" gorm:"index;comment:替班结束时间"`
+	Reason       string `json:"reason,omitempty" gorm:"size:255;comment:替班原因"`
+	CreateUserID int64  `json:"createUserId" gorm:"comment:创建替班记录的用户ID"`
+	CreateTime   int64  `gorm:"column:create_time;type:int;autoCreateTime" json:"create_time"` // 创建时间
+}
+
+func (MonitorOnDutyOverride) TableName() string {
+	return "monitor_on_duty_override"
+}
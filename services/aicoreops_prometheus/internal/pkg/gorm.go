@@ -50,7 +50,10 @@ func InitTables(db *gorm.DB) error {
 		model.MonitorAlertManagerPool{},
 		model.AlertRule{},
 		model.MonitorAlertEvent{},
+		model.MonitorAlertEventTimeline{},
 		model.MonitorSendGroup{},
+		model.MonitorOnDutyGroup{},
+		model.MonitorOnDutyOverride{},
 		model.MonitorRecordRule{},
 	)
 }
@@ -168,24 +168,68 @@ func SendSilenceRequest(ctx context.Context, l *zap.Logger, url string, data []b
 		return "", fmt.Errorf("AlertManager request failed, status: %d, response: %s", resp.StatusCode, string(body))
 	}
 
-	// 解析响应
+	// 解析响应，AlertManager v2 API 返回 {"silenceID": "..."}
 	var result struct {
-		Status string `json:"status"`
-		Data   struct {
-			ID string `json:"id"`
-		} `json:"data"`
+		SilenceID string `json:"silenceID"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		l.Error("sendSilenceRequest failed: decode response error", zap.Error(err))
 		return "", err
 	}
 
-	if result.Status != "success" {
-		l.Error("sendSilenceRequest failed: AlertManager status not success", zap.String("status", result.Status))
-		return "", fmt.Errorf("AlertManager status not success, status: %s", result.Status)
+	if result.SilenceID == "" {
+		l.Error("sendSilenceRequest failed: AlertManager response without silenceID")
+		return "", fmt.Errorf("AlertManager response without silenceID")
 	}
 
-	return result.Data.ID, nil
+	return result.SilenceID, nil
+}
+
+// Silence AlertManager v2 API 创建静默的请求体
+type Silence struct {
+	Matchers  []*labels.Matcher `json:"matchers"`
+	StartsAt  time.Time         `json:"startsAt"`
+	EndsAt    time.Time         `json:"endsAt"`
+	CreatedBy string            `json:"createdBy"`
+	Comment   string            `json:"comment"`
+}
+
+// AlertManagerURL 将 AlertManager 实例地址补全为 URL，未指定协议和端口时使用 http 和默认端口 9093
+func AlertManagerURL(instance string) string {
+	if !strings.Contains(instance, "://") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err == nil && u.Port() == "" {
+		u.Host += ":9093"
+		return u.String()
+	}
+	return instance
+}
+
+// DeleteSilenceRequest 使静默失效，静默不存在（404）时视为已解除
+func DeleteSilenceRequest(ctx context.Context, l *zap.Logger, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		l.Error("deleteSilenceRequest failed: create HTTP request error", zap.Error(err))
+		return err
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		l.Error("deleteSilenceRequest failed: send HTTP request error", zap.Error(err))
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		l.Error("deleteSilenceRequest failed: AlertManager response error", zap.Int("status", resp.StatusCode), zap.String("body", string(body)))
+		return fmt.Errorf("AlertManager request failed, status: %d, response: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
 }
 
 func FromSliceTuMap(kvs []string) map[string]string {
@@ -0,0 +1,33 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+)
+
+// AlertEventFilter 告警事件查询条件，零值表示不过滤
+type AlertEventFilter struct {
+	Status      string
+	Severity    string
+	SendGroupID int64
+	TreeNodeID  int64
+	// OnDutyUserID 告警触发时解析出的通知接收人
+	OnDutyUserID int64
+	// StartTime、EndTime 按触发开始时间过滤，Unix 秒
+	StartTime int64
+	EndTime   int64
+	Offset    int
+	Limit     int
+}
+
+// AlertEventRepo 告警事件Repo
+type AlertEventRepo interface {
+	// ApplyAlertEvent 在事务中锁定指纹对应的告警事件（不存在时为只有指纹的新事件）并交给 apply 修改，apply 返回 false 时不写入
+	ApplyAlertEvent(ctx context.Context, fingerprint string, apply func(event *model.MonitorAlertEvent) bool) error
+	ListAlertEvents(ctx context.Context, filter AlertEventFilter) ([]*model.MonitorAlertEvent, int64, error)
+	GetAlertEventById(ctx context.Context, id int) (*model.MonitorAlertEvent, error)
+	// UpdateAlertEvent 更新告警事件的字段并写入处理记录
+	UpdateAlertEvent(ctx context.Context, id int, fields map[string]any, timeline *model.MonitorAlertEventTimeline) error
+	ListAlertEventTimeline(ctx context.Context, eventID int) ([]*model.MonitorAlertEventTimeline, error)
+}
@@ -11,6 +11,7 @@ type MonitorAlterManagerPoolRepo interface {
 	CreateMonitorAlertManagerPool(ctx context.Context, pool *model.MonitorAlertManagerPool) error
 	SearchMonitorAlertManagerPoolByName(ctx context.Context, name string) ([]*model.MonitorAlertManagerPool, error)
 	GetMonitorAlertManagerPoolList(ctx context.Context) ([]*model.MonitorAlertManagerPool, error)
+	GetMonitorAlertManagerPoolById(ctx context.Context, id int64) (*model.MonitorAlertManagerPool, error)
 	UpdateMonitorAlertManagerPool(ctx context.Context, pool *model.MonitorAlertManagerPool) error
 	DeleteMonitorAlertManagerPool(ctx context.Context, poolId int64) error
 	CheckMonitorAlertManagerPoolExist(ctx context.Context, name string) (bool, error)
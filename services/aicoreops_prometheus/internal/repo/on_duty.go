@@ -0,0 +1,25 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/model"
+)
+
+// OnDutyRepo 值班组Repo
+type OnDutyRepo interface {
+	CreateOnDutyGroup(ctx context.Context, group *model.MonitorOnDutyGroup) error
+	UpdateOnDutyGroup(ctx context.Context, group *model.MonitorOnDutyGroup) error
+	// DeleteOnDutyGroup 删除值班组及其替班记录
+	DeleteOnDutyGroup(ctx context.Context, id int64) error
+	GetOnDutyGroupById(ctx context.Context, id int64) (*model.MonitorOnDutyGroup, error)
+	GetOnDutyGroupList(ctx context.Context) ([]*model.MonitorOnDutyGroup, error)
+	SearchOnDutyGroupByName(ctx context.Context, name string) ([]*model.MonitorOnDutyGroup, error)
+	CheckOnDutyGroupExists(ctx context.Context, name string) (bool, error)
+
+	// CreateOnDutyOverrides 在一个事务中创建替班记录
+	CreateOnDutyOverrides(ctx context.Context, overrides ...*model.MonitorOnDutyOverride) error
+	DeleteOnDutyOverride(ctx context.Context, id int64) error
+	// ListOnDutyOverrides 获取与 [start, end) 有重叠的替班记录
+	ListOnDutyOverrides(ctx context.Context, groupID, start, end int64) ([]*model.MonitorOnDutyOverride, error)
+}
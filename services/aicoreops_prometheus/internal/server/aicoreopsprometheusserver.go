@@ -6,15 +6,11 @@ import (
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/logic"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/types"
-	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/logic"
-	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
-	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/types"
 )
 
 type AicoreopsPrometheusServer struct {
 	svcCtx *svc.ServiceContext
 	types.UnimplementedPrometheusRpcServer
-	types.UnimplementedPrometheusRpcServer
 }
 
 func NewAicoreopsPrometheusServer(svcCtx *svc.ServiceContext) *AicoreopsPrometheusServer {
@@ -162,3 +158,91 @@ func (s *AicoreopsPrometheusServer) BatchEnableSwitchRecordRule(ctx context.Cont
 	l := logic.NewRecordRuleLogic(ctx, s.svcCtx)
 	return l.BatchEnableSwitchRecordRule(ctx, req)
 }
+
+// 配置文件
+func (s *AicoreopsPrometheusServer) GetConfigSyncStatus(ctx context.Context, req *types.GetConfigSyncStatusRequest) (*types.GetConfigSyncStatusResponse, error) {
+	l := logic.NewConfigSyncLogic(ctx, s.svcCtx)
+	return l.GetConfigSyncStatus(ctx)
+}
+
+// 告警事件
+func (s *AicoreopsPrometheusServer) ListAlertEvents(ctx context.Context, req *types.ListAlertEventsRequest) (*types.ListAlertEventsResponse, error) {
+	l := logic.NewAlertEventLogic(ctx, s.svcCtx)
+	return l.ListAlertEvents(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) ClaimAlertEvent(ctx context.Context, req *types.ClaimAlertEventRequest) (*types.ClaimAlertEventResponse, error) {
+	l := logic.NewAlertEventLogic(ctx, s.svcCtx)
+	return l.ClaimAlertEvent(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) SilenceAlertEvent(ctx context.Context, req *types.SilenceAlertEventRequest) (*types.SilenceAlertEventResponse, error) {
+	l := logic.NewAlertEventLogic(ctx, s.svcCtx)
+	return l.SilenceAlertEvent(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) UnsilenceAlertEvent(ctx context.Context, req *types.UnsilenceAlertEventRequest) (*types.UnsilenceAlertEventResponse, error) {
+	l := logic.NewAlertEventLogic(ctx, s.svcCtx)
+	return l.UnsilenceAlertEvent(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) BatchSilence(ctx context.Context, req *types.BatchSilenceRequest) (*types.BatchSilenceResponse, error) {
+	l := logic.NewAlertEventLogic(ctx, s.svcCtx)
+	return l.BatchSilence(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) ResolveAlertEvent(ctx context.Context, req *types.ResolveAlertEventRequest) (*types.ResolveAlertEventResponse, error) {
+	l := logic.NewAlertEventLogic(ctx, s.svcCtx)
+	return l.ResolveAlertEvent(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) GetAlertEventTimeline(ctx context.Context, req *types.GetAlertEventTimelineRequest) (*types.GetAlertEventTimelineResponse, error) {
+	l := logic.NewAlertEventLogic(ctx, s.svcCtx)
+	return l.GetAlertEventTimeline(ctx, req)
+}
+
+// 值班组
+func (s *AicoreopsPrometheusServer) GetOnDutyGroupList(ctx context.Context, req *types.GetOnDutyGroupListRequest) (*types.GetOnDutyGroupListResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.GetOnDutyGroupList(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) CreateOnDutyGroup(ctx context.Context, req *types.CreateOnDutyGroupRequest) (*types.CreateOnDutyGroupResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.CreateOnDutyGroup(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) UpdateOnDutyGroup(ctx context.Context, req *types.UpdateOnDutyGroupRequest) (*types.UpdateOnDutyGroupResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.UpdateOnDutyGroup(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) DeleteOnDutyGroup(ctx context.Context, req *types.DeleteOnDutyGroupRequest) (*types.DeleteOnDutyGroupResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.DeleteOnDutyGroup(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) CreateOnDutyOverride(ctx context.Context, req *types.CreateOnDutyOverrideRequest) (*types.CreateOnDutyOverrideResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.CreateOnDutyOverride(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) DeleteOnDutyOverride(ctx context.Context, req *types.DeleteOnDutyOverrideRequest) (*types.DeleteOnDutyOverrideResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.DeleteOnDutyOverride(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) SwapOnDutyShifts(ctx context.Context, req *types.SwapOnDutyShiftsRequest) (*types.SwapOnDutyShiftsResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.SwapOnDutyShifts(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) GetOnCallUser(ctx context.Context, req *types.GetOnCallUserRequest) (*types.GetOnCallUserResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.GetOnCallUser(ctx, req)
+}
+
+func (s *AicoreopsPrometheusServer) ListOnDutyShifts(ctx context.Context, req *types.ListOnDutyShiftsRequest) (*types.ListOnDutyShiftsResponse, error) {
+	l := logic.NewOnDutyLogic(ctx, s.svcCtx)
+	return l.ListOnDutyShifts(ctx, req)
+}
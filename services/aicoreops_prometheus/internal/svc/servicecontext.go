@@ -1,6 +1,10 @@
 package svc
 
 import (
+	"context"
+	"time"
+
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/cache"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/config"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/pkg"
 	"github.com/redis/go-redis/v9"
@@ -11,15 +15,25 @@ type ServiceContext struct {
 	Config config.Config
 	DB     *gorm.DB
 	Redis  redis.Cmdable
+	// MonitorCache 按IP缓存生成的 Prometheus、AlertManager 配置
+	MonitorCache cache.MonitorCache
+	// ConfigSyncer 定时及配置变更后重新生成 MonitorCache
+	ConfigSyncer *cache.ConfigSyncer
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	db := pkg.InitDB(c.Mysql)
 
 	redis := pkg.InitRedis(c.XRedis)
+	monitorCache := cache.NewMonitorCache(context.Background(), db, &c)
 	return &ServiceContext{
-		Config: c,
-		DB:     db,
-		Redis:  redis,
+		Config:       c,
+		DB:           db,
+		Redis:        redis,
+		MonitorCache: monitorCache,
+		ConfigSyncer: cache.NewConfigSyncer(monitorCache,
+			time.Duration(c.ConfigSync.Interval)*time.Second,
+			time.Duration(c.ConfigSync.Debounce)*time.Second,
+		),
 	}
 }
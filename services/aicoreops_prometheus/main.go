@@ -5,12 +5,14 @@ import (
 	"fmt"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/config"
+	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/handler"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/server"
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/internal/svc"
 
 	"github.com/GoSimplicity/AICoreOps/services/aicoreops_prometheus/types"
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -32,8 +34,18 @@ func main() {
 			reflection.Register(grpcServer)
 		}
 	})
-	defer s.Stop()
 
-	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
-	s.Start()
+	// 配置下发接口、告警 webhook、配置同步与 rpc 服务一起启动
+	httpServer := rest.MustNewServer(c.HttpServer)
+	handler.NewConfigHandler(ctx).RegisterRoutes(httpServer)
+	handler.NewAlertWebhookHandler(ctx).RegisterRoutes(httpServer)
+
+	group := service.NewServiceGroup()
+	group.Add(s)
+	group.Add(httpServer)
+	group.Add(ctx.ConfigSyncer)
+	defer group.Stop()
+
+	fmt.Printf("Starting rpc server at %s, http server at %s:%d...\n", c.ListenOn, c.HttpServer.Host, c.HttpServer.Port)
+	group.Start()
 }
@@ -46,6 +46,23 @@ const (
 	PrometheusRpc_EnableSwitchRecordRule_FullMethodName         = "/prometheus_rpc.Prometheus_rpc/EnableSwitchRecordRule"
 	PrometheusRpc_BatchEnableSwitchRecordRule_FullMethodName    = "/prometheus_rpc.Prometheus_rpc/BatchEnableSwitchRecordRule"
 	PrometheusRpc_BatchDeleteRecordRule_FullMethodName          = "/prometheus_rpc.Prometheus_rpc/BatchDeleteRecordRule"
+	PrometheusRpc_GetConfigSyncStatus_FullMethodName            = "/prometheus_rpc.Prometheus_rpc/GetConfigSyncStatus"
+	PrometheusRpc_ListAlertEvents_FullMethodName                = "/prometheus_rpc.Prometheus_rpc/ListAlertEvents"
+	PrometheusRpc_ClaimAlertEvent_FullMethodName                = "/prometheus_rpc.Prometheus_rpc/ClaimAlertEvent"
+	PrometheusRpc_SilenceAlertEvent_FullMethodName              = "/prometheus_rpc.Prometheus_rpc/SilenceAlertEvent"
+	PrometheusRpc_UnsilenceAlertEvent_FullMethodName            = "/prometheus_rpc.Prometheus_rpc/UnsilenceAlertEvent"
+	PrometheusRpc_BatchSilence_FullMethodName                   = "/prometheus_rpc.Prometheus_rpc/BatchSilence"
+	PrometheusRpc_ResolveAlertEvent_FullMethodName              = "/prometheus_rpc.Prometheus_rpc/ResolveAlertEvent"
+	PrometheusRpc_GetAlertEventTimeline_FullMethodName          = "/prometheus_rpc.Prometheus_rpc/GetAlertEventTimeline"
+	PrometheusRpc_GetOnDutyGroupList_FullMethodName             = "/prometheus_rpc.Prometheus_rpc/GetOnDutyGroupList"
+	PrometheusRpc_CreateOnDutyGroup_FullMethodName              = "/prometheus_rpc.Prometheus_rpc/CreateOnDutyGroup"
+	PrometheusRpc_UpdateOnDutyGroup_FullMethodName              = "/prometheus_rpc.Prometheus_rpc/UpdateOnDutyGroup"
+	PrometheusRpc_DeleteOnDutyGroup_FullMethodName              = "/prometheus_rpc.Prometheus_rpc/DeleteOnDutyGroup"
+	PrometheusRpc_CreateOnDutyOverride_FullMethodName           = "/prometheus_rpc.Prometheus_rpc/CreateOnDutyOverride"
+	PrometheusRpc_DeleteOnDutyOverride_FullMethodName           = "/prometheus_rpc.Prometheus_rpc/DeleteOnDutyOverride"
+	PrometheusRpc_SwapOnDutyShifts_FullMethodName               = "/prometheus_rpc.Prometheus_rpc/SwapOnDutyShifts"
+	PrometheusRpc_GetOnCallUser_FullMethodName                  = "/prometheus_rpc.Prometheus_rpc/GetOnCallUser"
+	PrometheusRpc_ListOnDutyShifts_FullMethodName               = "/prometheus_rpc.Prometheus_rpc/ListOnDutyShifts"
 )
 
 // PrometheusRpcClient is the client API for PrometheusRpc service.
@@ -84,6 +101,26 @@ type PrometheusRpcClient interface {
 	EnableSwitchRecordRule(ctx context.Context, in *EnableSwitchRecordRuleRequest, opts ...grpc.CallOption) (*EnableSwitchRecordRuleResponse, error)
 	BatchEnableSwitchRecordRule(ctx context.Context, in *BatchEnableSwitchRecordRuleRequest, opts ...grpc.CallOption) (*BatchEnableSwitchRecordRuleResponse, error)
 	BatchDeleteRecordRule(ctx context.Context, in *BatchDeleteRecordRuleRequest, opts ...grpc.CallOption) (*BatchDeleteRecordRuleResponse, error)
+	// 配置文件
+	GetConfigSyncStatus(ctx context.Context, in *GetConfigSyncStatusRequest, opts ...grpc.CallOption) (*GetConfigSyncStatusResponse, error)
+	// 告警事件
+	ListAlertEvents(ctx context.Context, in *ListAlertEventsRequest, opts ...grpc.CallOption) (*ListAlertEventsResponse, error)
+	ClaimAlertEvent(ctx context.Context, in *ClaimAlertEventRequest, opts ...grpc.CallOption) (*ClaimAlertEventResponse, error)
+	SilenceAlertEvent(ctx context.Context, in *SilenceAlertEventRequest, opts ...grpc.CallOption) (*SilenceAlertEventResponse, error)
+	UnsilenceAlertEvent(ctx context.Context, in *UnsilenceAlertEventRequest, opts ...grpc.CallOption) (*UnsilenceAlertEventResponse, error)
+	BatchSilence(ctx context.Context, in *BatchSilenceRequest, opts ...grpc.CallOption) (*BatchSilenceResponse, error)
+	ResolveAlertEvent(ctx context.Context, in *ResolveAlertEventRequest, opts ...grpc.CallOption) (*ResolveAlertEventResponse, error)
+	GetAlertEventTimeline(ctx context.Context, in *GetAlertEventTimelineRequest, opts ...grpc.CallOption) (*GetAlertEventTimelineResponse, error)
+	// 值班组
+	GetOnDutyGroupList(ctx context.Context, in *GetOnDutyGroupListRequest, opts ...grpc.CallOption) (*GetOnDutyGroupListResponse, error)
+	CreateOnDutyGroup(ctx context.Context, in *CreateOnDutyGroupRequest, opts ...grpc.CallOption) (*CreateOnDutyGroupResponse, error)
+	UpdateOnDutyGroup(ctx context.Context, in *UpdateOnDutyGroupRequest, opts ...grpc.CallOption) (*UpdateOnDutyGroupResponse, error)
+	DeleteOnDutyGroup(ctx context.Context, in *DeleteOnDutyGroupRequest, opts ...grpc.CallOption) (*DeleteOnDutyGroupResponse, error)
+	CreateOnDutyOverride(ctx context.Context, in *CreateOnDutyOverrideRequest, opts ...grpc.CallOption) (*CreateOnDutyOverrideResponse, error)
+	DeleteOnDutyOverride(ctx context.Context, in *DeleteOnDutyOverrideRequest, opts ...grpc.CallOption) (*DeleteOnDutyOverrideResponse, error)
+	SwapOnDutyShifts(ctx context.Context, in *SwapOnDutyShiftsRequest, opts ...grpc.CallOption) (*SwapOnDutyShiftsResponse, error)
+	GetOnCallUser(ctx context.Context, in *GetOnCallUserRequest, opts ...grpc.CallOption) (*GetOnCallUserResponse, error)
+	ListOnDutyShifts(ctx context.Context, in *ListOnDutyShiftsRequest, opts ...grpc.CallOption) (*ListOnDutyShiftsResponse, error)
 }
 
 type prometheusRpcClient struct {
@@ -364,6 +401,176 @@ func (c *prometheusRpcClient) BatchDeleteRecordRule(ctx context.Context, in *Bat
 	return out, nil
 }
 
+func (c *prometheusRpcClient) GetConfigSyncStatus(ctx context.Context, in *GetConfigSyncStatusRequest, opts ...grpc.CallOption) (*GetConfigSyncStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigSyncStatusResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_GetConfigSyncStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) ListAlertEvents(ctx context.Context, in *ListAlertEventsRequest, opts ...grpc.CallOption) (*ListAlertEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertEventsResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_ListAlertEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) ClaimAlertEvent(ctx context.Context, in *ClaimAlertEventRequest, opts ...grpc.CallOption) (*ClaimAlertEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimAlertEventResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_ClaimAlertEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) SilenceAlertEvent(ctx context.Context, in *SilenceAlertEventRequest, opts ...grpc.CallOption) (*SilenceAlertEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SilenceAlertEventResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_SilenceAlertEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) UnsilenceAlertEvent(ctx context.Context, in *UnsilenceAlertEventRequest, opts ...grpc.CallOption) (*UnsilenceAlertEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsilenceAlertEventResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_UnsilenceAlertEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) BatchSilence(ctx context.Context, in *BatchSilenceRequest, opts ...grpc.CallOption) (*BatchSilenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchSilenceResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_BatchSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) ResolveAlertEvent(ctx context.Context, in *ResolveAlertEventRequest, opts ...grpc.CallOption) (*ResolveAlertEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveAlertEventResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_ResolveAlertEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) GetAlertEventTimeline(ctx context.Context, in *GetAlertEventTimelineRequest, opts ...grpc.CallOption) (*GetAlertEventTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlertEventTimelineResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_GetAlertEventTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) GetOnDutyGroupList(ctx context.Context, in *GetOnDutyGroupListRequest, opts ...grpc.CallOption) (*GetOnDutyGroupListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOnDutyGroupListResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_GetOnDutyGroupList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) CreateOnDutyGroup(ctx context.Context, in *CreateOnDutyGroupRequest, opts ...grpc.CallOption) (*CreateOnDutyGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOnDutyGroupResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_CreateOnDutyGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) UpdateOnDutyGroup(ctx context.Context, in *UpdateOnDutyGroupRequest, opts ...grpc.CallOption) (*UpdateOnDutyGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOnDutyGroupResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_UpdateOnDutyGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) DeleteOnDutyGroup(ctx context.Context, in *DeleteOnDutyGroupRequest, opts ...grpc.CallOption) (*DeleteOnDutyGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOnDutyGroupResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_DeleteOnDutyGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) CreateOnDutyOverride(ctx context.Context, in *CreateOnDutyOverrideRequest, opts ...grpc.CallOption) (*CreateOnDutyOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOnDutyOverrideResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_CreateOnDutyOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) DeleteOnDutyOverride(ctx context.Context, in *DeleteOnDutyOverrideRequest, opts ...grpc.CallOption) (*DeleteOnDutyOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOnDutyOverrideResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_DeleteOnDutyOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) SwapOnDutyShifts(ctx context.Context, in *SwapOnDutyShiftsRequest, opts ...grpc.CallOption) (*SwapOnDutyShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapOnDutyShiftsResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_SwapOnDutyShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) GetOnCallUser(ctx context.Context, in *GetOnCallUserRequest, opts ...grpc.CallOption) (*GetOnCallUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOnCallUserResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_GetOnCallUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *prometheusRpcClient) ListOnDutyShifts(ctx context.Context, in *ListOnDutyShiftsRequest, opts ...grpc.CallOption) (*ListOnDutyShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnDutyShiftsResponse)
+	err := c.cc.Invoke(ctx, PrometheusRpc_ListOnDutyShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PrometheusRpcServer is the server API for PrometheusRpc service.
 // All implementations must embed UnimplementedPrometheusRpcServer
 // for forward compatibility.
@@ -400,6 +607,26 @@ type PrometheusRpcServer interface {
 	EnableSwitchRecordRule(context.Context, *EnableSwitchRecordRuleRequest) (*EnableSwitchRecordRuleResponse, error)
 	BatchEnableSwitchRecordRule(context.Context, *BatchEnableSwitchRecordRuleRequest) (*BatchEnableSwitchRecordRuleResponse, error)
 	BatchDeleteRecordRule(context.Context, *BatchDeleteRecordRuleRequest) (*BatchDeleteRecordRuleResponse, error)
+	// 配置文件
+	GetConfigSyncStatus(context.Context, *GetConfigSyncStatusRequest) (*GetConfigSyncStatusResponse, error)
+	// 告警事件
+	ListAlertEvents(context.Context, *ListAlertEventsRequest) (*ListAlertEventsResponse, error)
+	ClaimAlertEvent(context.Context, *ClaimAlertEventRequest) (*ClaimAlertEventResponse, error)
+	SilenceAlertEvent(context.Context, *SilenceAlertEventRequest) (*SilenceAlertEventResponse, error)
+	UnsilenceAlertEvent(context.Context, *UnsilenceAlertEventRequest) (*UnsilenceAlertEventResponse, error)
+	BatchSilence(context.Context, *BatchSilenceRequest) (*BatchSilenceResponse, error)
+	ResolveAlertEvent(context.Context, *ResolveAlertEventRequest) (*ResolveAlertEventResponse, error)
+	GetAlertEventTimeline(context.Context, *GetAlertEventTimelineRequest) (*GetAlertEventTimelineResponse, error)
+	// 值班组
+	GetOnDutyGroupList(context.Context, *GetOnDutyGroupListRequest) (*GetOnDutyGroupListResponse, error)
+	CreateOnDutyGroup(context.Context, *CreateOnDutyGroupRequest) (*CreateOnDutyGroupResponse, error)
+	UpdateOnDutyGroup(context.Context, *UpdateOnDutyGroupRequest) (*UpdateOnDutyGroupResponse, error)
+	DeleteOnDutyGroup(context.Context, *DeleteOnDutyGroupRequest) (*DeleteOnDutyGroupResponse, error)
+	CreateOnDutyOverride(context.Context, *CreateOnDutyOverrideRequest) (*CreateOnDutyOverrideResponse, error)
+	DeleteOnDutyOverride(context.Context, *DeleteOnDutyOverrideRequest) (*DeleteOnDutyOverrideResponse, error)
+	SwapOnDutyShifts(context.Context, *SwapOnDutyShiftsRequest) (*SwapOnDutyShiftsResponse, error)
+	GetOnCallUser(context.Context, *GetOnCallUserRequest) (*GetOnCallUserResponse, error)
+	ListOnDutyShifts(context.Context, *ListOnDutyShiftsRequest) (*ListOnDutyShiftsResponse, error)
 	mustEmbedUnimplementedPrometheusRpcServer()
 }
 
@@ -491,6 +718,57 @@ func (UnimplementedPrometheusRpcServer) BatchEnableSwitchRecordRule(context.Cont
 func (UnimplementedPrometheusRpcServer) BatchDeleteRecordRule(context.Context, *BatchDeleteRecordRuleRequest) (*BatchDeleteRecordRuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteRecordRule not implemented")
 }
+func (UnimplementedPrometheusRpcServer) GetConfigSyncStatus(context.Context, *GetConfigSyncStatusRequest) (*GetConfigSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigSyncStatus not implemented")
+}
+func (UnimplementedPrometheusRpcServer) ListAlertEvents(context.Context, *ListAlertEventsRequest) (*ListAlertEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertEvents not implemented")
+}
+func (UnimplementedPrometheusRpcServer) ClaimAlertEvent(context.Context, *ClaimAlertEventRequest) (*ClaimAlertEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAlertEvent not implemented")
+}
+func (UnimplementedPrometheusRpcServer) SilenceAlertEvent(context.Context, *SilenceAlertEventRequest) (*SilenceAlertEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SilenceAlertEvent not implemented")
+}
+func (UnimplementedPrometheusRpcServer) UnsilenceAlertEvent(context.Context, *UnsilenceAlertEventRequest) (*UnsilenceAlertEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsilenceAlertEvent not implemented")
+}
+func (UnimplementedPrometheusRpcServer) BatchSilence(context.Context, *BatchSilenceRequest) (*BatchSilenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSilence not implemented")
+}
+func (UnimplementedPrometheusRpcServer) ResolveAlertEvent(context.Context, *ResolveAlertEventRequest) (*ResolveAlertEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAlertEvent not implemented")
+}
+func (UnimplementedPrometheusRpcServer) GetAlertEventTimeline(context.Context, *GetAlertEventTimelineRequest) (*GetAlertEventTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertEventTimeline not implemented")
+}
+func (UnimplementedPrometheusRpcServer) GetOnDutyGroupList(context.Context, *GetOnDutyGroupListRequest) (*GetOnDutyGroupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnDutyGroupList not implemented")
+}
+func (UnimplementedPrometheusRpcServer) CreateOnDutyGroup(context.Context, *CreateOnDutyGroupRequest) (*CreateOnDutyGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOnDutyGroup not implemented")
+}
+func (UnimplementedPrometheusRpcServer) UpdateOnDutyGroup(context.Context, *UpdateOnDutyGroupRequest) (*UpdateOnDutyGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOnDutyGroup not implemented")
+}
+func (UnimplementedPrometheusRpcServer) DeleteOnDutyGroup(context.Context, *DeleteOnDutyGroupRequest) (*DeleteOnDutyGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnDutyGroup not implemented")
+}
+func (UnimplementedPrometheusRpcServer) CreateOnDutyOverride(context.Context, *CreateOnDutyOverrideRequest) (*CreateOnDutyOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOnDutyOverride not implemented")
+}
+func (UnimplementedPrometheusRpcServer) DeleteOnDutyOverride(context.Context, *DeleteOnDutyOverrideRequest) (*DeleteOnDutyOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnDutyOverride not implemented")
+}
+func (UnimplementedPrometheusRpcServer) SwapOnDutyShifts(context.Context, *SwapOnDutyShiftsRequest) (*SwapOnDutyShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapOnDutyShifts not implemented")
+}
+func (UnimplementedPrometheusRpcServer) GetOnCallUser(context.Context, *GetOnCallUserRequest) (*GetOnCallUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnCallUser not implemented")
+}
+func (UnimplementedPrometheusRpcServer) ListOnDutyShifts(context.Context, *ListOnDutyShiftsRequest) (*ListOnDutyShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnDutyShifts not implemented")
+}
 func (UnimplementedPrometheusRpcServer) mustEmbedUnimplementedPrometheusRpcServer() {}
 func (UnimplementedPrometheusRpcServer) testEmbeddedByValue()                       {}
 
@@ -998,6 +1276,312 @@ func _PrometheusRpc_BatchDeleteRecordRule_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PrometheusRpc_GetConfigSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).GetConfigSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_GetConfigSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).GetConfigSyncStatus(ctx, req.(*GetConfigSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_ListAlertEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).ListAlertEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_ListAlertEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).ListAlertEvents(ctx, req.(*ListAlertEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_ClaimAlertEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAlertEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).ClaimAlertEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_ClaimAlertEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).ClaimAlertEvent(ctx, req.(*ClaimAlertEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_SilenceAlertEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SilenceAlertEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).SilenceAlertEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_SilenceAlertEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).SilenceAlertEvent(ctx, req.(*SilenceAlertEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_UnsilenceAlertEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsilenceAlertEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).UnsilenceAlertEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_UnsilenceAlertEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).UnsilenceAlertEvent(ctx, req.(*UnsilenceAlertEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_BatchSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).BatchSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_BatchSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).BatchSilence(ctx, req.(*BatchSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_ResolveAlertEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAlertEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).ResolveAlertEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_ResolveAlertEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).ResolveAlertEvent(ctx, req.(*ResolveAlertEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_GetAlertEventTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertEventTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).GetAlertEventTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_GetAlertEventTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).GetAlertEventTimeline(ctx, req.(*GetAlertEventTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_GetOnDutyGroupList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnDutyGroupListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).GetOnDutyGroupList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_GetOnDutyGroupList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).GetOnDutyGroupList(ctx, req.(*GetOnDutyGroupListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_CreateOnDutyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOnDutyGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).CreateOnDutyGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_CreateOnDutyGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).CreateOnDutyGroup(ctx, req.(*CreateOnDutyGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_UpdateOnDutyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOnDutyGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).UpdateOnDutyGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_UpdateOnDutyGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).UpdateOnDutyGroup(ctx, req.(*UpdateOnDutyGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_DeleteOnDutyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOnDutyGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).DeleteOnDutyGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_DeleteOnDutyGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).DeleteOnDutyGroup(ctx, req.(*DeleteOnDutyGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_CreateOnDutyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOnDutyOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).CreateOnDutyOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_CreateOnDutyOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).CreateOnDutyOverride(ctx, req.(*CreateOnDutyOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_DeleteOnDutyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOnDutyOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).DeleteOnDutyOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_DeleteOnDutyOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).DeleteOnDutyOverride(ctx, req.(*DeleteOnDutyOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_SwapOnDutyShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapOnDutyShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).SwapOnDutyShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_SwapOnDutyShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).SwapOnDutyShifts(ctx, req.(*SwapOnDutyShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_GetOnCallUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnCallUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).GetOnCallUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_GetOnCallUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).GetOnCallUser(ctx, req.(*GetOnCallUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrometheusRpc_ListOnDutyShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnDutyShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrometheusRpcServer).ListOnDutyShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrometheusRpc_ListOnDutyShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrometheusRpcServer).ListOnDutyShifts(ctx, req.(*ListOnDutyShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PrometheusRpc_ServiceDesc is the grpc.ServiceDesc for PrometheusRpc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1113,6 +1697,74 @@ var PrometheusRpc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchDeleteRecordRule",
 			Handler:    _PrometheusRpc_BatchDeleteRecordRule_Handler,
 		},
+		{
+			MethodName: "GetConfigSyncStatus",
+			Handler:    _PrometheusRpc_GetConfigSyncStatus_Handler,
+		},
+		{
+			MethodName: "ListAlertEvents",
+			Handler:    _PrometheusRpc_ListAlertEvents_Handler,
+		},
+		{
+			MethodName: "ClaimAlertEvent",
+			Handler:    _PrometheusRpc_ClaimAlertEvent_Handler,
+		},
+		{
+			MethodName: "SilenceAlertEvent",
+			Handler:    _PrometheusRpc_SilenceAlertEvent_Handler,
+		},
+		{
+			MethodName: "UnsilenceAlertEvent",
+			Handler:    _PrometheusRpc_UnsilenceAlertEvent_Handler,
+		},
+		{
+			MethodName: "BatchSilence",
+			Handler:    _PrometheusRpc_BatchSilence_Handler,
+		},
+		{
+			MethodName: "ResolveAlertEvent",
+			Handler:    _PrometheusRpc_ResolveAlertEvent_Handler,
+		},
+		{
+			MethodName: "GetAlertEventTimeline",
+			Handler:    _PrometheusRpc_GetAlertEventTimeline_Handler,
+		},
+		{
+			MethodName: "GetOnDutyGroupList",
+			Handler:    _PrometheusRpc_GetOnDutyGroupList_Handler,
+		},
+		{
+			MethodName: "CreateOnDutyGroup",
+			Handler:    _PrometheusRpc_CreateOnDutyGroup_Handler,
+		},
+		{
+			MethodName: "UpdateOnDutyGroup",
+			Handler:    _PrometheusRpc_UpdateOnDutyGroup_Handler,
+		},
+		{
+			MethodName: "DeleteOnDutyGroup",
+			Handler:    _PrometheusRpc_DeleteOnDutyGroup_Handler,
+		},
+		{
+			MethodName: "CreateOnDutyOverride",
+			Handler:    _PrometheusRpc_CreateOnDutyOverride_Handler,
+		},
+		{
+			MethodName: "DeleteOnDutyOverride",
+			Handler:    _PrometheusRpc_DeleteOnDutyOverride_Handler,
+		},
+		{
+			MethodName: "SwapOnDutyShifts",
+			Handler:    _PrometheusRpc_SwapOnDutyShifts_Handler,
+		},
+		{
+			MethodName: "GetOnCallUser",
+			Handler:    _PrometheusRpc_GetOnCallUser_Handler,
+		},
+		{
+			MethodName: "ListOnDutyShifts",
+			Handler:    _PrometheusRpc_ListOnDutyShifts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "prometheus_rpc.proto",